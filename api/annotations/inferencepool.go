@@ -0,0 +1,27 @@
+package annotations
+
+const (
+	// EndpointPickerTLS is an annotation that can be set on an InferencePool to configure how the gateway connects
+	// to the endpoint picker (EPP) of the pool. Supported values are:
+	//   - "Insecure" (default): TLS without verifying the certificate of the endpoint picker, which serves a
+	//     self-signed certificate by default.
+	//   - "Disabled": plaintext gRPC.
+	//   - "Verify": TLS verifying the certificate of the endpoint picker against the CA certificate referenced by
+	//     the EndpointPickerCACertificate annotation, and that it is issued for the hostname of the endpoint picker
+	//     Service.
+	EndpointPickerTLS = "kgateway.dev/endpoint-picker-tls"
+
+	// EndpointPickerCACertificate is an annotation that can be set on an InferencePool to reference a ConfigMap in
+	// the namespace of the pool holding the CA certificate of the endpoint picker under the "ca.crt" key. It is
+	// required when EndpointPickerTLS is "Verify" and ignored otherwise.
+	EndpointPickerCACertificate = "kgateway.dev/endpoint-picker-ca-configmap"
+)
+
+// EndpointPickerTLSMode is a value of the EndpointPickerTLS annotation.
+type EndpointPickerTLSMode string
+
+const (
+	EndpointPickerTLSInsecure EndpointPickerTLSMode = "Insecure"
+	EndpointPickerTLSDisabled EndpointPickerTLSMode = "Disabled"
+	EndpointPickerTLSVerify   EndpointPickerTLSMode = "Verify"
+)
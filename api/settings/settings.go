@@ -23,6 +23,8 @@ const (
 	// (e.g. RDS, CDS, and security-related policies). Routes that fail these
 	// checks are also replaced with direct responses, and helps prevent unsafe
 	// config from reaching Envoy.
+	// The config is validated by the Envoy binary when it is available in the controller image,
+	// and in-process otherwise, which checks a subset of what Envoy rejects.
 	// Strict Validation is not supported with Rustformation yet,
 	// see docs/guides/transformation.md for details
 	ValidationStrict ValidationMode = "STRICT"
@@ -158,6 +160,17 @@ type Settings struct {
 	// This corresponds to the value of the `grpc-xds` port in the service.
 	XdsServicePort uint32 `split_words:"true" default:"9977"`
 
+	// WasmServicePort is the port of the Kubernetes Service that serves the Wasm modules pulled from images
+	// or stored in ConfigMaps to the proxies. The service host is the same as the xDS service host.
+	// This corresponds to the value of the `http-wasm` port in the service.
+	WasmServicePort uint32 `split_words:"true" default:"9978"`
+
+	// EnableWasmModuleServer enables the server of the Wasm modules pulled from images or stored in ConfigMaps.
+	// The server is started once a GatewayExtension references such a module. It serves the modules to the proxies
+	// presenting their xDS token when XdsAuth is enabled, and over TLS when XdsTLS is enabled; otherwise, the modules
+	// are served to any client reaching the port. When disabled, only the Wasm modules on the proxy filesystem are supported.
+	EnableWasmModuleServer bool `split_words:"true" default:"true"`
+
 	// XdsAuth enables or disables xDS authentication between the data-plane and control-plane.
 	// By default, this is enabled.
 	XdsAuth bool `split_words:"true" default:"true"`
@@ -166,6 +179,16 @@ type Settings struct {
 	// By default, this is disabled.
 	XdsTLS bool `split_words:"true" default:"false"`
 
+	// XdsNackRollback pins the xDS resources rejected (NACKed) by a proxy to the version last accepted by the proxy,
+	// while the other resources keep being updated. A pinned resource is sent again once its translation changes.
+	// By default, this is disabled and the rejected configuration is kept as the current configuration.
+	XdsNackRollback bool `split_words:"true" default:"false"`
+
+	// XdsDelta configures the provisioned proxies to use incremental (delta) xDS instead of state-of-the-world xDS.
+	// With delta xDS, only the resources that changed are sent to the proxies, e.g., a single endpoint update
+	// does not send all the clusters and endpoints again. The control plane serves both variants of the protocol.
+	XdsDelta bool `split_words:"true" default:"false"`
+
 	UseRustFormations bool `split_words:"true" default:"true"`
 
 	// DefaultImageRegistry is the default image registry to use for the kgateway image.
@@ -209,6 +232,12 @@ type Settings struct {
 	// - "STRICT": Builds on STANDARD by running targeted validation
 	ValidationMode ValidationMode `split_words:"true" default:"STANDARD"`
 
+	// XdsValidatorFallback allows the controller to validate the xDS configuration in process when the Envoy
+	// binary is not available. The in-process validator only checks a subset of the rules enforced by Envoy,
+	// so configuration it accepts can still be rejected by the proxies. By default, this is disabled and the
+	// controller always validates with the Envoy binary.
+	XdsValidatorFallback bool `split_words:"true" default:"false"`
+
 	// EnableBuiltinDefaultMetrics enables the default builtin controller-runtime metrics and go runtime metrics.
 	// Since these metrics can be numerous, it is disabled by default.
 	EnableBuiltinDefaultMetrics bool `split_words:"true" default:"false"`
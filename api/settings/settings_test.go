@@ -28,6 +28,8 @@ func allEnvVarsSet() map[string]string {
 		"KGW_XDS_SERVICE_HOST":                         "my-xds-host",
 		"KGW_XDS_SERVICE_NAME":                         "custom-svc",
 		"KGW_XDS_SERVICE_PORT":                         "1234",
+		"KGW_WASM_SERVICE_PORT":                        "1235",
+		"KGW_ENABLE_WASM_MODULE_SERVER":                "false",
 		"KGW_USE_RUST_FORMATIONS":                      "false",
 		"KGW_DEFAULT_IMAGE_REGISTRY":                   "my-registry",
 		"KGW_DEFAULT_IMAGE_TAG":                        "my-tag",
@@ -39,6 +41,7 @@ func allEnvVarsSet() map[string]string {
 		"KGW_ENABLE_ENVOY":                             "false",
 		"KGW_WEIGHTED_ROUTE_PRECEDENCE":                "true",
 		"KGW_VALIDATION_MODE":                          string(ValidationStrict),
+		"KGW_XDS_VALIDATOR_FALLBACK":                   "true",
 		"KGW_ENABLE_BUILTIN_DEFAULT_METRICS":           "true",
 		"KGW_GLOBAL_POLICY_NAMESPACE":                  "foo",
 		"KGW_DISABLE_LEADER_ELECTION":                  "true",
@@ -47,6 +50,8 @@ func allEnvVarsSet() map[string]string {
 		"KGW_ENABLE_WAYPOINT":                          "true",
 		"KGW_XDS_AUTH":                                 "false",
 		"KGW_XDS_TLS":                                  "true",
+		"KGW_XDS_NACK_ROLLBACK":                        "true",
+		"KGW_XDS_DELTA":                                "true",
 		"KGW_ENABLE_EXPERIMENTAL_GATEWAY_API_FEATURES": "false",
 	}
 }
@@ -79,6 +84,8 @@ func TestSettings(t *testing.T) {
 				XdsServiceHost:                       "",
 				XdsServiceName:                       wellknown.DefaultXdsService,
 				XdsServicePort:                       wellknown.DefaultXdsPort,
+				WasmServicePort:                      wellknown.DefaultWasmPort,
+				EnableWasmModuleServer:               true,
 				UseRustFormations:                    true,
 				DefaultImageRegistry:                 "cr.kgateway.dev",
 				DefaultImageTag:                      "",
@@ -97,6 +104,8 @@ func TestSettings(t *testing.T) {
 				EnableWaypoint:                       false,
 				XdsAuth:                              true,
 				XdsTLS:                               false,
+				XdsNackRollback:                      false,
+				XdsDelta:                             false,
 				EnableExperimentalGatewayAPIFeatures: true,
 				GatewayClassParametersRefs:           GatewayClassParametersRefs{},
 			},
@@ -115,6 +124,8 @@ func TestSettings(t *testing.T) {
 				XdsServiceHost:                       "my-xds-host",
 				XdsServiceName:                       "custom-svc",
 				XdsServicePort:                       1234,
+				WasmServicePort:                      1235,
+				EnableWasmModuleServer:               false,
 				UseRustFormations:                    false,
 				DefaultImageRegistry:                 "my-registry",
 				DefaultImageTag:                      "my-tag",
@@ -126,6 +137,7 @@ func TestSettings(t *testing.T) {
 				EnableEnvoy:                          false,
 				WeightedRoutePrecedence:              true,
 				ValidationMode:                       ValidationStrict,
+				XdsValidatorFallback:                 true,
 				EnableBuiltinDefaultMetrics:          true,
 				GlobalPolicyNamespace:                "foo",
 				DisableLeaderElection:                true,
@@ -133,6 +145,8 @@ func TestSettings(t *testing.T) {
 				EnableWaypoint:                       true,
 				XdsAuth:                              false,
 				XdsTLS:                               true,
+				XdsNackRollback:                      true,
+				XdsDelta:                             true,
 				EnableExperimentalGatewayAPIFeatures: false,
 				GatewayClassParametersRefs: GatewayClassParametersRefs{
 					"kgateway": {
@@ -198,6 +212,8 @@ func TestSettings(t *testing.T) {
 				IstioNamespace:                       "istio-system",
 				XdsServiceName:                       wellknown.DefaultXdsService,
 				XdsServicePort:                       wellknown.DefaultXdsPort,
+				WasmServicePort:                      wellknown.DefaultWasmPort,
+				EnableWasmModuleServer:               true,
 				UseRustFormations:                    true,
 				DefaultImageRegistry:                 "cr.kgateway.dev",
 				DefaultImageTag:                      "",
@@ -212,6 +228,8 @@ func TestSettings(t *testing.T) {
 				PolicyMerge:                          "{}",
 				XdsAuth:                              true,
 				XdsTLS:                               false,
+				XdsNackRollback:                      false,
+				XdsDelta:                             false,
 				EnableExperimentalGatewayAPIFeatures: true,
 				GatewayClassParametersRefs:           GatewayClassParametersRefs{},
 			},
@@ -106,9 +106,11 @@ type BackendConfigPolicySpec struct {
 	CircuitBreakers *CircuitBreakers `json:"circuitBreakers,omitempty"`
 }
 
-// CircuitBreakers contains the options to configure circuit breaker thresholds for the default priority.
+// CircuitBreakers contains the options to configure circuit breaker thresholds for the default priority,
+// and optionally for the high priority.
 // See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/cluster/v3/circuit_breaker.proto) for more details.
-// +kubebuilder:validation:AtLeastOneOf=maxConnections;maxPendingRequests;maxRequests;maxRetries
+// +kubebuilder:validation:AtLeastOneOf=maxConnections;maxPendingRequests;maxRequests;maxRetries;retryBudget;maxConnectionPools;highPriority;trackRemaining
+// +kubebuilder:validation:XValidation:rule="!(has(self.maxRetries) && has(self.retryBudget))",message="maxRetries and retryBudget are mutually exclusive"
 type CircuitBreakers struct {
 	// MaxConnections is the maximum number of connections that will be made to
 	// the upstream cluster. If not specified, defaults to 1024.
@@ -133,6 +135,87 @@ type CircuitBreakers struct {
 	// +optional
 	// +kubebuilder:validation:Minimum=0
 	MaxRetries *int32 `json:"maxRetries,omitempty"`
+
+	// RetryBudget limits the parallel retries to a share of the active requests,
+	// so that retries scale with the traffic instead of being capped at a fixed number.
+	// Mutually exclusive with MaxRetries.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// MaxConnectionPools is the maximum number of connection pools per cluster that
+	// are concurrently supported. If not specified, the number of connection pools is unlimited.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConnectionPools *int32 `json:"maxConnectionPools,omitempty"`
+
+	// HighPriority contains the circuit breaker thresholds for the requests routed with the high priority.
+	// If not specified, the Envoy defaults apply to the high priority.
+	// +optional
+	HighPriority *CircuitBreakerThresholds `json:"highPriority,omitempty"`
+
+	// TrackRemaining publishes the remaining capacity of the circuit breakers of all the priorities
+	// as stats, e.g. the number of connections or retries that can still be made before the circuit breaker opens.
+	// +optional
+	TrackRemaining *bool `json:"trackRemaining,omitempty"`
+}
+
+// CircuitBreakerThresholds contains the circuit breaker thresholds for a priority.
+// +kubebuilder:validation:AtLeastOneOf=maxConnections;maxPendingRequests;maxRequests;maxRetries;retryBudget;maxConnectionPools
+// +kubebuilder:validation:XValidation:rule="!(has(self.maxRetries) && has(self.retryBudget))",message="maxRetries and retryBudget are mutually exclusive"
+type CircuitBreakerThresholds struct {
+	// MaxConnections is the maximum number of connections that will be made to
+	// the upstream cluster. If not specified, defaults to 1024.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
+
+	// MaxPendingRequests is the maximum number of pending requests that are
+	// allowed to the upstream cluster. If not specified, defaults to 1024.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxPendingRequests *int32 `json:"maxPendingRequests,omitempty"`
+
+	// MaxRequests is the maximum number of parallel requests that are allowed
+	// to the upstream cluster. If not specified, defaults to 1024.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRequests *int32 `json:"maxRequests,omitempty"`
+
+	// MaxRetries is the maximum number of parallel retries that are allowed
+	// to the upstream cluster. If not specified, defaults to 3.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+
+	// RetryBudget limits the parallel retries to a share of the active requests.
+	// Mutually exclusive with MaxRetries.
+	// +optional
+	RetryBudget *RetryBudget `json:"retryBudget,omitempty"`
+
+	// MaxConnectionPools is the maximum number of connection pools per cluster that
+	// are concurrently supported. If not specified, the number of connection pools is unlimited.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConnectionPools *int32 `json:"maxConnectionPools,omitempty"`
+}
+
+// RetryBudget limits the parallel retries to a percentage of the active requests,
+// i.e. the sum of the active and pending requests.
+// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/cluster/v3/circuit_breaker.proto#envoy-v3-api-msg-config-cluster-v3-circuitbreakers-thresholds-retrybudget) for more details.
+type RetryBudget struct {
+	// BudgetPercent is the percentage of the active requests that can be retried in parallel.
+	// If not specified, defaults to 20%.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	BudgetPercent *int32 `json:"budgetPercent,omitempty"`
+
+	// MinRetryConcurrency is the number of parallel retries that are always allowed,
+	// regardless of the budget, e.g. when there are few active requests.
+	// If not specified, defaults to 3.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinRetryConcurrency *int32 `json:"minRetryConcurrency,omitempty"`
 }
 
 // See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/protocol.proto#envoy-v3-api-msg-config-core-v3-http1protocoloptions) for more details.
@@ -376,6 +459,11 @@ type LoadBalancer struct {
 	// +kubebuilder:validation:Enum=WeightedLb
 	LocalityType *LocalityType `json:"localityType,omitempty"`
 
+	// Locality configures how traffic is distributed across the localities (regions, zones and subzones)
+	// of the backend endpoints, e.g. to keep traffic in the zone of the proxy.
+	// +optional
+	Locality *LocalityLoadBalancing `json:"locality,omitempty"`
+
 	// If set to true, the load balancer will drain connections when the host set changes.
 	//
 	// Ring Hash or Maglev can be used to ensure that clients with the same key
@@ -389,6 +477,13 @@ type LoadBalancer struct {
 	// before being closed.
 	// +optional
 	CloseConnectionsOnHostSetChange *bool `json:"closeConnectionsOnHostSetChange,omitempty"`
+
+	// SessionPersistence configures cookie or header based session affinity (sticky sessions)
+	// for all routes to the targeted backends.
+	// Session persistence configured on an HTTPRoute rule takes precedence over this setting.
+	// See [Gateway API session persistence](https://gateway-api.sigs.k8s.io/geps/gep-1619/) for details.
+	// +optional
+	SessionPersistence *gwv1.SessionPersistence `json:"sessionPersistence,omitempty"`
 }
 
 // LoadBalancerLeastRequestConfig configures the least request load balancer type.
@@ -491,6 +586,89 @@ const (
 	LocalityConfigTypeWeightedLb LocalityType = "WeightedLb"
 )
 
+// LocalityLoadBalancing configures how traffic is distributed across the localities of the backend endpoints.
+// The locality of an endpoint is read from the topology.kubernetes.io/region and topology.kubernetes.io/zone
+// labels of its node and the topology.istio.io/subzone label of its pod, as is the locality of the proxy.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.zoneAware) || has(self.failoverPriority) || has(self.weights)",message="at least one of zoneAware, failoverPriority or weights must be set"
+// +kubebuilder:validation:XValidation:rule="!(has(self.zoneAware) && has(self.failoverPriority))",message="zoneAware and failoverPriority are mutually exclusive"
+type LocalityLoadBalancing struct {
+	// ZoneAware sends traffic to the endpoints in the zone of the proxy first. Traffic fails over to the
+	// endpoints in the other zones of its region, and then to the endpoints in other regions, when the
+	// endpoints of the zone become unhealthy.
+	// +optional
+	ZoneAware *ZoneAwareRouting `json:"zoneAware,omitempty"`
+
+	// FailoverPriority is an ordered list of labels used to prioritize the endpoints. Traffic is sent to
+	// the endpoints that match the labels of the proxy on all the labels first, then to the endpoints that
+	// match on all but the last label, and so on. A label can be set as `<label>=<value>` to match the
+	// endpoints with the value, instead of the value of the label of the proxy.
+	//
+	// Example:
+	// ```yaml
+	// failoverPriority:
+	// - topology.kubernetes.io/region
+	// - topology.kubernetes.io/zone
+	// ```
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:items:MinLength=1
+	FailoverPriority []string `json:"failoverPriority,omitempty"`
+
+	// Weights sets the share of the traffic sent to each locality, and enables locality weighted load
+	// balancing. The weight of a locality is the weight of the first entry that matches it. Endpoints in
+	// localities that match no entry receive no traffic.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Weights []LocalityWeight `json:"weights,omitempty"`
+}
+
+// ZoneAwareRouting configures the thresholds of zone-aware routing.
+type ZoneAwareRouting struct {
+	// MinClusterSize is the minimum number of endpoints of the backend for zone-aware routing to be used.
+	// Traffic to backends with fewer endpoints is balanced across all zones.
+	// If unset, zone-aware routing is used regardless of the number of endpoints.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinClusterSize *int32 `json:"minClusterSize,omitempty"`
+
+	// MinHealthyPercent is the percentage of healthy endpoints in a zone below which part of the traffic
+	// for the zone fails over to the next zones, in proportion to the unhealthy endpoints.
+	// If unset, Envoy's default of 72% (an overprovisioning factor of 1.4) is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	MinHealthyPercent *int32 `json:"minHealthyPercent,omitempty"`
+}
+
+// LocalityWeight is the load balancing weight of the localities that match the region, zone and subzone.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.subzone) || has(self.zone)",message="zone must be set when subzone is set"
+type LocalityWeight struct {
+	// Region of the locality.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Region string `json:"region"`
+
+	// Zone of the locality. If unset, all the zones of the region match.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Zone *string `json:"zone,omitempty"`
+
+	// Subzone of the locality. If unset, all the subzones of the zone match.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Subzone *string `json:"subzone,omitempty"`
+
+	// Weight is the weight of the locality, relative to the weights of the other localities.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	Weight int32 `json:"weight"`
+}
+
 // HealthCheck contains the options to configure the health check.
 // See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/health_check.proto) for more details.
 
@@ -0,0 +1,129 @@
+package kgateway
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// ResponseCache configures caching of HTTP responses by the proxy.
+// Responses are cached following the HTTP caching rules of RFC 9111, i.e., based on the
+// Cache-Control, Expires and Vary headers of requests and responses.
+// Cached responses are served after authentication, authorization and rate limiting,
+// and before transformations are applied to requests.
+// A cache configured for a route replaces the cache configured at a higher level in the config
+// hierarchy, e.g., for the Gateway, instead of being used in addition to it.
+// See https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/cache_filter
+// for details on the Envoy cache filter.
+// +kubebuilder:validation:XValidation:rule="has(self.disable) ? !has(self.storage) && !has(self.allowedVaryHeaders) && !has(self.key) && !has(self.maxBodySize) && !has(self.ignoreRequestCacheControl) : true",message="disable cannot be set with other fields"
+type ResponseCache struct {
+	// Storage configures where cached responses are stored. Defaults to an in-memory cache.
+	// +optional
+	Storage *CacheStorage `json:"storage,omitempty"`
+
+	// AllowedVaryHeaders lists the request headers that responses may vary on.
+	// Responses with a Vary header that lists a header not matched by any of these matchers
+	// are not cached.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	AllowedVaryHeaders []shared.StringMatcher `json:"allowedVaryHeaders,omitempty"`
+
+	// Key customizes the cache key of responses.
+	// By default, the cache key includes the scheme, host, path and query string of the request.
+	// +optional
+	Key *CacheKey `json:"key,omitempty"`
+
+	// MaxBodySize is the maximum size of a response body to cache. Larger responses are not cached.
+	// Defaults to no limit other than the limits of the storage.
+	// Example format: "1Mi", "512Ki"
+	// +optional
+	// +kubebuilder:validation:XValidation:message="maxBodySize must be greater than 0 and less than 4Gi",rule="(type(self) == int && int(self) > 0 && int(self) < 4294967296) || (type(self) == string && quantity(self).isGreaterThan(quantity('0')) && quantity(self).isLessThan(quantity('4Gi')))"
+	MaxBodySize *resource.Quantity `json:"maxBodySize,omitempty"`
+
+	// IgnoreRequestCacheControl ignores the Cache-Control header of requests, so that clients cannot
+	// bypass the cache, e.g., with `Cache-Control: no-cache`.
+	// +optional
+	IgnoreRequestCacheControl *bool `json:"ignoreRequestCacheControl,omitempty"`
+
+	// Disable response caching.
+	// Can be used to disable response caching policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// CacheStorage configures where cached responses are stored.
+// +kubebuilder:validation:ExactlyOneOf=memory;fileSystem
+type CacheStorage struct {
+	// Memory stores cached responses in the memory of the proxy.
+	// The cache is shared by all the listeners of the proxy and is not bounded in size,
+	// so it is best suited for a small number of small responses.
+	// +optional
+	Memory *MemoryCacheStorage `json:"memory,omitempty"`
+
+	// FileSystem stores cached responses as files on the proxy filesystem.
+	// +optional
+	FileSystem *FileSystemCacheStorage `json:"fileSystem,omitempty"`
+}
+
+// MemoryCacheStorage configures an in-memory cache.
+type MemoryCacheStorage struct{}
+
+// FileSystemCacheStorage configures a cache stored on the proxy filesystem.
+type FileSystemCacheStorage struct {
+	// Path is the directory in which cached responses are stored, e.g., a volume mounted
+	// into the proxy container. The directory must exist and be writable by the proxy.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+
+	// MaxSize is the maximum total size of the cache. Entries are evicted when it is exceeded.
+	// Defaults to no limit.
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+
+	// MaxEntrySize is the maximum size of a single cache entry. Larger responses are not cached.
+	// Defaults to no limit.
+	// +optional
+	MaxEntrySize *resource.Quantity `json:"maxEntrySize,omitempty"`
+
+	// MaxEntries is the maximum number of entries in the cache. Entries are evicted when it is exceeded.
+	// Defaults to no limit.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxEntries *int64 `json:"maxEntries,omitempty"`
+
+	// ThreadCount is the number of threads used for file operations.
+	// Defaults to the number of concurrent threads supported by the hardware.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	ThreadCount *int32 `json:"threadCount,omitempty"`
+}
+
+// CacheKey customizes the cache key of responses.
+// +kubebuilder:validation:AtLeastOneOf=excludeScheme;excludeHost;includedQueryParameters;excludedQueryParameters
+type CacheKey struct {
+	// ExcludeScheme excludes the scheme of requests from the cache key.
+	// Set it if the backends return the same responses for HTTP and HTTPS requests.
+	// +optional
+	ExcludeScheme *bool `json:"excludeScheme,omitempty"`
+
+	// ExcludeHost excludes the host of requests from the cache key.
+	// Set it if the responses of the backends do not depend on the host.
+	// +optional
+	ExcludeHost *bool `json:"excludeHost,omitempty"`
+
+	// IncludedQueryParameters lists the names of the query parameters included in the cache key.
+	// If set, other query parameters do not affect the cache key.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	IncludedQueryParameters []string `json:"includedQueryParameters,omitempty"`
+
+	// ExcludedQueryParameters lists the names of the query parameters excluded from the cache key,
+	// even if they are listed in IncludedQueryParameters.
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	ExcludedQueryParameters []string `json:"excludedQueryParameters,omitempty"`
+}
@@ -0,0 +1,124 @@
+package kgateway
+
+import (
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// CustomResponse replaces responses with specific status codes, e.g., to serve custom error pages
+// instead of the default responses of the proxy. Responses of the backends are replaced as well as
+// the local responses of the proxy, such as the responses denying requests because of external
+// authorization, JWT or API key authentication, WAF rules or rate limits.
+// A custom response configured for a route replaces the custom response configured at a higher level
+// in the config hierarchy, e.g., for the Gateway, so that a Gateway-wide default can be overridden
+// by routes.
+// See https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/custom_response_filter
+// for details on the Envoy custom response filter.
+//
+// +kubebuilder:validation:ExactlyOneOf=rules;disable
+type CustomResponse struct {
+	// Rules map ranges of response status codes to the response to send instead.
+	// Rules are evaluated in order and the first rule matching the status code of the response applies.
+	// Responses that do not match any rule are not modified.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Rules []CustomResponseRule `json:"rules,omitempty"`
+
+	// Disable the custom responses.
+	// Can be used to disable custom responses applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// CustomResponseRule maps ranges of response status codes to the response to send instead.
+//
+// +kubebuilder:validation:ExactlyOneOf=localResponse;redirect
+type CustomResponseRule struct {
+	// StatusCodes are the ranges of status codes of the responses to replace.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	StatusCodes []StatusCodeRange `json:"statusCodes"`
+
+	// LocalResponse is the response sent by the proxy instead of the original response.
+	// +optional
+	LocalResponse *CustomLocalResponse `json:"localResponse,omitempty"`
+
+	// Redirect internally redirects the request to another URI, e.g., served by an error page
+	// service, whose response is sent instead of the original response.
+	// +optional
+	Redirect *CustomResponseRedirect `json:"redirect,omitempty"`
+}
+
+// StatusCodeRange is an inclusive range of HTTP status codes.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.end) || self.end >= self.start",message="end must be greater than or equal to start"
+type StatusCodeRange struct {
+	// Start is the first status code of the range.
+	// +required
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	Start int32 `json:"start"`
+
+	// End is the last status code of the range. Defaults to Start, i.e., a single status code.
+	// +optional
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	End *int32 `json:"end,omitempty"`
+}
+
+// CustomLocalResponse is a response sent by the proxy.
+type CustomLocalResponse struct {
+	// StatusCode is the status code of the response. Defaults to the status code of the original response.
+	// +optional
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// Body is the body of the response. Envoy command operators such as `%REQ(x-request-id)%` or
+	// `%RESPONSE_CODE%` are substituted in the body, so a literal `%` must be escaped as `%%`.
+	// If omitted, the response has no body.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Body *string `json:"body,omitempty"`
+
+	// ContentType is the content type of the body. Defaults to `text/plain`.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Headers are added to the response, overwriting existing headers with the same name.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Headers []gwv1.HTTPHeader `json:"headers,omitempty"`
+}
+
+// CustomResponseRedirect internally redirects the request to another URI, whose response
+// is sent instead of the original response.
+type CustomResponseRedirect struct {
+	// URI is the absolute URI the request is redirected to, e.g., `http://errors.example.com/5xx.html`.
+	// The URI must be routable by the Gateway, i.e., its host must match a route of the Gateway.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:XValidation:rule="isURL(self)",message="uri must be an absolute URI"
+	URI string `json:"uri"`
+
+	// StatusCode is the status code of the response. Defaults to the status code of the original response.
+	// +optional
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=599
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// Headers are added to the response, overwriting existing headers with the same name.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Headers []gwv1.HTTPHeader `json:"headers,omitempty"`
+}
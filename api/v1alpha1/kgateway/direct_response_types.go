@@ -2,6 +2,7 @@ package kgateway
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 // +kubebuilder:rbac:groups=gateway.kgateway.dev,resources=directresponses,verbs=get;list;watch
@@ -32,6 +33,9 @@ type DirectResponseList struct {
 }
 
 // DirectResponseSpec describes the desired state of a DirectResponse.
+//
+// +kubebuilder:validation:XValidation:rule="!(has(self.body) && has(self.bodyFrom))",message="at most one of body and bodyFrom may be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.formatBody) || !self.formatBody || has(self.body) || has(self.bodyFrom)",message="formatBody requires body or bodyFrom to be set"
 type DirectResponseSpec struct {
 	// StatusCode defines the HTTP status code to return for this route.
 	//
@@ -47,6 +51,37 @@ type DirectResponseSpec struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=4096
 	Body *string `json:"body,omitempty"`
+	// BodyFrom defines the source of the content to be returned in the HTTP response body,
+	// for content that is too large to be inlined in Body, e.g., maintenance pages.
+	// The content is limited to 65536 bytes.
+	//
+	// +optional
+	BodyFrom *DirectResponseBodySource `json:"bodyFrom,omitempty"`
+	// Headers defines the headers to be added to the response, overwriting existing
+	// headers with the same name, e.g., to set the Content-Type of the body.
+	// Header values can contain Envoy command operators such as `%REQ(x-request-id)%`.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Headers []gwv1.HTTPHeader `json:"headers,omitempty"`
+	// FormatBody enables the substitution of Envoy command operators in the body, e.g.,
+	// `%REQ(x-request-id)%` for the request ID or `%REQ(:authority)%` for the host.
+	// When enabled, a literal `%` in the body must be escaped as `%%`.
+	// See https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log/usage#command-operators
+	// for the list of command operators.
+	//
+	// +optional
+	FormatBody *bool `json:"formatBody,omitempty"`
+}
+
+// DirectResponseBodySource defines the source of the body of a direct response.
+type DirectResponseBodySource struct {
+	// ConfigMapRef references a ConfigMap key containing the body.
+	//
+	// +required
+	ConfigMapRef ConfigMapKeyReference `json:"configMapRef"`
 }
 
 // DirectResponseStatus defines the observed state of a DirectResponse.
@@ -0,0 +1,98 @@
+package kgateway
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// FaultInjection configures the injection of delays and aborts into requests.
+// It can be used to test the resiliency of services to upstream failures.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.disable) ? !has(self.delay) && !has(self.abort) && !has(self.headers) && !has(self.maxActiveFaults) : has(self.delay) || has(self.abort)",message="exactly one of disable or at least one of delay/abort must be set"
+type FaultInjection struct {
+	// Delay injects a delay before the request is forwarded upstream.
+	// +optional
+	Delay *FaultDelay `json:"delay,omitempty"`
+
+	// Abort aborts the request with the configured status instead of forwarding it upstream.
+	// When both Delay and Abort are set, the delay is injected before the request is aborted.
+	// +optional
+	Abort *FaultAbort `json:"abort,omitempty"`
+
+	// Headers restricts fault injection to requests that match all of the given headers.
+	// If empty, faults are injected into all requests, subject to the configured percentages.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Headers []gwv1.HTTPHeaderMatch `json:"headers,omitempty"`
+
+	// MaxActiveFaults is the maximum number of faults that can be active at a single time.
+	// If not set, the number of active faults is unbounded.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxActiveFaults *int32 `json:"maxActiveFaults,omitempty"`
+
+	// Disable the fault injection filter.
+	// Can be used to disable fault injection policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// FaultDelay configures a delay fault.
+// +kubebuilder:validation:ExactlyOneOf=fixedDelay;fromHeader
+type FaultDelay struct {
+	// FixedDelay is the duration of the delay to inject.
+	// It is specified as a sequence of decimal numbers, each with optional fraction and a unit suffix, such as "1s" or "500ms".
+	// +optional
+	//
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="faultInjection.delay.fixedDelay must be at least 1ms."
+	FixedDelay *metav1.Duration `json:"fixedDelay,omitempty"`
+
+	// FromHeader enables header-driven delays. The delay duration in milliseconds is read from
+	// the `x-envoy-fault-delay-request` request header, and requests without the header are not delayed.
+	// +optional
+	FromHeader *FaultHeaderActivation `json:"fromHeader,omitempty"`
+
+	// Percentage specifies the percentage of requests to which the delay is applied.
+	// Defaults to 100 if not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *int32 `json:"percentage,omitempty"`
+}
+
+// FaultAbort configures an abort fault.
+// +kubebuilder:validation:ExactlyOneOf=httpStatus;grpcStatus;fromHeader
+type FaultAbort struct {
+	// HTTPStatus is the HTTP status code used to abort the request.
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	HTTPStatus *int32 `json:"httpStatus,omitempty"`
+
+	// GRPCStatus is the gRPC status code used to abort the request.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=16
+	GRPCStatus *int32 `json:"grpcStatus,omitempty"`
+
+	// FromHeader enables header-driven aborts. The HTTP or gRPC status is read from the
+	// `x-envoy-fault-abort-request` or `x-envoy-fault-abort-grpc-request` request header,
+	// and requests without either header are not aborted.
+	// +optional
+	FromHeader *FaultHeaderActivation `json:"fromHeader,omitempty"`
+
+	// Percentage specifies the percentage of requests to which the abort is applied.
+	// Defaults to 100 if not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *int32 `json:"percentage,omitempty"`
+}
+
+// FaultHeaderActivation enables faults controlled by request headers.
+// The percentage of requests affected can additionally be controlled with the
+// `x-envoy-fault-delay-request-percentage` and `x-envoy-fault-abort-request-percentage` headers.
+type FaultHeaderActivation struct{}
@@ -40,16 +40,17 @@ type NamedJWTProvider struct {
 }
 
 // GatewayExtensionSpec defines the desired state of GatewayExtension.
-// +kubebuilder:validation:ExactlyOneOf=extAuth;extProc;rateLimit;jwt;oauth2
+// +kubebuilder:validation:ExactlyOneOf=extAuth;extProc;rateLimit;jwt;oauth2;wasm
 // +kubebuilder:validation:XValidation:message="extAuth must be set when type is ExtAuth",rule="has(self.type) && self.type == 'ExtAuth' ? has(self.extAuth) : true"
 // +kubebuilder:validation:XValidation:message="extProc must be set when type is ExtProc",rule="has(self.type) && self.type == 'ExtProc' ? has(self.extProc) : true"
 // +kubebuilder:validation:XValidation:message="rateLimit must be set when type is RateLimit",rule="has(self.type) && self.type == 'RateLimit' ? has(self.rateLimit) : true"
 // +kubebuilder:validation:XValidation:message="JWT must be set when type is JWT",rule="has(self.type) && self.type == 'JWT' ? has(self.jwt) : true"
 // +kubebuilder:validation:XValidation:message="oauth2 must be set when type is OAuth2",rule="has(self.type) && self.type == 'OAuth2' ? has(self.oauth2) : true"
+// +kubebuilder:validation:XValidation:message="wasm must be set when type is Wasm",rule="has(self.type) && self.type == 'Wasm' ? has(self.wasm) : true"
 type GatewayExtensionSpec struct {
 	// Deprecated: Setting this field has no effect.
 	// Type indicates the type of the GatewayExtension to be used.
-	// +kubebuilder:validation:Enum=ExtAuth;ExtProc;RateLimit;JWT;OAuth2
+	// +kubebuilder:validation:Enum=ExtAuth;ExtProc;RateLimit;JWT;OAuth2;Wasm
 	// +optional
 	Type *GatewayExtensionType `json:"type,omitempty"`
 
@@ -72,6 +73,10 @@ type GatewayExtensionSpec struct {
 	// OAuth2 configuration for OAuth2 extension type.
 	// +optional
 	OAuth2 *OAuth2Provider `json:"oauth2,omitempty"`
+
+	// Wasm configuration for Wasm extension type.
+	// +optional
+	Wasm *WasmProvider `json:"wasm,omitempty"`
 }
 
 type JWT struct {
@@ -119,6 +124,8 @@ const (
 	GatewayExtensionTypeJWT GatewayExtensionType = "JWT"
 	// GatewayExtensionTypeOAuth2 is the type for OAuth2 extensions.
 	GatewayExtensionTypeOAuth2 GatewayExtensionType = "OAuth2"
+	// GatewayExtensionTypeWasm is the type for Wasm extensions.
+	GatewayExtensionTypeWasm GatewayExtensionType = "Wasm"
 )
 
 const HTTPDefaultTimeout = 2 * time.Second
@@ -2,9 +2,11 @@ package kgateway
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
 )
@@ -97,6 +99,19 @@ type KubernetesProxyConfig struct {
 	// +optional
 	Deployment *ProxyDeployment `json:"deployment,omitempty"`
 
+	// Configuration for a HorizontalPodAutoscaler scaling the proxy Deployment.
+	// When set, the number of replicas is owned by the HorizontalPodAutoscaler,
+	// so `deployment.replicas` is not set on the generated Deployment.
+	//
+	// +optional
+	Autoscaling *ProxyAutoscaling `json:"autoscaling,omitempty"`
+
+	// Configuration for a PodDisruptionBudget limiting the voluntary disruptions
+	// of the proxy pods, e.g., during node drains.
+	//
+	// +optional
+	DisruptionBudget *ProxyDisruptionBudget `json:"disruptionBudget,omitempty"`
+
 	// Configuration for the container running Envoy.
 	//
 	// +optional
@@ -157,6 +172,20 @@ func (in *KubernetesProxyConfig) GetDeployment() *ProxyDeployment {
 	return in.Deployment
 }
 
+func (in *KubernetesProxyConfig) GetAutoscaling() *ProxyAutoscaling {
+	if in == nil {
+		return nil
+	}
+	return in.Autoscaling
+}
+
+func (in *KubernetesProxyConfig) GetDisruptionBudget() *ProxyDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	return in.DisruptionBudget
+}
+
 func (in *KubernetesProxyConfig) GetEnvoyContainer() *EnvoyContainer {
 	if in == nil {
 		return nil
@@ -217,7 +246,8 @@ func (in *KubernetesProxyConfig) GetOmitDefaultSecurityContext() *bool {
 type ProxyDeployment struct {
 	// The number of desired pods.
 	// If omitted, behavior will be managed by the K8s control plane, and will default to 1.
-	// If you are using an HPA, make sure to not explicitly define this.
+	// If you are using an HPA, make sure to not explicitly define this. It is ignored
+	// when `autoscaling` is set, as the generated HPA owns the number of replicas.
 	// K8s reference: https://kubernetes.io/docs/concepts/workloads/controllers/deployment/#replicas
 	//
 	// +optional
@@ -255,6 +285,125 @@ func (in *ProxyDeployment) GetStrategy() *appsv1.DeploymentStrategy {
 	return in.Strategy
 }
 
+// Configuration for a HorizontalPodAutoscaler scaling the proxy Deployment.
+// If none of the CPU, memory or custom metrics are set, the HorizontalPodAutoscaler
+// targets an average CPU utilization of 80%.
+// K8s reference: https://kubernetes.io/docs/tasks/run-application/horizontal-pod-autoscale/
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must be less than or equal to maxReplicas"
+type ProxyAutoscaling struct {
+	// The lower limit for the number of replicas. Defaults to 1.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// The upper limit for the number of replicas.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// The target average CPU utilization of the proxy pods, as a percentage of
+	// the CPU requested by the pods.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// The target average memory utilization of the proxy pods, as a percentage of
+	// the memory requested by the pods.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+
+	// Additional metrics to scale on, e.g., the active downstream connections of
+	// the proxy exposed to the custom metrics API by a metrics adapter:
+	// metrics:
+	// - type: Pods
+	//   pods:
+	//     metric:
+	//       name: envoy_http_downstream_cx_active
+	//     target:
+	//       type: AverageValue
+	//       averageValue: "1000"
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+}
+
+func (in *ProxyAutoscaling) GetMinReplicas() *int32 {
+	if in == nil {
+		return nil
+	}
+	return in.MinReplicas
+}
+
+func (in *ProxyAutoscaling) GetMaxReplicas() int32 {
+	if in == nil {
+		return 0
+	}
+	return in.MaxReplicas
+}
+
+func (in *ProxyAutoscaling) GetTargetCPUUtilizationPercentage() *int32 {
+	if in == nil {
+		return nil
+	}
+	return in.TargetCPUUtilizationPercentage
+}
+
+func (in *ProxyAutoscaling) GetTargetMemoryUtilizationPercentage() *int32 {
+	if in == nil {
+		return nil
+	}
+	return in.TargetMemoryUtilizationPercentage
+}
+
+func (in *ProxyAutoscaling) GetMetrics() []autoscalingv2.MetricSpec {
+	if in == nil {
+		return nil
+	}
+	return in.Metrics
+}
+
+// Configuration for a PodDisruptionBudget of the proxy pods. Exactly one of
+// minAvailable or maxUnavailable must be set.
+// K8s reference: https://kubernetes.io/docs/concepts/workloads/pods/disruptions/#pod-disruption-budgets
+//
+// +kubebuilder:validation:ExactlyOneOf=minAvailable;maxUnavailable
+type ProxyDisruptionBudget struct {
+	// The number or percentage of proxy pods that must remain available
+	// during a voluntary disruption, e.g., `1` or `50%`.
+	//
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// The number or percentage of proxy pods that can be unavailable
+	// during a voluntary disruption, e.g., `1` or `25%`.
+	//
+	// +optional
+	// +kubebuilder:validation:XIntOrString
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+func (in *ProxyDisruptionBudget) GetMinAvailable() *intstr.IntOrString {
+	if in == nil {
+		return nil
+	}
+	return in.MinAvailable
+}
+
+func (in *ProxyDisruptionBudget) GetMaxUnavailable() *intstr.IntOrString {
+	if in == nil {
+		return nil
+	}
+	return in.MaxUnavailable
+}
+
 // EnvoyContainer configures the container running Envoy.
 type EnvoyContainer struct {
 	// Initial envoy configuration.
@@ -767,16 +916,18 @@ type GatewayParametersOverlays struct {
 	ServiceAccountOverlay *shared.KubernetesResourceOverlay `json:"serviceAccountOverlay,omitempty"`
 
 	// podDisruptionBudget allows creating a PodDisruptionBudget for the proxy.
-	// If absent, no PDB is created. If present, a PDB is created with its selector
-	// automatically configured to target the proxy Deployment.
-	// The metadata and spec fields from this overlay are applied to the generated PDB.
+	// If absent, no PDB is created unless `disruptionBudget` is set. If present, a PDB is
+	// created with its selector automatically configured to target the proxy Deployment.
+	// The metadata and spec fields from this overlay are applied to the generated PDB,
+	// including the PDB generated from `disruptionBudget`.
 	// +optional
 	PodDisruptionBudget *shared.KubernetesResourceOverlay `json:"podDisruptionBudget,omitempty"`
 
 	// horizontalPodAutoscaler allows creating a HorizontalPodAutoscaler for the proxy.
-	// If absent, no HPA is created. If present, an HPA is created with its scaleTargetRef
-	// automatically configured to target the proxy Deployment.
-	// The metadata and spec fields from this overlay are applied to the generated HPA.
+	// If absent, no HPA is created unless `autoscaling` is set. If present, an HPA is
+	// created with its scaleTargetRef automatically configured to target the proxy Deployment.
+	// The metadata and spec fields from this overlay are applied to the generated HPA,
+	// including the HPA generated from `autoscaling`.
 	// +optional
 	HorizontalPodAutoscaler *shared.KubernetesResourceOverlay `json:"horizontalPodAutoscaler,omitempty"`
 
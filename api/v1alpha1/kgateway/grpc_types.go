@@ -0,0 +1,96 @@
+package kgateway
+
+import (
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// GRPCJSONTranscoder configures the transcoding of RESTful JSON requests to gRPC requests,
+// so that the gRPC services of the backends can be called by REST clients. The HTTP
+// mapping of the methods is defined by the `google.api.http` annotations of the services.
+// See https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/grpc_json_transcoder_filter
+// for more details.
+//
+// +kubebuilder:validation:ExactlyOneOf=descriptorSet;disable
+// +kubebuilder:validation:XValidation:rule="has(self.descriptorSet) == has(self.services)",message="services must be set if and only if descriptorSet is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.disable) || !has(self.printOptions)",message="printOptions cannot be set when disable is set"
+type GRPCJSONTranscoder struct {
+	// DescriptorSet is the protobuf descriptor set of the gRPC services, generated with
+	// `protoc --include_imports --descriptor_set_out`.
+	// +optional
+	DescriptorSet *ProtoDescriptorSet `json:"descriptorSet,omitempty"`
+
+	// Services are the fully qualified names of the gRPC services to transcode, e.g., `helloworld.Greeter`.
+	// The services must be defined in the descriptor set.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	Services []string `json:"services,omitempty"`
+
+	// PrintOptions configures how the gRPC responses are printed as JSON.
+	// +optional
+	PrintOptions *GRPCJSONPrintOptions `json:"printOptions,omitempty"`
+
+	// Disable the gRPC-JSON transcoding.
+	// Can be used to disable transcoding policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// ProtoDescriptorSet is the source of a binary protobuf descriptor set (`google.protobuf.FileDescriptorSet`).
+// +kubebuilder:validation:ExactlyOneOf=configMapRef;secretRef
+type ProtoDescriptorSet struct {
+	// ConfigMapRef references a ConfigMap key containing the descriptor set.
+	// The descriptor set is read from the binaryData of the ConfigMap, falling back to data.
+	// +optional
+	ConfigMapRef *ConfigMapKeyReference `json:"configMapRef,omitempty"`
+
+	// SecretRef references a Secret key containing the descriptor set.
+	// +optional
+	SecretRef *SecretKeyReference `json:"secretRef,omitempty"`
+}
+
+// SecretKeyReference identifies a key in a Kubernetes Secret.
+type SecretKeyReference struct {
+	// Name of the Secret.
+	// +required
+	Name gwv1.ObjectName `json:"name"`
+
+	// Namespace of the Secret. If not specified, defaults to the namespace of the referencing policy.
+	// Note that a Secret in a different namespace requires a ReferenceGrant to be accessible.
+	// +optional
+	Namespace *gwv1.Namespace `json:"namespace,omitempty"`
+
+	// Key in the Secret that contains the data.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// GRPCJSONPrintOptions configures how the gRPC responses are printed as JSON.
+type GRPCJSONPrintOptions struct {
+	// AddWhitespace adds spaces, line breaks and indentation to make the JSON output easy to read.
+	// +optional
+	AddWhitespace *bool `json:"addWhitespace,omitempty"`
+
+	// AlwaysPrintPrimitiveFields prints the primitive fields set to their default value,
+	// which are omitted by default.
+	// +optional
+	AlwaysPrintPrimitiveFields *bool `json:"alwaysPrintPrimitiveFields,omitempty"`
+
+	// AlwaysPrintEnumsAsInts prints enums as integers instead of strings.
+	// +optional
+	AlwaysPrintEnumsAsInts *bool `json:"alwaysPrintEnumsAsInts,omitempty"`
+
+	// PreserveProtoFieldNames uses the field names of the proto definitions instead of
+	// their lowerCamelCase JSON names.
+	// +optional
+	PreserveProtoFieldNames *bool `json:"preserveProtoFieldNames,omitempty"`
+
+	// StreamNewlineDelimited prints the messages of server streaming methods as newline delimited
+	// JSON objects instead of a JSON array.
+	// +optional
+	StreamNewlineDelimited *bool `json:"streamNewlineDelimited,omitempty"`
+}
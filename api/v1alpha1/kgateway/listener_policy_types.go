@@ -111,6 +111,14 @@ type ListenerConfig struct {
 	// that should map 1-to-1 with a given HTTP listener, such as the Envoy health check HTTP filter.
 	// +optional
 	HTTPSettings *HTTPSettings `json:"httpSettings,omitempty"`
+
+	// HTTP3 enables HTTP/3 (QUIC) for HTTPS listeners. When set, a QUIC listener is created on the
+	// same port using UDP, reusing the certificates and routes of the HTTPS listener, and responses
+	// served over TCP advertise it to clients with an `alt-svc` header.
+	// This setting has no effect on listeners that do not use the HTTPS protocol.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/http/http3
+	// +optional
+	HTTP3 *HTTP3Config `json:"http3,omitempty"`
 }
 
 type ListenerDefaultConfig struct {
@@ -132,6 +140,17 @@ type ListenerDefaultConfig struct {
 	ListenerConfig `json:",inline"`
 }
 
+// HTTP3Config configures HTTP/3 (QUIC) support for HTTPS listeners.
+// The presence of this configuration enables HTTP/3.
+type HTTP3Config struct {
+	// AltSvcMaxAge is the duration for which clients may cache the HTTP/3 `alt-svc` advertisement.
+	// Defaults to 24h.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s')",message="altSvcMaxAge must be at least 1 second"
+	AltSvcMaxAge *metav1.Duration `json:"altSvcMaxAge,omitempty"`
+}
+
 // ProxyProtocolConfig configures the PROXY protocol listener filter.
 // The presence of this configuration enables PROXY protocol support.
 type ProxyProtocolConfig struct {
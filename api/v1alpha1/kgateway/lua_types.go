@@ -0,0 +1,47 @@
+package kgateway
+
+import (
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// Lua configures a Lua script that is run on requests and responses.
+// The script must define an `envoy_on_request` and/or `envoy_on_response` function.
+// See https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/lua_filter
+// for the API available to the script.
+//
+// +kubebuilder:validation:ExactlyOneOf=inline;configMapRef;disable
+type Lua struct {
+	// Inline is the Lua source code of the script.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=65536
+	Inline *string `json:"inline,omitempty"`
+
+	// ConfigMapRef references a ConfigMap key containing the Lua source code of the script.
+	// +optional
+	ConfigMapRef *ConfigMapKeyReference `json:"configMapRef,omitempty"`
+
+	// Disable the Lua filter.
+	// Can be used to disable Lua policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// ConfigMapKeyReference identifies a key in a Kubernetes ConfigMap.
+type ConfigMapKeyReference struct {
+	// Name of the ConfigMap.
+	// +required
+	Name gwv1.ObjectName `json:"name"`
+
+	// Namespace of the ConfigMap. If not specified, defaults to the namespace of the referencing policy.
+	// Note that a ConfigMap in a different namespace requires a ReferenceGrant to be accessible.
+	// +optional
+	Namespace *gwv1.Namespace `json:"namespace,omitempty"`
+
+	// Key in the ConfigMap that contains the data.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
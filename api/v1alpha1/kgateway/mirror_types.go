@@ -0,0 +1,64 @@
+package kgateway
+
+import (
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// MirrorPolicy configures the mirroring of requests to additional backends, e.g., to shadow
+// production traffic to a new version of a service. Mirrored requests are sent in a fire-and-forget
+// manner, and their responses are ignored.
+//
+// Mirrors are not combined across the levels of the config hierarchy: mirrors configured for a
+// route, either by a policy or by an HTTPRoute RequestMirror filter, replace the mirrors configured
+// for its Gateway or listener.
+//
+// +kubebuilder:validation:ExactlyOneOf=targets;disable
+type MirrorPolicy struct {
+	// Targets lists the backends to mirror requests to.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Targets []MirrorTarget `json:"targets,omitempty"`
+
+	// Disable request mirroring.
+	// Can be used to disable mirroring policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// MirrorTarget configures the mirroring of requests to a backend.
+// +kubebuilder:validation:AtMostOneOf=percent;fraction
+type MirrorTarget struct {
+	// BackendRef references the backend to mirror requests to.
+	// It can reference a Service or a kgateway Backend, e.g., of type Static or AWS.
+	// +required
+	BackendRef gwv1.BackendObjectReference `json:"backendRef"`
+
+	// Percent is the percentage of requests to mirror. Defaults to 100 if neither
+	// Percent nor Fraction is set.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percent *int32 `json:"percent,omitempty"`
+
+	// Fraction is the fraction of requests to mirror, for percentages that require more
+	// precision than Percent.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.numerator <= (has(self.denominator) ? self.denominator : 100)",message="numerator must be less than or equal to denominator"
+	Fraction *gwv1.Fraction `json:"fraction,omitempty"`
+
+	// RuntimeKey is the key of an Envoy runtime value that overrides the percentage of requests
+	// to mirror, allowing operators to adjust it at runtime without updating the policy.
+	// See https://www.envoyproxy.io/docs/envoy/latest/configuration/operations/runtime
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	RuntimeKey *string `json:"runtimeKey,omitempty"`
+
+	// TraceSampled specifies whether the trace spans of mirrored requests are sampled.
+	// Set it to false to prevent mirrored requests from being marked as sampled, e.g., to
+	// avoid doubling the volume of traces. Defaults to true.
+	// +optional
+	TraceSampled *bool `json:"traceSampled,omitempty"`
+}
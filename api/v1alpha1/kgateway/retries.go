@@ -10,9 +10,24 @@ import (
 // +kubebuilder:validation:Enum={"5xx",gateway-error,reset,reset-before-request,connect-failure,envoy-ratelimited,retriable-4xx,refused-stream,retriable-status-codes,http3-post-connect-failure,cancelled,deadline-exceeded,internal,resource-exhausted,unavailable}
 type RetryOnCondition string
 
+// RetryHostPredicate specifies a predicate used to reject a host selected during a retry attempt.
+//
+// +kubebuilder:validation:Enum=PreviousHosts;OmitCanaryHosts
+type RetryHostPredicate string
+
+const (
+	// RetryHostPredicatePreviousHosts rejects hosts that have already been attempted for the request.
+	RetryHostPredicatePreviousHosts RetryHostPredicate = "PreviousHosts"
+
+	// RetryHostPredicateOmitCanaryHosts rejects hosts that are marked as canary hosts.
+	RetryHostPredicateOmitCanaryHosts RetryHostPredicate = "OmitCanaryHosts"
+)
+
 // Retry defines the retry policy
 //
 // +kubebuilder:validation:XValidation:rule="has(self.retryOn) || has(self.statusCodes)",message="retryOn or statusCodes must be set."
+// +kubebuilder:validation:XValidation:rule="has(self.hedge) && has(self.hedge.hedgeOnPerTryTimeout) && self.hedge.hedgeOnPerTryTimeout ? has(self.perTryTimeout) : true",message="perTryTimeout must be set when hedge.hedgeOnPerTryTimeout is enabled."
+// +kubebuilder:validation:XValidation:rule="has(self.hostSelectionMaxAttempts) ? has(self.hostPredicates) : true",message="hostSelectionMaxAttempts requires hostPredicates to be set."
 type Retry struct {
 	// RetryOn specifies the conditions under which a retry should be attempted.
 	// +optional
@@ -58,4 +73,67 @@ type Retry struct {
 	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
 	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="retry.backoffBaseInterval must be at least 1ms."
 	BackoffBaseInterval *metav1.Duration `json:"backoffBaseInterval,omitempty"`
+
+	// PerTryIdleTimeout specifies the idle timeout per retry attempt (including the initial attempt).
+	// The timer is reset each time bytes are received from the upstream, so this can be used to retry
+	// requests whose upstream stalls after a response has started without bounding the total duration
+	// of each attempt.
+	// It is specified as a sequence of decimal numbers, each with optional fraction and a unit suffix, such as "1s" or "500ms".
+	// +optional
+	//
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="retry.perTryIdleTimeout must be at least 1ms."
+	PerTryIdleTimeout *metav1.Duration `json:"perTryIdleTimeout,omitempty"`
+
+	// HostPredicates specifies the predicates used to reject a host selected for a retry attempt.
+	// When a host is rejected, host selection is reattempted up to HostSelectionMaxAttempts times.
+	// For example, PreviousHosts makes retries prefer hosts that have not already been attempted.
+	// +optional
+	//
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	HostPredicates []RetryHostPredicate `json:"hostPredicates,omitempty"`
+
+	// HostSelectionMaxAttempts specifies the maximum number of times host selection is reattempted
+	// when a selected host is rejected by HostPredicates, before the last selected host is used.
+	// Defaults to 1 if not set.
+	// +optional
+	//
+	// +kubebuilder:validation:Minimum=1
+	HostSelectionMaxAttempts *int32 `json:"hostSelectionMaxAttempts,omitempty"`
+
+	// Hedge specifies the request hedging policy.
+	// Hedging sends additional requests to the upstream to reduce tail latency, returning the first
+	// response that arrives.
+	// The hedging policy is not applied to routes whose retry policy is set by the HTTPRoute
+	// retry field, which takes precedence over this retry policy.
+	// +optional
+	Hedge *RetryHedgePolicy `json:"hedge,omitempty"`
+}
+
+// RetryHedgePolicy defines the request hedging policy.
+type RetryHedgePolicy struct {
+	// InitialRequests specifies the number of initial requests that are sent to the upstream.
+	// Defaults to 1 if not set.
+	// +optional
+	//
+	// +kubebuilder:validation:Minimum=1
+	InitialRequests *int32 `json:"initialRequests,omitempty"`
+
+	// AdditionalRequestPercentage specifies the percentage of requests for which an additional
+	// initial request is sent to the upstream, on top of InitialRequests.
+	// Defaults to 0 if not set.
+	// +optional
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	AdditionalRequestPercentage *int32 `json:"additionalRequestPercentage,omitempty"`
+
+	// HedgeOnPerTryTimeout specifies whether a new request is sent to the upstream when the
+	// per-try timeout elapses, without canceling the in-flight request. The first response to arrive
+	// is returned downstream.
+	// Requires PerTryTimeout to be set.
+	// +optional
+	HedgeOnPerTryTimeout *bool `json:"hedgeOnPerTryTimeout,omitempty"`
 }
@@ -150,6 +150,48 @@ type TrafficPolicySpec struct {
 	// malicious social engineering.
 	// +optional
 	OAuth2 *OAuth2Policy `json:"oauth2,omitempty"`
+
+	// FaultInjection specifies the fault injection configuration for the policy.
+	// This can be used to inject delays and aborts into requests to test the resiliency of services.
+	// +optional
+	FaultInjection *FaultInjection `json:"faultInjection,omitempty"`
+
+	// Lua specifies a Lua script to run on requests and responses for the policy.
+	// This can be used for request and response manipulation that cannot be expressed with Transformation.
+	// +optional
+	Lua *Lua `json:"lua,omitempty"`
+
+	// Wasm specifies the WebAssembly (Wasm) filters to run on requests and responses for the policy.
+	// The Wasm modules are configured using GatewayExtensions of type Wasm.
+	// +optional
+	Wasm *WasmPolicy `json:"wasm,omitempty"`
+
+	// Cache configures caching of responses for the policy.
+	// +optional
+	Cache *ResponseCache `json:"cache,omitempty"`
+
+	// Mirror configures the mirroring of requests to additional backends.
+	// +optional
+	Mirror *MirrorPolicy `json:"mirror,omitempty"`
+
+	// WAF configures a web application firewall inspecting requests with SecLang rules,
+	// e.g., the OWASP Core Rule Set.
+	// +optional
+	WAF *WAF `json:"waf,omitempty"`
+
+	// GRPCJSONTranscoder transcodes RESTful JSON requests to requests to gRPC services,
+	// so that gRPC backends can be called by REST clients without a separate transcoding proxy.
+	// +optional
+	GRPCJSONTranscoder *GRPCJSONTranscoder `json:"grpcJsonTranscoder,omitempty"`
+
+	// GRPCWeb enables the translation of gRPC-Web requests, e.g., from browsers, to gRPC requests.
+	// Set it to false to disable gRPC-Web enabled at a higher level in the config hierarchy.
+	// +optional
+	GRPCWeb *bool `json:"grpcWeb,omitempty"`
+
+	// CustomResponse replaces responses with specific status codes, e.g., to serve custom error pages.
+	// +optional
+	CustomResponse *CustomResponse `json:"customResponse,omitempty"`
 }
 
 // URLRewrite specifies URL rewrite rules using regular expressions.
@@ -0,0 +1,96 @@
+package kgateway
+
+import (
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// WAF configures a web application firewall inspecting requests with SecLang rules,
+// e.g., the OWASP Core Rule Set (CRS). The rules are enforced at the WAF stage of the
+// filter chain by a Coraza-based Wasm module, such as coraza-proxy-wasm, configured using
+// a GatewayExtension of type Wasm.
+//
+// The module is passed the SecLang directives of the policy. The rules matched by a request are
+// logged by the module to the proxy logs, with their IDs, for the rules using the `log` action
+// as the CRS rules do.
+//
+// WAF policies are not combined across the levels of the config hierarchy: a WAF policy applied
+// to a route replaces the WAF policy applied to its Gateway or listener. To exclude rules for a
+// route, apply a WAF policy loading the same rule sets, e.g., from the same ConfigMaps, with the
+// rule exclusions of the route.
+//
+// +kubebuilder:validation:ExactlyOneOf=ruleSets;disable
+// +kubebuilder:validation:XValidation:rule="has(self.ruleSets) == has(self.extensionRef)",message="extensionRef must be set if and only if ruleSets is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.disable) || (!has(self.ruleExclusions) && !has(self.mode))",message="mode and ruleExclusions cannot be set when disable is set"
+type WAF struct {
+	// ExtensionRef references the GatewayExtension of type Wasm providing the Coraza-based Wasm module.
+	// The configuration and stage of the GatewayExtension are ignored, the module being configured
+	// with the rules of the policy and run at the WAF stage.
+	// +optional
+	ExtensionRef *shared.NamespacedObjectReference `json:"extensionRef,omitempty"`
+
+	// RuleSets are the SecLang rule sets to load, in order.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	RuleSets []WAFRuleSet `json:"ruleSets,omitempty"`
+
+	// Mode is the mode of the WAF. Defaults to Block.
+	// +optional
+	// +kubebuilder:validation:Enum=Block;DetectionOnly
+	Mode *WAFMode `json:"mode,omitempty"`
+
+	// RuleExclusions are the rules of the rule sets that are not evaluated for the policy targets,
+	// e.g., to work around false positives of a route.
+	// +optional
+	RuleExclusions *WAFRuleExclusions `json:"ruleExclusions,omitempty"`
+
+	// Disable the WAF.
+	// Can be used to disable WAF policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// WAFRuleSet is a set of SecLang directives.
+// +kubebuilder:validation:ExactlyOneOf=inline;configMapRef
+type WAFRuleSet struct {
+	// Inline are the SecLang directives of the rule set.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=65536
+	Inline *string `json:"inline,omitempty"`
+
+	// ConfigMapRef references a ConfigMap key containing the SecLang directives of the rule set,
+	// e.g., the rules of the OWASP Core Rule Set.
+	// +optional
+	ConfigMapRef *ConfigMapKeyReference `json:"configMapRef,omitempty"`
+}
+
+// WAFMode is the mode of the WAF.
+type WAFMode string
+
+const (
+	// WAFModeBlock blocks the requests matching the rules, with the status of the rule disruptive action.
+	WAFModeBlock WAFMode = "Block"
+	// WAFModeDetectionOnly evaluates the rules and records the matches without blocking any request.
+	WAFModeDetectionOnly WAFMode = "DetectionOnly"
+)
+
+// WAFRuleExclusions selects the rules excluded from evaluation.
+// +kubebuilder:validation:AtLeastOneOf=ids;tags
+type WAFRuleExclusions struct {
+	// IDs are the IDs of the excluded rules, either a single ID, e.g., `942100`,
+	// or an inclusive range of IDs, e.g., `942100-942199`.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:Pattern=`^[0-9]+(-[0-9]+)?$`
+	IDs []string `json:"ids,omitempty"`
+
+	// Tags excludes the rules having any of the tags, e.g., `attack-sqli`.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:Pattern=`^[^\s"]+$`
+	Tags []string `json:"tags,omitempty"`
+}
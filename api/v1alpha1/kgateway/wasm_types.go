@@ -0,0 +1,158 @@
+package kgateway
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// WasmProvider configures a WebAssembly (Wasm) HTTP filter.
+// See https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/wasm_filter
+// for details on the Envoy Wasm filter.
+type WasmProvider struct {
+	// Module is the source of the Wasm module.
+	// +required
+	Module WasmModule `json:"module"`
+
+	// Config is the plugin configuration passed to the Wasm module.
+	// It is serialized to a JSON string before being passed to the module.
+	// +optional
+	Config *runtime.RawExtension `json:"config,omitempty"`
+
+	// RootID is the root context ID of the plugin within the Wasm module.
+	// Must be set if the module contains more than one plugin.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	RootID *string `json:"rootID,omitempty"`
+
+	// Runtime is the Wasm runtime used to run the module. Defaults to V8.
+	// +optional
+	// +kubebuilder:validation:Enum=V8;Wamr;Wasmtime
+	Runtime *WasmRuntime `json:"runtime,omitempty"`
+
+	// Stage is the stage of the HTTP filter chain at which the Wasm filter is placed.
+	// Defaults to the Accepted stage, i.e., after authentication, authorization and rate limiting.
+	// +optional
+	Stage *FilterStage `json:"stage,omitempty"`
+
+	// FailOpen allows requests to continue when the Wasm plugin fails to load or crashes.
+	// By default, requests fail with a 503 response in that case.
+	// +optional
+	FailOpen *bool `json:"failOpen,omitempty"`
+}
+
+// WasmModule is the source of a Wasm module.
+// The modules of ConfigMaps and images are served to the proxies by the controller, on the Wasm port
+// of the controller Service. The proxies authenticate with their xDS token, unless xDS authentication
+// is disabled, and the modules are served over TLS when xDS TLS is enabled.
+// +kubebuilder:validation:ExactlyOneOf=configMapRef;localFile;image
+type WasmModule struct {
+	// ConfigMapRef references a ConfigMap key containing the Wasm module.
+	// The module is read from the binaryData of the ConfigMap, falling back to data.
+	// Note that ConfigMaps are limited to 1MiB in size. The module is served to the proxy
+	// by the controller.
+	// +optional
+	ConfigMapRef *ConfigMapKeyReference `json:"configMapRef,omitempty"`
+
+	// LocalFile is the path of the Wasm module on the proxy filesystem,
+	// e.g., a module mounted into the proxy container.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	LocalFile *string `json:"localFile,omitempty"`
+
+	// Image references an OCI image containing the Wasm module.
+	// The image is pulled by the controller in the background and the module, limited to 32MiB,
+	// is served to the proxy by the controller. The GatewayExtension reports an error until
+	// the image has been pulled.
+	// +optional
+	Image *WasmImage `json:"image,omitempty"`
+}
+
+// WasmImage references an OCI image containing a Wasm module.
+// Both the Wasm OCI artifact format, with a single layer of media type
+// `application/vnd.module.wasm.content.layer.v1+wasm`, and images with a single
+// compressed tar layer containing a `plugin.wasm` file are supported.
+type WasmImage struct {
+	// Reference is the reference of the image, e.g., `ghcr.io/org/plugin:v1` or
+	// `ghcr.io/org/plugin@sha256:...`. Referencing the image by digest is recommended,
+	// as tags are only resolved again every 5 minutes.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Reference string `json:"reference"`
+
+	// PullSecretRef references a Secret of type `kubernetes.io/dockerconfigjson` in the namespace
+	// of the GatewayExtension, containing the credentials to pull the image.
+	// +optional
+	PullSecretRef *corev1.LocalObjectReference `json:"pullSecretRef,omitempty"`
+}
+
+// WasmRuntime is the runtime used to run a Wasm module.
+type WasmRuntime string
+
+const (
+	// WasmRuntimeV8 runs the module with the V8 engine.
+	WasmRuntimeV8 WasmRuntime = "V8"
+	// WasmRuntimeWamr runs the module with the WebAssembly Micro Runtime.
+	WasmRuntimeWamr WasmRuntime = "Wamr"
+	// WasmRuntimeWasmtime runs the module with Wasmtime.
+	WasmRuntimeWasmtime WasmRuntime = "Wasmtime"
+)
+
+// FilterStage is a position in the HTTP filter chain, relative to one of the
+// well-known stages of the filter chain.
+type FilterStage struct {
+	// Name is the well-known stage the filter is placed relative to.
+	// The stages are ordered as follows: Fault, Cors, WAF, AuthN, AuthZ, RateLimit,
+	// Cache, Accepted, OutAuth, Route.
+	// +required
+	// +kubebuilder:validation:Enum=Fault;Cors;WAF;AuthN;AuthZ;RateLimit;Cache;Accepted;OutAuth;Route
+	Name FilterStageName `json:"name"`
+
+	// Predicate places the filter before, during or after the stage. Defaults to During.
+	// +optional
+	// +kubebuilder:validation:Enum=Before;During;After
+	Predicate *FilterStagePredicate `json:"predicate,omitempty"`
+}
+
+// FilterStageName is the name of a well-known stage of the HTTP filter chain.
+type FilterStageName string
+
+const (
+	FilterStageFault     FilterStageName = "Fault"
+	FilterStageCors      FilterStageName = "Cors"
+	FilterStageWAF       FilterStageName = "WAF"
+	FilterStageAuthN     FilterStageName = "AuthN"
+	FilterStageAuthZ     FilterStageName = "AuthZ"
+	FilterStageRateLimit FilterStageName = "RateLimit"
+	FilterStageCache     FilterStageName = "Cache"
+	FilterStageAccepted  FilterStageName = "Accepted"
+	FilterStageOutAuth   FilterStageName = "OutAuth"
+	FilterStageRoute     FilterStageName = "Route"
+)
+
+// FilterStagePredicate places a filter relative to a stage of the HTTP filter chain.
+type FilterStagePredicate string
+
+const (
+	FilterStagePredicateBefore FilterStagePredicate = "Before"
+	FilterStagePredicateDuring FilterStagePredicate = "During"
+	FilterStagePredicateAfter  FilterStagePredicate = "After"
+)
+
+// WasmPolicy enables the Wasm filters configured by GatewayExtensions for the policy targets.
+// +kubebuilder:validation:ExactlyOneOf=extensionRefs;disable
+type WasmPolicy struct {
+	// ExtensionRefs references the GatewayExtensions of type Wasm to run for the policy.
+	// The filters run in the order of their stage in the filter chain, not in the order listed.
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	ExtensionRefs []shared.NamespacedObjectReference `json:"extensionRefs,omitempty"`
+
+	// Disable the Wasm filters.
+	// Can be used to disable Wasm policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
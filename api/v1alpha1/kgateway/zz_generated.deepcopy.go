@@ -7,9 +7,11 @@ package kgateway
 import (
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
@@ -745,6 +747,111 @@ func (in *CSRFPolicy) DeepCopy() *CSRFPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheKey) DeepCopyInto(out *CacheKey) {
+	*out = *in
+	if in.ExcludeScheme != nil {
+		in, out := &in.ExcludeScheme, &out.ExcludeScheme
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExcludeHost != nil {
+		in, out := &in.ExcludeHost, &out.ExcludeHost
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncludedQueryParameters != nil {
+		in, out := &in.IncludedQueryParameters, &out.IncludedQueryParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedQueryParameters != nil {
+		in, out := &in.ExcludedQueryParameters, &out.ExcludedQueryParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheKey.
+func (in *CacheKey) DeepCopy() *CacheKey {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheStorage) DeepCopyInto(out *CacheStorage) {
+	*out = *in
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(MemoryCacheStorage)
+		**out = **in
+	}
+	if in.FileSystem != nil {
+		in, out := &in.FileSystem, &out.FileSystem
+		*out = new(FileSystemCacheStorage)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheStorage.
+func (in *CacheStorage) DeepCopy() *CacheStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreakerThresholds) DeepCopyInto(out *CircuitBreakerThresholds) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPendingRequests != nil {
+		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRequests != nil {
+		in, out := &in.MaxRequests, &out.MaxRequests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryBudget != nil {
+		in, out := &in.RetryBudget, &out.RetryBudget
+		*out = new(RetryBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxConnectionPools != nil {
+		in, out := &in.MaxConnectionPools, &out.MaxConnectionPools
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakerThresholds.
+func (in *CircuitBreakerThresholds) DeepCopy() *CircuitBreakerThresholds {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreakerThresholds)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreakers) DeepCopyInto(out *CircuitBreakers) {
 	*out = *in
@@ -768,6 +875,26 @@ func (in *CircuitBreakers) DeepCopyInto(out *CircuitBreakers) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.RetryBudget != nil {
+		in, out := &in.RetryBudget, &out.RetryBudget
+		*out = new(RetryBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxConnectionPools != nil {
+		in, out := &in.MaxConnectionPools, &out.MaxConnectionPools
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HighPriority != nil {
+		in, out := &in.HighPriority, &out.HighPriority
+		*out = new(CircuitBreakerThresholds)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrackRemaining != nil {
+		in, out := &in.TrackRemaining, &out.TrackRemaining
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakers.
@@ -941,6 +1068,26 @@ func (in *Compression) DeepCopy() *Compression {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyReference) DeepCopyInto(out *ConfigMapKeyReference) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(apisv1.Namespace)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyReference.
+func (in *ConfigMapKeyReference) DeepCopy() *ConfigMapKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cookie) DeepCopyInto(out *Cookie) {
 	*out = *in
@@ -1117,6 +1264,125 @@ func (in *CustomAttributeMetadata) DeepCopy() *CustomAttributeMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomLocalResponse) DeepCopyInto(out *CustomLocalResponse) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]apisv1.HTTPHeader, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomLocalResponse.
+func (in *CustomLocalResponse) DeepCopy() *CustomLocalResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomLocalResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomResponse) DeepCopyInto(out *CustomResponse) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]CustomResponseRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResponse.
+func (in *CustomResponse) DeepCopy() *CustomResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomResponseRedirect) DeepCopyInto(out *CustomResponseRedirect) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]apisv1.HTTPHeader, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResponseRedirect.
+func (in *CustomResponseRedirect) DeepCopy() *CustomResponseRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomResponseRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomResponseRule) DeepCopyInto(out *CustomResponseRule) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]StatusCodeRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LocalResponse != nil {
+		in, out := &in.LocalResponse, &out.LocalResponse
+		*out = new(CustomLocalResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(CustomResponseRedirect)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResponseRule.
+func (in *CustomResponseRule) DeepCopy() *CustomResponseRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomResponseRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DirectResponse) DeepCopyInto(out *DirectResponse) {
 	*out = *in
@@ -1144,6 +1410,22 @@ func (in *DirectResponse) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectResponseBodySource) DeepCopyInto(out *DirectResponseBodySource) {
+	*out = *in
+	in.ConfigMapRef.DeepCopyInto(&out.ConfigMapRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseBodySource.
+func (in *DirectResponseBodySource) DeepCopy() *DirectResponseBodySource {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectResponseBodySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DirectResponseList) DeepCopyInto(out *DirectResponseList) {
 	*out = *in
@@ -1184,6 +1466,21 @@ func (in *DirectResponseSpec) DeepCopyInto(out *DirectResponseSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.BodyFrom != nil {
+		in, out := &in.BodyFrom, &out.BodyFrom
+		*out = new(DirectResponseBodySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]apisv1.HTTPHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.FormatBody != nil {
+		in, out := &in.FormatBody, &out.FormatBody
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseSpec.
@@ -1649,65 +1946,242 @@ func (in *ExtSvcRetryPolicy) DeepCopy() *ExtSvcRetryPolicy {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FileSink) DeepCopyInto(out *FileSink) {
+func (in *FaultAbort) DeepCopyInto(out *FaultAbort) {
 	*out = *in
-	if in.StringFormat != nil {
-		in, out := &in.StringFormat, &out.StringFormat
-		*out = new(string)
+	if in.HTTPStatus != nil {
+		in, out := &in.HTTPStatus, &out.HTTPStatus
+		*out = new(int32)
 		**out = **in
 	}
-	if in.JsonFormat != nil {
-		in, out := &in.JsonFormat, &out.JsonFormat
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	if in.GRPCStatus != nil {
+		in, out := &in.GRPCStatus, &out.GRPCStatus
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FromHeader != nil {
+		in, out := &in.FromHeader, &out.FromHeader
+		*out = new(FaultHeaderActivation)
+		**out = **in
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSink.
-func (in *FileSink) DeepCopy() *FileSink {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultAbort.
+func (in *FaultAbort) DeepCopy() *FaultAbort {
 	if in == nil {
 		return nil
 	}
-	out := new(FileSink)
+	out := new(FaultAbort)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FilterType) DeepCopyInto(out *FilterType) {
+func (in *FaultDelay) DeepCopyInto(out *FaultDelay) {
 	*out = *in
-	if in.StatusCodeFilter != nil {
-		in, out := &in.StatusCodeFilter, &out.StatusCodeFilter
-		*out = new(StatusCodeFilter)
-		**out = **in
-	}
-	if in.DurationFilter != nil {
-		in, out := &in.DurationFilter, &out.DurationFilter
-		*out = new(DurationFilter)
+	if in.FixedDelay != nil {
+		in, out := &in.FixedDelay, &out.FixedDelay
+		*out = new(v1.Duration)
 		**out = **in
 	}
-	if in.NotHealthCheckFilter != nil {
-		in, out := &in.NotHealthCheckFilter, &out.NotHealthCheckFilter
-		*out = new(bool)
+	if in.FromHeader != nil {
+		in, out := &in.FromHeader, &out.FromHeader
+		*out = new(FaultHeaderActivation)
 		**out = **in
 	}
-	if in.TraceableFilter != nil {
-		in, out := &in.TraceableFilter, &out.TraceableFilter
-		*out = new(bool)
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
 		**out = **in
 	}
-	if in.HeaderFilter != nil {
-		in, out := &in.HeaderFilter, &out.HeaderFilter
-		*out = new(HeaderFilter)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ResponseFlagFilter != nil {
-		in, out := &in.ResponseFlagFilter, &out.ResponseFlagFilter
-		*out = new(ResponseFlagFilter)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultDelay.
+func (in *FaultDelay) DeepCopy() *FaultDelay {
+	if in == nil {
+		return nil
 	}
-	if in.GrpcStatusFilter != nil {
-		in, out := &in.GrpcStatusFilter, &out.GrpcStatusFilter
+	out := new(FaultDelay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultHeaderActivation) DeepCopyInto(out *FaultHeaderActivation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultHeaderActivation.
+func (in *FaultHeaderActivation) DeepCopy() *FaultHeaderActivation {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultHeaderActivation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjection) DeepCopyInto(out *FaultInjection) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(FaultDelay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Abort != nil {
+		in, out := &in.Abort, &out.Abort
+		*out = new(FaultAbort)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]apisv1.HTTPHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxActiveFaults != nil {
+		in, out := &in.MaxActiveFaults, &out.MaxActiveFaults
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjection.
+func (in *FaultInjection) DeepCopy() *FaultInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSink) DeepCopyInto(out *FileSink) {
+	*out = *in
+	if in.StringFormat != nil {
+		in, out := &in.StringFormat, &out.StringFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.JsonFormat != nil {
+		in, out := &in.JsonFormat, &out.JsonFormat
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSink.
+func (in *FileSink) DeepCopy() *FileSink {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSystemCacheStorage) DeepCopyInto(out *FileSystemCacheStorage) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxEntrySize != nil {
+		in, out := &in.MaxEntrySize, &out.MaxEntrySize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxEntries != nil {
+		in, out := &in.MaxEntries, &out.MaxEntries
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ThreadCount != nil {
+		in, out := &in.ThreadCount, &out.ThreadCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemCacheStorage.
+func (in *FileSystemCacheStorage) DeepCopy() *FileSystemCacheStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSystemCacheStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterStage) DeepCopyInto(out *FilterStage) {
+	*out = *in
+	if in.Predicate != nil {
+		in, out := &in.Predicate, &out.Predicate
+		*out = new(FilterStagePredicate)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterStage.
+func (in *FilterStage) DeepCopy() *FilterStage {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterType) DeepCopyInto(out *FilterType) {
+	*out = *in
+	if in.StatusCodeFilter != nil {
+		in, out := &in.StatusCodeFilter, &out.StatusCodeFilter
+		*out = new(StatusCodeFilter)
+		**out = **in
+	}
+	if in.DurationFilter != nil {
+		in, out := &in.DurationFilter, &out.DurationFilter
+		*out = new(DurationFilter)
+		**out = **in
+	}
+	if in.NotHealthCheckFilter != nil {
+		in, out := &in.NotHealthCheckFilter, &out.NotHealthCheckFilter
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TraceableFilter != nil {
+		in, out := &in.TraceableFilter, &out.TraceableFilter
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HeaderFilter != nil {
+		in, out := &in.HeaderFilter, &out.HeaderFilter
+		*out = new(HeaderFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResponseFlagFilter != nil {
+		in, out := &in.ResponseFlagFilter, &out.ResponseFlagFilter
+		*out = new(ResponseFlagFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GrpcStatusFilter != nil {
+		in, out := &in.GrpcStatusFilter, &out.GrpcStatusFilter
 		*out = new(GrpcStatusFilter)
 		(*in).DeepCopyInto(*out)
 	}
@@ -1728,6 +2202,81 @@ func (in *FilterType) DeepCopy() *FilterType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONPrintOptions) DeepCopyInto(out *GRPCJSONPrintOptions) {
+	*out = *in
+	if in.AddWhitespace != nil {
+		in, out := &in.AddWhitespace, &out.AddWhitespace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AlwaysPrintPrimitiveFields != nil {
+		in, out := &in.AlwaysPrintPrimitiveFields, &out.AlwaysPrintPrimitiveFields
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AlwaysPrintEnumsAsInts != nil {
+		in, out := &in.AlwaysPrintEnumsAsInts, &out.AlwaysPrintEnumsAsInts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreserveProtoFieldNames != nil {
+		in, out := &in.PreserveProtoFieldNames, &out.PreserveProtoFieldNames
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StreamNewlineDelimited != nil {
+		in, out := &in.StreamNewlineDelimited, &out.StreamNewlineDelimited
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONPrintOptions.
+func (in *GRPCJSONPrintOptions) DeepCopy() *GRPCJSONPrintOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONPrintOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoder) DeepCopyInto(out *GRPCJSONTranscoder) {
+	*out = *in
+	if in.DescriptorSet != nil {
+		in, out := &in.DescriptorSet, &out.DescriptorSet
+		*out = new(ProtoDescriptorSet)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrintOptions != nil {
+		in, out := &in.PrintOptions, &out.PrintOptions
+		*out = new(GRPCJSONPrintOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoder.
+func (in *GRPCJSONTranscoder) DeepCopy() *GRPCJSONTranscoder {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GatewayExtension) DeepCopyInto(out *GatewayExtension) {
 	*out = *in
@@ -1820,6 +2369,11 @@ func (in *GatewayExtensionSpec) DeepCopyInto(out *GatewayExtensionSpec) {
 		*out = new(OAuth2Provider)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Wasm != nil {
+		in, out := &in.Wasm, &out.Wasm
+		*out = new(WasmProvider)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayExtensionSpec.
@@ -2068,6 +2622,26 @@ func (in *GrpcStatusFilter) DeepCopy() *GrpcStatusFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTP3Config) DeepCopyInto(out *HTTP3Config) {
+	*out = *in
+	if in.AltSvcMaxAge != nil {
+		in, out := &in.AltSvcMaxAge, &out.AltSvcMaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTP3Config.
+func (in *HTTP3Config) DeepCopy() *HTTP3Config {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTP3Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPListenerPolicy) DeepCopyInto(out *HTTPListenerPolicy) {
 	*out = *in
@@ -2861,6 +3435,16 @@ func (in *KubernetesProxyConfig) DeepCopyInto(out *KubernetesProxyConfig) {
 		*out = new(ProxyDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(ProxyAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisruptionBudget != nil {
+		in, out := &in.DisruptionBudget, &out.DisruptionBudget
+		*out = new(ProxyDisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EnvoyContainer != nil {
 		in, out := &in.EnvoyContainer, &out.EnvoyContainer
 		*out = new(EnvoyContainer)
@@ -2954,6 +3538,11 @@ func (in *ListenerConfig) DeepCopyInto(out *ListenerConfig) {
 		*out = new(HTTPSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HTTP3 != nil {
+		in, out := &in.HTTP3, &out.HTTP3
+		*out = new(HTTP3Config)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerConfig.
@@ -3146,11 +3735,21 @@ func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
 		*out = new(LocalityType)
 		**out = **in
 	}
+	if in.Locality != nil {
+		in, out := &in.Locality, &out.Locality
+		*out = new(LocalityLoadBalancing)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CloseConnectionsOnHostSetChange != nil {
 		in, out := &in.CloseConnectionsOnHostSetChange, &out.CloseConnectionsOnHostSetChange
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SessionPersistence != nil {
+		in, out := &in.SessionPersistence, &out.SessionPersistence
+		*out = new(apisv1.SessionPersistence)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
@@ -3337,6 +3936,63 @@ func (in *LocalRateLimitPolicy) DeepCopy() *LocalRateLimitPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalityLoadBalancing) DeepCopyInto(out *LocalityLoadBalancing) {
+	*out = *in
+	if in.ZoneAware != nil {
+		in, out := &in.ZoneAware, &out.ZoneAware
+		*out = new(ZoneAwareRouting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailoverPriority != nil {
+		in, out := &in.FailoverPriority, &out.FailoverPriority
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Weights != nil {
+		in, out := &in.Weights, &out.Weights
+		*out = make([]LocalityWeight, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalityLoadBalancing.
+func (in *LocalityLoadBalancing) DeepCopy() *LocalityLoadBalancing {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalityLoadBalancing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalityWeight) DeepCopyInto(out *LocalityWeight) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Subzone != nil {
+		in, out := &in.Subzone, &out.Subzone
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalityWeight.
+func (in *LocalityWeight) DeepCopy() *LocalityWeight {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalityWeight)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogFormat) DeepCopyInto(out *LogFormat) {
 	*out = *in
@@ -3362,6 +4018,51 @@ func (in *LogFormat) DeepCopy() *LogFormat {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Lua) DeepCopyInto(out *Lua) {
+	*out = *in
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeyReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Lua.
+func (in *Lua) DeepCopy() *Lua {
+	if in == nil {
+		return nil
+	}
+	out := new(Lua)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryCacheStorage) DeepCopyInto(out *MemoryCacheStorage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryCacheStorage.
+func (in *MemoryCacheStorage) DeepCopy() *MemoryCacheStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryCacheStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetadataKey) DeepCopyInto(out *MetadataKey) {
 	*out = *in
@@ -3442,6 +4143,69 @@ func (in *MetadataPathSegment) DeepCopy() *MetadataPathSegment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorPolicy) DeepCopyInto(out *MirrorPolicy) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]MirrorTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorPolicy.
+func (in *MirrorPolicy) DeepCopy() *MirrorPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorTarget) DeepCopyInto(out *MirrorTarget) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Fraction != nil {
+		in, out := &in.Fraction, &out.Fraction
+		*out = new(apisv1.Fraction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuntimeKey != nil {
+		in, out := &in.RuntimeKey, &out.RuntimeKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.TraceSampled != nil {
+		in, out := &in.TraceSampled, &out.TraceSampled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorTarget.
+func (in *MirrorTarget) DeepCopy() *MirrorTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamedJWTProvider) DeepCopyInto(out *NamedJWTProvider) {
 	*out = *in
@@ -3900,6 +4664,68 @@ func (in *ProcessingMode) DeepCopy() *ProcessingMode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtoDescriptorSet) DeepCopyInto(out *ProtoDescriptorSet) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeyReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretKeyReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtoDescriptorSet.
+func (in *ProtoDescriptorSet) DeepCopy() *ProtoDescriptorSet {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtoDescriptorSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyAutoscaling) DeepCopyInto(out *ProxyAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetMemoryUtilizationPercentage != nil {
+		in, out := &in.TargetMemoryUtilizationPercentage, &out.TargetMemoryUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]v2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyAutoscaling.
+func (in *ProxyAutoscaling) DeepCopy() *ProxyAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyDeployment) DeepCopyInto(out *ProxyDeployment) {
 	*out = *in
@@ -3925,6 +4751,31 @@ func (in *ProxyDeployment) DeepCopy() *ProxyDeployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyDisruptionBudget) DeepCopyInto(out *ProxyDisruptionBudget) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyDisruptionBudget.
+func (in *ProxyDisruptionBudget) DeepCopy() *ProxyDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyDisruptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyProtocolConfig) DeepCopyInto(out *ProxyProtocolConfig) {
 	*out = *in
@@ -4128,6 +4979,53 @@ func (in *ResourceDetector) DeepCopy() *ResourceDetector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResponseCache) DeepCopyInto(out *ResponseCache) {
+	*out = *in
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(CacheStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedVaryHeaders != nil {
+		in, out := &in.AllowedVaryHeaders, &out.AllowedVaryHeaders
+		*out = make([]shared.StringMatcher, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(CacheKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxBodySize != nil {
+		in, out := &in.MaxBodySize, &out.MaxBodySize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.IgnoreRequestCacheControl != nil {
+		in, out := &in.IgnoreRequestCacheControl, &out.IgnoreRequestCacheControl
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseCache.
+func (in *ResponseCache) DeepCopy() *ResponseCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResponseCompression) DeepCopyInto(out *ResponseCompression) {
 	*out = *in
@@ -4158,68 +5056,143 @@ func (in *ResponseFlagFilter) DeepCopyInto(out *ResponseFlagFilter) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseFlagFilter.
-func (in *ResponseFlagFilter) DeepCopy() *ResponseFlagFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResponseFlagFilter.
+func (in *ResponseFlagFilter) DeepCopy() *ResponseFlagFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ResponseFlagFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Retry) DeepCopyInto(out *Retry) {
+	*out = *in
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]RetryOnCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.PerTryTimeout != nil {
+		in, out := &in.PerTryTimeout, &out.PerTryTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]apisv1.HTTPRouteRetryStatusCode, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackoffBaseInterval != nil {
+		in, out := &in.BackoffBaseInterval, &out.BackoffBaseInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PerTryIdleTimeout != nil {
+		in, out := &in.PerTryIdleTimeout, &out.PerTryIdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HostPredicates != nil {
+		in, out := &in.HostPredicates, &out.HostPredicates
+		*out = make([]RetryHostPredicate, len(*in))
+		copy(*out, *in)
+	}
+	if in.HostSelectionMaxAttempts != nil {
+		in, out := &in.HostSelectionMaxAttempts, &out.HostSelectionMaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Hedge != nil {
+		in, out := &in.Hedge, &out.Hedge
+		*out = new(RetryHedgePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
+func (in *Retry) DeepCopy() *Retry {
+	if in == nil {
+		return nil
+	}
+	out := new(Retry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBackoff) DeepCopyInto(out *RetryBackoff) {
+	*out = *in
+	out.BaseInterval = in.BaseInterval
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBackoff.
+func (in *RetryBackoff) DeepCopy() *RetryBackoff {
 	if in == nil {
 		return nil
 	}
-	out := new(ResponseFlagFilter)
+	out := new(RetryBackoff)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Retry) DeepCopyInto(out *Retry) {
+func (in *RetryBudget) DeepCopyInto(out *RetryBudget) {
 	*out = *in
-	if in.RetryOn != nil {
-		in, out := &in.RetryOn, &out.RetryOn
-		*out = make([]RetryOnCondition, len(*in))
-		copy(*out, *in)
-	}
-	if in.PerTryTimeout != nil {
-		in, out := &in.PerTryTimeout, &out.PerTryTimeout
-		*out = new(v1.Duration)
+	if in.BudgetPercent != nil {
+		in, out := &in.BudgetPercent, &out.BudgetPercent
+		*out = new(int32)
 		**out = **in
 	}
-	if in.StatusCodes != nil {
-		in, out := &in.StatusCodes, &out.StatusCodes
-		*out = make([]apisv1.HTTPRouteRetryStatusCode, len(*in))
-		copy(*out, *in)
-	}
-	if in.BackoffBaseInterval != nil {
-		in, out := &in.BackoffBaseInterval, &out.BackoffBaseInterval
-		*out = new(v1.Duration)
+	if in.MinRetryConcurrency != nil {
+		in, out := &in.MinRetryConcurrency, &out.MinRetryConcurrency
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
-func (in *Retry) DeepCopy() *Retry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBudget.
+func (in *RetryBudget) DeepCopy() *RetryBudget {
 	if in == nil {
 		return nil
 	}
-	out := new(Retry)
+	out := new(RetryBudget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RetryBackoff) DeepCopyInto(out *RetryBackoff) {
+func (in *RetryHedgePolicy) DeepCopyInto(out *RetryHedgePolicy) {
 	*out = *in
-	out.BaseInterval = in.BaseInterval
-	if in.MaxInterval != nil {
-		in, out := &in.MaxInterval, &out.MaxInterval
-		*out = new(v1.Duration)
+	if in.InitialRequests != nil {
+		in, out := &in.InitialRequests, &out.InitialRequests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AdditionalRequestPercentage != nil {
+		in, out := &in.AdditionalRequestPercentage, &out.AdditionalRequestPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HedgeOnPerTryTimeout != nil {
+		in, out := &in.HedgeOnPerTryTimeout, &out.HedgeOnPerTryTimeout
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBackoff.
-func (in *RetryBackoff) DeepCopy() *RetryBackoff {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryHedgePolicy.
+func (in *RetryHedgePolicy) DeepCopy() *RetryHedgePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(RetryBackoff)
+	out := new(RetryHedgePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4324,6 +5297,26 @@ func (in *SdsContainer) DeepCopy() *SdsContainer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(apisv1.Namespace)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyReference.
+func (in *SecretKeyReference) DeepCopy() *SecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretReference) DeepCopyInto(out *SecretReference) {
 	*out = *in
@@ -4610,6 +5603,26 @@ func (in *StatusCodeFilter) DeepCopy() *StatusCodeFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusCodeRange) DeepCopyInto(out *StatusCodeRange) {
+	*out = *in
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusCodeRange.
+func (in *StatusCodeRange) DeepCopy() *StatusCodeRange {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusCodeRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TCPKeepalive) DeepCopyInto(out *TCPKeepalive) {
 	*out = *in
@@ -5030,6 +6043,51 @@ func (in *TrafficPolicySpec) DeepCopyInto(out *TrafficPolicySpec) {
 		*out = new(OAuth2Policy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FaultInjection != nil {
+		in, out := &in.FaultInjection, &out.FaultInjection
+		*out = new(FaultInjection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lua != nil {
+		in, out := &in.Lua, &out.Lua
+		*out = new(Lua)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Wasm != nil {
+		in, out := &in.Wasm, &out.Wasm
+		*out = new(WasmPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(ResponseCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(MirrorPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WAF != nil {
+		in, out := &in.WAF, &out.WAF
+		*out = new(WAF)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPCJSONTranscoder != nil {
+		in, out := &in.GRPCJSONTranscoder, &out.GRPCJSONTranscoder
+		*out = new(GRPCJSONTranscoder)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPCWeb != nil {
+		in, out := &in.GRPCWeb, &out.GRPCWeb
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CustomResponse != nil {
+		in, out := &in.CustomResponse, &out.CustomResponse
+		*out = new(CustomResponse)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficPolicySpec.
@@ -5166,3 +6224,238 @@ func (in *UuidRequestIdConfig) DeepCopy() *UuidRequestIdConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAF) DeepCopyInto(out *WAF) {
+	*out = *in
+	if in.ExtensionRef != nil {
+		in, out := &in.ExtensionRef, &out.ExtensionRef
+		*out = new(shared.NamespacedObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuleSets != nil {
+		in, out := &in.RuleSets, &out.RuleSets
+		*out = make([]WAFRuleSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(WAFMode)
+		**out = **in
+	}
+	if in.RuleExclusions != nil {
+		in, out := &in.RuleExclusions, &out.RuleExclusions
+		*out = new(WAFRuleExclusions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAF.
+func (in *WAF) DeepCopy() *WAF {
+	if in == nil {
+		return nil
+	}
+	out := new(WAF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFRuleExclusions) DeepCopyInto(out *WAFRuleExclusions) {
+	*out = *in
+	if in.IDs != nil {
+		in, out := &in.IDs, &out.IDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFRuleExclusions.
+func (in *WAFRuleExclusions) DeepCopy() *WAFRuleExclusions {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFRuleExclusions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFRuleSet) DeepCopyInto(out *WAFRuleSet) {
+	*out = *in
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeyReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFRuleSet.
+func (in *WAFRuleSet) DeepCopy() *WAFRuleSet {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFRuleSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmImage) DeepCopyInto(out *WasmImage) {
+	*out = *in
+	if in.PullSecretRef != nil {
+		in, out := &in.PullSecretRef, &out.PullSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmImage.
+func (in *WasmImage) DeepCopy() *WasmImage {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmModule) DeepCopyInto(out *WasmModule) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ConfigMapKeyReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalFile != nil {
+		in, out := &in.LocalFile, &out.LocalFile
+		*out = new(string)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(WasmImage)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmModule.
+func (in *WasmModule) DeepCopy() *WasmModule {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmModule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmPolicy) DeepCopyInto(out *WasmPolicy) {
+	*out = *in
+	if in.ExtensionRefs != nil {
+		in, out := &in.ExtensionRefs, &out.ExtensionRefs
+		*out = make([]shared.NamespacedObjectReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmPolicy.
+func (in *WasmPolicy) DeepCopy() *WasmPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmProvider) DeepCopyInto(out *WasmProvider) {
+	*out = *in
+	in.Module.DeepCopyInto(&out.Module)
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RootID != nil {
+		in, out := &in.RootID, &out.RootID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Runtime != nil {
+		in, out := &in.Runtime, &out.Runtime
+		*out = new(WasmRuntime)
+		**out = **in
+	}
+	if in.Stage != nil {
+		in, out := &in.Stage, &out.Stage
+		*out = new(FilterStage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailOpen != nil {
+		in, out := &in.FailOpen, &out.FailOpen
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmProvider.
+func (in *WasmProvider) DeepCopy() *WasmProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneAwareRouting) DeepCopyInto(out *ZoneAwareRouting) {
+	*out = *in
+	if in.MinClusterSize != nil {
+		in, out := &in.MinClusterSize, &out.MinClusterSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinHealthyPercent != nil {
+		in, out := &in.MinHealthyPercent, &out.MinHealthyPercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneAwareRouting.
+func (in *ZoneAwareRouting) DeepCopy() *ZoneAwareRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneAwareRouting)
+	in.DeepCopyInto(out)
+	return out
+}
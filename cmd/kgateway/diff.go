@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translate"
+	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
+)
+
+func diffCmd() *cobra.Command {
+	var (
+		before []string
+		after  []string
+		output string
+	)
+	cmd := &cobra.Command{
+		Use:   "diff --before <dir> --after <dir>",
+		Short: "Shows the xDS changes between two sets of Gateway API and kgateway resources",
+		Long: `Translates the Gateway API and kgateway resources of the before and after manifests with the same pipeline
+as the controller, and shows the listeners, routes, clusters and filters that change, and the Gateways affected.
+The controller settings are read from the KGW_* environment variables, as for the controller.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "text" && output != "json" {
+				return fmt.Errorf("unsupported output format %q", output)
+			}
+			ctx := cmd.Context()
+			if err := configureOfflineLogging(); err != nil {
+				return err
+			}
+
+			settings, err := apisettings.BuildSettings()
+			if err != nil {
+				return fmt.Errorf("error building settings: %w", err)
+			}
+			opts := translate.Options{
+				Settings:  *settings,
+				Validator: validator.New(),
+			}
+			beforeResults, beforeObjs, err := translate.TranslateFiles(ctx, before, opts)
+			if err != nil {
+				return fmt.Errorf("error translating the before resources: %w", err)
+			}
+			afterResults, afterObjs, err := translate.TranslateFiles(ctx, after, opts)
+			if err != nil {
+				return fmt.Errorf("error translating the after resources: %w", err)
+			}
+
+			d := translate.Diff(beforeObjs, beforeResults, afterObjs, afterResults)
+			if output == "json" {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(d)
+			}
+			writeDiff(cmd.OutOrStdout(), d)
+			return nil
+		},
+	}
+	cmd.Flags().StringArrayVar(&before, "before", nil, "Manifest file or directory of the resources before the change, can be repeated")
+	cmd.Flags().StringArrayVar(&after, "after", nil, "Manifest file or directory of the resources after the change, can be repeated")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "Output format, one of text or json")
+	_ = cmd.MarkFlagRequired("before")
+	_ = cmd.MarkFlagRequired("after")
+	return cmd
+}
+
+var changeMarkers = map[translate.ChangeType]string{
+	translate.Added:    "+",
+	translate.Removed:  "-",
+	translate.Modified: "~",
+}
+
+// writeDiff writes a summary of the diff for reviewers, followed by the changes of each Gateway.
+func writeDiff(w io.Writer, d *translate.ConfigDiff) {
+	if len(d.Resources) > 0 {
+		fmt.Fprintln(w, "Changed resources:")
+		writeChanges(w, "  ", d.Resources)
+		fmt.Fprintln(w)
+	}
+
+	listeners, routes, clusters, filters := d.Changes()
+	fmt.Fprintf(w, "%s, %s, %s and %s change on %s\n",
+		plural(listeners, "listener"), plural(routes, "route"), plural(clusters, "cluster"), plural(filters, "filter"),
+		plural(len(d.Gateways), "gateway"))
+
+	for _, gw := range d.Gateways {
+		fmt.Fprintf(w, "\n%s %s (%s)\n", changeMarkers[gw.Change], gw.Gateway, gw.Change)
+		for _, section := range []struct {
+			name    string
+			changes []translate.ResourceChange
+		}{
+			{"listeners", gw.Listeners},
+			{"routes", gw.Routes},
+			{"clusters", gw.Clusters},
+			{"filters", gw.Filters},
+		} {
+			if len(section.changes) == 0 {
+				continue
+			}
+			fmt.Fprintf(w, "  %s:\n", section.name)
+			writeChanges(w, "    ", section.changes)
+		}
+	}
+}
+
+func writeChanges(w io.Writer, indent string, changes []translate.ResourceChange) {
+	for _, change := range changes {
+		fmt.Fprintf(w, "%s%s %s\n", indent, changeMarkers[change.Change], change.Name)
+	}
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
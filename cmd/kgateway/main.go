@@ -32,6 +32,7 @@ func main() {
 		},
 	}
 	cmd.Flags().BoolVarP(&kgatewayVersion, "version", "v", false, "Print the version of kgateway")
+	cmd.AddCommand(translateCmd(), diffCmd())
 
 	if err := cmd.Execute(); err != nil {
 		log.Fatal(err)
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	istiolog "istio.io/istio/pkg/log"
+	"k8s.io/apimachinery/pkg/types"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translate"
+	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
+)
+
+func translateCmd() *cobra.Command {
+	var (
+		files     []string
+		validate  bool
+		envoyPath string
+	)
+	cmd := &cobra.Command{
+		Use:   "translate -f <file>...",
+		Short: "Translates Gateway API and kgateway resources to xDS without a cluster",
+		Long: `Translates the Gateway API and kgateway resources of the given manifests with the same pipeline
+as the controller, and prints the xDS resources of each Gateway and the statuses that would be written, as JSON.
+The controller settings are read from the KGW_* environment variables, as for the controller.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if err := configureOfflineLogging(); err != nil {
+				return err
+			}
+
+			settings, err := apisettings.BuildSettings()
+			if err != nil {
+				return fmt.Errorf("error building settings: %w", err)
+			}
+			v := validator.New()
+			if envoyPath != "" {
+				v = validator.NewBinary(envoyPath)
+			}
+			results, objs, err := translate.TranslateFiles(ctx, files, translate.Options{
+				Settings:  *settings,
+				Validator: v,
+			})
+			if err != nil {
+				return err
+			}
+
+			out, err := translate.NewOutput(results, translate.ListenerSets(objs))
+			if err != nil {
+				return fmt.Errorf("error building output: %w", err)
+			}
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			if err := enc.Encode(out); err != nil {
+				return err
+			}
+
+			if !validate {
+				return nil
+			}
+			gwNNs := make([]types.NamespacedName, 0, len(results))
+			for gwNN := range results {
+				gwNNs = append(gwNNs, gwNN)
+			}
+			slices.SortFunc(gwNNs, func(a, b types.NamespacedName) int {
+				return strings.Compare(a.String(), b.String())
+			})
+			var errs []error
+			for _, gwNN := range gwNNs {
+				if err := translate.Validate(ctx, v, results[gwNN]); err != nil {
+					errs = append(errs, fmt.Errorf("gateway %s: %w", gwNN, err))
+				}
+			}
+			return errors.Join(errs...)
+		},
+	}
+	cmd.Flags().StringArrayVarP(&files, "file", "f", nil, "Manifest file of the resources to translate, can be repeated")
+	cmd.Flags().BoolVar(&validate, "validate", false, "Validate the xDS resources of each Gateway with Envoy")
+	cmd.Flags().StringVar(&envoyPath, "envoy-path", "", "Path of the Envoy binary used to validate the xDS resources, "+
+		"the resources are validated in-process when the Envoy binary is not found")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+// configureOfflineLogging configures the logging of the commands translating resources offline.
+// Their output is written to stdout, so keep the logs of the krt collections out of it.
+func configureOfflineLogging() error {
+	loggingOptions := istiolog.DefaultOptions()
+	loggingOptions.OutputPaths = []string{"stderr"}
+	loggingOptions.SetDefaultOutputLevel(istiolog.OverrideScopeName, istiolog.WarnLevel)
+	if err := istiolog.Configure(loggingOptions); err != nil {
+		return fmt.Errorf("error configuring logging: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+// Package kgatewaycrds embeds the kgateway CRDs of the chart, e.g. to apply the API defaults to the
+// resources translated offline by `kgateway translate`.
+package kgatewaycrds
+
+import (
+	"embed"
+)
+
+//go:embed templates/gateway.kgateway.dev_*.yaml
+var CRDs embed.FS
@@ -0,0 +1,203 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+
+	"istio.io/istio/pkg/config/schema/gvr"
+	"istio.io/istio/pkg/kube"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/gateway-api/pkg/consts"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	kgwfake "github.com/kgateway-dev/kgateway/v2/pkg/client/clientset/versioned/fake"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/schemes"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
+)
+
+// CRDs are the resources of the CRDs served by the in-memory client.
+var CRDs = []schema.GroupVersionResource{
+	// Gateway API
+	gvr.KubernetesGateway,
+	gvr.GatewayClass,
+	gvr.HTTPRoute,
+	gvr.GRPCRoute,
+	gvr.TCPRoute,
+	gvr.TLSRoute,
+	gvr.UDPRoute,
+	gvr.ReferenceGrant,
+	gvr.BackendTLSPolicy,
+	gvr.XListenerSet,
+	gvr.XBackendTrafficPolicy,
+	wellknown.BackendTLSPolicyGVR,
+	// Gateway API Inference Extension
+	wellknown.InferencePoolGVR,
+	// K8s API
+	gvr.Service,
+	gvr.Pod,
+	// Istio API
+	gvr.ServiceEntry,
+	gvr.WorkloadEntry,
+	gvr.AuthorizationPolicy,
+	// kgateway API
+	wellknown.BackendGVR,
+	wellknown.BackendConfigPolicyGVR,
+	wellknown.TrafficPolicyGVR,
+	wellknown.HTTPListenerPolicyGVR,
+	wellknown.ListenerPolicyGVR,
+	wellknown.DirectResponseGVR,
+	wellknown.GatewayExtensionGVR,
+	wellknown.GatewayParametersGVR,
+}
+
+// NewInMemoryClient returns a client serving the given resources from memory, without an API server,
+// e.g. to translate resources offline. The CRDs of extraGVRs are served in addition to CRDs.
+func NewInMemoryClient(extraGVRs []schema.GroupVersionResource, objects ...ctrlclient.Object) (Client, error) {
+	known, kgw := filterObjects(objects...)
+	kubeClient, err := inMemoryKubeClient(known...)
+	if err != nil {
+		return nil, err
+	}
+	kgwClient, err := inMemoryKgwClient(kgw...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, crd := range append(CRDs, extraGVRs...) {
+		if err := createCRD(kubeClient, crd); err != nil {
+			return nil, err
+		}
+	}
+
+	RegisterTypes()
+
+	return &client{
+		Client:   kubeClient,
+		kgateway: kgwClient,
+	}, nil
+}
+
+// createCRD makes the CRD of the resource known to the client, with the Gateway API bundle version
+// of the Gateway API CRDs.
+func createCRD(c kube.Client, res schema.GroupVersionResource) error {
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s.%s", res.Resource, res.Group),
+			Annotations: map[string]string{
+				consts.BundleVersionAnnotation: consts.BundleVersion,
+			},
+		},
+	}
+	// the CRDs are watched with the metadata client, which is not kept in sync with the fake clientset
+	fmc, ok := c.Metadata().(*metadatafake.FakeMetadataClient)
+	if !ok {
+		return nil
+	}
+	fmd, ok := fmc.Resource(gvr.CustomResourceDefinition).(metadatafake.MetadataClient)
+	if !ok {
+		return nil
+	}
+	obj := &metav1.PartialObjectMetadata{
+		TypeMeta:   crd.TypeMeta,
+		ObjectMeta: crd.ObjectMeta,
+	}
+	if _, err := fmd.CreateFake(obj, metav1.CreateOptions{}); err != nil {
+		if !kerrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create CRD %s: %w", crd.Name, err)
+		}
+		if _, err := fmd.UpdateFake(obj, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update CRD %s: %w", crd.Name, err)
+		}
+	}
+	return nil
+}
+
+func inMemoryKubeClient(objects ...ctrlclient.Object) (kube.Client, error) {
+	runtimeObjs := make([]runtime.Object, 0, len(objects))
+	for _, obj := range objects {
+		runtimeObjs = append(runtimeObjs, obj)
+	}
+	c := kube.NewFakeClient(runtimeObjs...)
+	// Also add to the Dynamic store
+	for _, obj := range objects {
+		nn := kubeutils.NamespacedNameFrom(obj)
+		gvr, err := getGVR(obj, kube.IstioScheme)
+		if err != nil {
+			return nil, err
+		}
+		d := c.Dynamic().Resource(gvr).Namespace(obj.GetNamespace())
+		us, err := kubeutils.ToUnstructured(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert to unstructured for object %T %s: %w", obj, nn, err)
+		}
+		_, err = d.Create(context.Background(), us, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create in dynamic client for object %T %s: %w", obj, nn, err)
+		}
+	}
+
+	return c, nil
+}
+
+func inMemoryKgwClient(objects ...ctrlclient.Object) (*kgwfake.Clientset, error) {
+	f := kgwfake.NewSimpleClientset()
+	for _, obj := range objects {
+		gvr, err := getGVR(obj, schemes.DefaultScheme())
+		if err != nil {
+			return nil, err
+		}
+		// Run Create() instead of Add(), so we can pass the GVR. Otherwise, Kubernetes guesses, and it guesses wrong for 'GatewayParameters'.
+		// DeepCopy since it will mutate the managed fields/etc
+		if err := f.Tracker().Create(gvr, obj.DeepCopyObject(), obj.(metav1.ObjectMetaAccessor).GetObjectMeta().GetNamespace()); err != nil {
+			return nil, fmt.Errorf("failed to create object %s: %w", kubeutils.NamespacedNameFrom(obj), err)
+		}
+	}
+	return f, nil
+}
+
+func filterObjects(objects ...ctrlclient.Object) (istio []ctrlclient.Object, kgw []ctrlclient.Object) {
+	for _, obj := range objects {
+		switch obj.(type) {
+		case *kgateway.Backend,
+			*kgateway.BackendConfigPolicy,
+			*kgateway.DirectResponse,
+			*kgateway.GatewayExtension,
+			*kgateway.GatewayParameters,
+			*kgateway.HTTPListenerPolicy,
+			*kgateway.ListenerPolicy,
+			*kgateway.TrafficPolicy:
+			kgw = append(kgw, obj)
+		default:
+			istio = append(istio, obj)
+		}
+	}
+	return istio, kgw
+}
+
+func getGVR(obj ctrlclient.Object, scheme *runtime.Scheme) (schema.GroupVersionResource, error) {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Group == "" {
+		gvks, _, _ := scheme.ObjectKinds(obj)
+		gvk = gvks[0]
+	}
+	gvr, err := wellknown.GVKToGVR(gvk)
+	if err != nil {
+		// try unsafe guess
+		gvr, _ = meta.UnsafeGuessKindToResource(gvk)
+		if gvr == (schema.GroupVersionResource{}) {
+			return schema.GroupVersionResource{}, fmt.Errorf("failed to get GVR for object %s: %v", kubeutils.NamespacedNameFrom(obj), err)
+		}
+	}
+	if gvr.Group == "core" {
+		gvr.Group = ""
+	}
+	return gvr, nil
+}
@@ -12,6 +12,7 @@ import (
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,11 +31,16 @@ import (
 
 var logger = logging.New("deployer")
 
+// replicasHandoffFieldManagerSuffix suffixes the field manager keeping the replicas of an autoscaled Deployment
+const replicasHandoffFieldManagerSuffix = "/replicas-handoff"
+
 type ControlPlaneInfo struct {
 	XdsHost      string
 	XdsPort      uint32
 	XdsTLS       bool
 	XdsTlsCaPath string
+	// XdsDelta configures the proxies to use incremental (delta) xDS
+	XdsDelta bool
 }
 
 type ImageInfo struct {
@@ -279,6 +285,9 @@ func (d *Deployer) DeployObjsWithSource(ctx context.Context, objs []client.Objec
 		// If the object doesn't exist or there's an error other than "not found", proceed with patching
 		switch {
 		case err == nil:
+			if err := d.handOffReplicas(u, existing, objs, gvr); err != nil {
+				return err
+			}
 			// zero out fields that api server changes
 			existing.SetResourceVersion("")
 			existing.SetGeneration(0)
@@ -326,6 +335,71 @@ func (d *Deployer) DeployObjsWithSource(ctx context.Context, objs []client.Objec
 	return nil
 }
 
+// handOffReplicas hands off the replicas of a Deployment to the HorizontalPodAutoscaler scaling it.
+// The replicas are not rendered when the Deployment is autoscaled, and applying the Deployment without
+// the replicas it applied before would remove them, scaling the Deployment down to a single replica
+// until the HorizontalPodAutoscaler scales it up again. So the current replicas are first applied with
+// a separate field manager, which keeps them until the HorizontalPodAutoscaler updates them.
+func (d *Deployer) handOffReplicas(rendered, existing *unstructured.Unstructured, objs []client.Object, gvr schema.GroupVersionResource) error {
+	if rendered.GroupVersionKind().GroupKind() != wellknown.DeploymentGVK.GroupKind() {
+		return nil
+	}
+	if _, found, _ := unstructured.NestedFieldNoCopy(rendered.Object, "spec", "replicas"); found {
+		return nil
+	}
+	replicas, found, _ := unstructured.NestedInt64(existing.Object, "spec", "replicas")
+	if !found || !isAutoscaled(objs, rendered.GetName()) || !appliesReplicas(existing.GetManagedFields(), d.controllerName) {
+		return nil
+	}
+
+	js, err := json.Marshal(map[string]any{
+		"apiVersion": rendered.GetAPIVersion(),
+		"kind":       rendered.GetKind(),
+		"metadata": map[string]any{
+			"name":      rendered.GetName(),
+			"namespace": rendered.GetNamespace(),
+		},
+		"spec": map[string]any{
+			"replicas": replicas,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	logger.Debug("handing off replicas to the HorizontalPodAutoscaler",
+		"namespace", rendered.GetNamespace(), "name", rendered.GetName(), "replicas", replicas)
+	if err := d.patcher(d.client, d.controllerName+replicasHandoffFieldManagerSuffix, gvr, rendered.GetName(), rendered.GetNamespace(), js); err != nil {
+		return fmt.Errorf("failed to hand off the replicas of Deployment %s/%s: %w", rendered.GetNamespace(), rendered.GetName(), err)
+	}
+	return nil
+}
+
+// isAutoscaled returns true if a HorizontalPodAutoscaler of the objects scales the Deployment with the given name.
+func isAutoscaled(objs []client.Object, deploymentName string) bool {
+	return slices.ContainsFunc(objs, func(obj client.Object) bool {
+		hpa, ok := obj.(*autoscalingv2.HorizontalPodAutoscaler)
+		return ok && hpa.Spec.ScaleTargetRef.Kind == wellknown.DeploymentGVK.Kind && hpa.Spec.ScaleTargetRef.Name == deploymentName
+	})
+}
+
+// appliesReplicas returns true if the field manager owns the replicas of the object it applied.
+func appliesReplicas(managedFields []metav1.ManagedFieldsEntry, fieldManager string) bool {
+	for _, entry := range managedFields {
+		if entry.Manager != fieldManager || entry.Operation != metav1.ManagedFieldsOperationApply ||
+			entry.Subresource != "" || entry.FieldsV1 == nil {
+			continue
+		}
+		var fields map[string]map[string]any
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		if _, ok := fields["f:spec"]["f:replicas"]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (d *Deployer) gvkToGVR(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error) {
 	// 1. Try our lib
 	gvr, err := wellknown.GVKToGVR(gvk)
@@ -15,6 +15,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"istio.io/istio/pkg/config/schema/gvk"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -2602,6 +2603,83 @@ var _ = Describe("DeployObjs", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(patched).To(BeTrue())
 	})
+
+	Context("replicas of an autoscaled Deployment", func() {
+		type patch struct {
+			fieldManager string
+			data         string
+		}
+
+		existingDeployment := func() *appsv1.Deployment {
+			return &appsv1.Deployment{
+				TypeMeta: metav1.TypeMeta{Kind: gvk.Deployment.Kind, APIVersion: gvk.Deployment.GroupVersion()},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: ns,
+					ManagedFields: []metav1.ManagedFieldsEntry{{
+						Manager:    wellknown.DefaultGatewayControllerName,
+						Operation:  metav1.ManagedFieldsOperationApply,
+						APIVersion: "apps/v1",
+						FieldsType: "FieldsV1",
+						FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{},"f:template":{}}}`)},
+					}},
+				},
+				Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](3)},
+			}
+		}
+		renderedDeployment := func() *appsv1.Deployment {
+			return &appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{Kind: gvk.Deployment.Kind, APIVersion: gvk.Deployment.GroupVersion()},
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			}
+		}
+		hpa := &autoscalingv2.HorizontalPodAutoscaler{
+			TypeMeta:   metav1.TypeMeta{Kind: "HorizontalPodAutoscaler", APIVersion: "autoscaling/v2"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: name, APIVersion: "apps/v1"},
+				MaxReplicas:    5,
+			},
+		}
+
+		deploy := func(existing *appsv1.Deployment, objs ...client.Object) []patch {
+			fc := fake.NewClient(GinkgoT(), existing)
+			var patches []patch
+			d := getDeployer(fc, func(client apiclient.Client, fieldManager string, gvr schema.GroupVersionResource, name string, namespace string, data []byte, subresources ...string) error {
+				if gvr.Resource == "deployments" {
+					patches = append(patches, patch{fieldManager: fieldManager, data: string(data)})
+				}
+				return nil
+			})
+			fc.RunAndWait(context.Background().Done())
+
+			Expect(d.DeployObjs(ctx, objs)).To(Succeed())
+			return patches
+		}
+
+		It("keeps the current replicas when the replicas are handed off to the HorizontalPodAutoscaler", func() {
+			patches := deploy(existingDeployment(), renderedDeployment(), hpa.DeepCopy())
+			Expect(patches).To(HaveLen(2))
+			Expect(patches[0].fieldManager).To(Equal(wellknown.DefaultGatewayControllerName + "/replicas-handoff"))
+			Expect(patches[0].data).To(MatchJSON(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-obj","namespace":"test-ns"},"spec":{"replicas":3}}`))
+			Expect(patches[1].fieldManager).To(Equal(wellknown.DefaultGatewayControllerName))
+			Expect(patches[1].data).NotTo(ContainSubstring("replicas"))
+		})
+
+		It("does not hand off the replicas once the controller does not apply them", func() {
+			existing := existingDeployment()
+			existing.ManagedFields[0].FieldsV1.Raw = []byte(`{"f:spec":{"f:template":{}}}`)
+			patches := deploy(existing, renderedDeployment(), hpa.DeepCopy())
+			Expect(patches).To(HaveLen(1))
+			Expect(patches[0].fieldManager).To(Equal(wellknown.DefaultGatewayControllerName))
+		})
+
+		It("does not hand off the replicas of a Deployment that is not autoscaled", func() {
+			patches := deploy(existingDeployment(), renderedDeployment())
+			Expect(patches).To(HaveLen(1))
+			Expect(patches[0].fieldManager).To(Equal(wellknown.DefaultGatewayControllerName))
+		})
+	})
 })
 
 var _ = Describe("SortByKindPriority", func() {
@@ -32,6 +32,9 @@ func DeepMergeGatewayParameters(dst, src *kgateway.GatewayParameters) {
 	srcKube := src.Spec.Kube.DeepCopy()
 
 	dstKube.Deployment = deepMergeDeployment(dstKube.GetDeployment(), srcKube.GetDeployment())
+	dstKube.Autoscaling = deepMergeAutoscaling(dstKube.GetAutoscaling(), srcKube.GetAutoscaling())
+	// minAvailable and maxUnavailable are mutually exclusive, so the disruption budget is overridden as a whole
+	dstKube.DisruptionBudget = MergePointers(dstKube.GetDisruptionBudget(), srcKube.GetDisruptionBudget())
 	dstKube.EnvoyContainer = deepMergeEnvoyContainer(dstKube.GetEnvoyContainer(), srcKube.GetEnvoyContainer())
 	dstKube.SdsContainer = deepMergeSdsContainer(dstKube.GetSdsContainer(), srcKube.GetSdsContainer())
 	dstKube.PodTemplate = deepMergePodTemplate(dstKube.GetPodTemplate(), srcKube.GetPodTemplate())
@@ -749,3 +752,22 @@ func deepMergeDeployment(dst, src *kgateway.ProxyDeployment) *kgateway.ProxyDepl
 
 	return dst
 }
+
+func deepMergeAutoscaling(dst, src *kgateway.ProxyAutoscaling) *kgateway.ProxyAutoscaling {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.MinReplicas = MergePointers(dst.GetMinReplicas(), src.GetMinReplicas())
+	dst.MaxReplicas = MergeComparable(dst.GetMaxReplicas(), src.GetMaxReplicas())
+	dst.TargetCPUUtilizationPercentage = MergePointers(dst.GetTargetCPUUtilizationPercentage(), src.GetTargetCPUUtilizationPercentage())
+	dst.TargetMemoryUtilizationPercentage = MergePointers(dst.GetTargetMemoryUtilizationPercentage(), src.GetTargetMemoryUtilizationPercentage())
+	dst.Metrics = OverrideSlices(dst.GetMetrics(), src.GetMetrics())
+
+	return dst
+}
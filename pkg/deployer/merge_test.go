@@ -430,6 +430,49 @@ func TestDeepMergeGatewayParameters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should merge autoscaling and override disruptionBudget from src",
+			dst: &kgateway.GatewayParameters{
+				Spec: kgateway.GatewayParametersSpec{
+					Kube: &kgateway.KubernetesProxyConfig{
+						Autoscaling: &kgateway.ProxyAutoscaling{
+							MinReplicas:                    ptr.To[int32](2),
+							MaxReplicas:                    10,
+							TargetCPUUtilizationPercentage: ptr.To[int32](80),
+						},
+						DisruptionBudget: &kgateway.ProxyDisruptionBudget{
+							MinAvailable: new(intstr.FromInt32(1)),
+						},
+					},
+				},
+			},
+			src: &kgateway.GatewayParameters{
+				Spec: kgateway.GatewayParametersSpec{
+					Kube: &kgateway.KubernetesProxyConfig{
+						Autoscaling: &kgateway.ProxyAutoscaling{
+							MaxReplicas: 20,
+						},
+						DisruptionBudget: &kgateway.ProxyDisruptionBudget{
+							MaxUnavailable: new(intstr.FromString("25%")),
+						},
+					},
+				},
+			},
+			want: &kgateway.GatewayParameters{
+				Spec: kgateway.GatewayParametersSpec{
+					Kube: &kgateway.KubernetesProxyConfig{
+						Autoscaling: &kgateway.ProxyAutoscaling{
+							MinReplicas:                    ptr.To[int32](2),
+							MaxReplicas:                    20,
+							TargetCPUUtilizationPercentage: ptr.To[int32](80),
+						},
+						DisruptionBudget: &kgateway.ProxyDisruptionBudget{
+							MaxUnavailable: new(intstr.FromString("25%")),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -79,6 +79,10 @@ func (a *OverlayApplier) ApplyOverlays(objs []client.Object) ([]client.Object, e
 		}
 	}
 
+	// The PDB and HPA may already be rendered from the GatewayParameters, or created by the overlays
+	// of the GatewayClass parameters, in which case the overlays are applied to them instead.
+	var hasPDB, hasHPA bool
+
 	for i, obj := range objs {
 		var overlay *shared.KubernetesResourceOverlay
 		var gvk schema.GroupVersionKind
@@ -95,6 +99,14 @@ func (a *OverlayApplier) ApplyOverlays(objs []client.Object) ([]client.Object, e
 		case *corev1.ServiceAccount:
 			overlay = a.overlays.ServiceAccount
 			gvk = wellknown.ServiceAccountGVK
+		case *policyv1.PodDisruptionBudget:
+			hasPDB = true
+			overlay = a.overlays.PodDisruptionBudget
+			gvk = wellknown.PodDisruptionBudgetGVK
+		case *autoscalingv2.HorizontalPodAutoscaler:
+			hasHPA = true
+			overlay = a.overlays.HorizontalPodAutoscaler
+			gvk = wellknown.HorizontalPodAutoscalerGVK
 		default:
 			continue
 		}
@@ -110,8 +122,8 @@ func (a *OverlayApplier) ApplyOverlays(objs []client.Object) ([]client.Object, e
 		objs[i] = patched
 	}
 
-	// Create PDB if overlay is present
-	if a.overlays.PodDisruptionBudget != nil && deployment != nil {
+	// Create PDB if overlay is present and no PDB was rendered
+	if a.overlays.PodDisruptionBudget != nil && deployment != nil && !hasPDB {
 		pdb, err := createPodDisruptionBudget(deployment, a.overlays.PodDisruptionBudget)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create PodDisruptionBudget: %w", err)
@@ -119,8 +131,8 @@ func (a *OverlayApplier) ApplyOverlays(objs []client.Object) ([]client.Object, e
 		objs = append(objs, pdb)
 	}
 
-	// Create HPA if overlay is present
-	if a.overlays.HorizontalPodAutoscaler != nil && deployment != nil {
+	// Create HPA if overlay is present and no HPA was rendered
+	if a.overlays.HorizontalPodAutoscaler != nil && deployment != nil && !hasHPA {
 		hpa, err := createHorizontalPodAutoscaler(deployment, a.overlays.HorizontalPodAutoscaler)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create HorizontalPodAutoscaler: %w", err)
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -340,3 +341,52 @@ func TestOverlayApplier_ApplyOverlays_MultipleObjects(t *testing.T) {
 	cm := objs[3].(*corev1.ConfigMap)
 	assert.Empty(t, cm.Labels)
 }
+
+func TestOverlayApplier_ApplyOverlays_RenderedHorizontalPodAutoscaler(t *testing.T) {
+	specPatch := []byte(`{
+		"behavior": {"scaleDown": {"stabilizationWindowSeconds": 600}}
+	}`)
+
+	params := &kgateway.GatewayParameters{
+		Spec: kgateway.GatewayParametersSpec{
+			Kube: &kgateway.KubernetesProxyConfig{
+				GatewayParametersOverlays: kgateway.GatewayParametersOverlays{
+					HorizontalPodAutoscaler: &shared.KubernetesResourceOverlay{
+						Metadata: &shared.ObjectMetadata{
+							Labels: map[string]string{"hpa": "modified"},
+						},
+						Spec: &apiextensionsv1.JSON{Raw: specPatch},
+					},
+				},
+			},
+		},
+	}
+
+	applier := NewOverlayApplierFromGatewayParameters(params)
+	objs := []client.Object{
+		&appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "test-deployment"},
+		},
+		&autoscalingv2.HorizontalPodAutoscaler{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler"},
+			ObjectMeta: metav1.ObjectMeta{Name: "test-deployment"},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				MinReplicas: ptr.To[int32](2),
+				MaxReplicas: 10,
+			},
+		},
+	}
+
+	objs, err := applier.ApplyOverlays(objs)
+	require.NoError(t, err)
+
+	// The rendered HPA is patched instead of creating another one
+	require.Len(t, objs, 2)
+	hpa := objs[1].(*autoscalingv2.HorizontalPodAutoscaler)
+	assert.Equal(t, "modified", hpa.Labels["hpa"])
+	assert.Equal(t, ptr.To[int32](2), hpa.Spec.MinReplicas)
+	assert.Equal(t, int32(10), hpa.Spec.MaxReplicas)
+	require.NotNil(t, hpa.Spec.Behavior)
+	assert.Equal(t, ptr.To[int32](600), hpa.Spec.Behavior.ScaleDown.StabilizationWindowSeconds)
+}
@@ -2,7 +2,9 @@ package deployer
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 )
@@ -39,6 +41,10 @@ type HelmGateway struct {
 	Service      *HelmService               `json:"service,omitempty"`
 	Strategy     *appsv1.DeploymentStrategy `json:"strategy,omitempty"`
 
+	// horizontalpodautoscaler and poddisruptionbudget values
+	Autoscaling         *HelmAutoscaling         `json:"autoscaling,omitempty"`
+	PodDisruptionBudget *HelmPodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+
 	// serviceaccount values
 	ServiceAccount *HelmServiceAccount `json:"serviceAccount,omitempty"`
 
@@ -116,6 +122,21 @@ type HelmService struct {
 	ExternalTrafficPolicy    *string           `json:"externalTrafficPolicy,omitempty"`
 }
 
+// HelmAutoscaling is rendered as the spec of the HorizontalPodAutoscaler
+// scaling the proxy Deployment.
+type HelmAutoscaling struct {
+	MinReplicas *int32                     `json:"minReplicas,omitempty"`
+	MaxReplicas int32                      `json:"maxReplicas"`
+	Metrics     []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+}
+
+// HelmPodDisruptionBudget is rendered as the spec of the PodDisruptionBudget
+// of the proxy pods.
+type HelmPodDisruptionBudget struct {
+	MinAvailable   *intstr.IntOrString `json:"minAvailable,omitempty"`
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
 type HelmServiceAccount struct {
 	ExtraAnnotations map[string]string `json:"extraAnnotations,omitempty"`
 	ExtraLabels      map[string]string `json:"extraLabels,omitempty"`
@@ -127,6 +148,8 @@ type HelmXds struct {
 	Host *string     `json:"host,omitempty"`
 	Port *uint32     `json:"port,omitempty"`
 	Tls  *HelmXdsTls `json:"tls,omitempty"`
+	// Delta configures envoy to use incremental (delta) xDS instead of state-of-the-world xDS
+	Delta *bool `json:"delta,omitempty"`
 }
 
 type HelmXdsTls struct {
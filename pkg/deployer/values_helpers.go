@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"istio.io/istio/pkg/slices"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -48,7 +49,16 @@ func GetPortsValues(gw *ir.GatewayForDeployer, gwp *kgateway.GatewayParameters)
 			logger.Error("skipping port", "gateway", gw.ResourceName(), "error", err)
 			continue
 		}
-		gwPorts = AppendPortValue(gwPorts, port, portName, gwp)
+		protocol := corev1.ProtocolTCP
+		if gw.UdpPorts.Contains(port) {
+			protocol = corev1.ProtocolUDP
+		}
+		gwPorts = AppendPortValue(gwPorts, port, portName, protocol, gwp)
+	}
+
+	// Add UDP ports for HTTPS listeners that are also served over HTTP/3 (QUIC)
+	for _, port := range gw.Http3Ports.List() {
+		gwPorts = AppendPortValue(gwPorts, port, fmt.Sprintf("quic-%d", port), corev1.ProtocolUDP, gwp)
 	}
 
 	// Add ports from GatewayParameters.Service.Ports
@@ -64,7 +74,7 @@ func GetPortsValues(gw *ir.GatewayForDeployer, gwp *kgateway.GatewayParameters)
 				},
 			}
 			portName := listener.GenerateListenerName(l)
-			gwPorts = AppendPortValue(gwPorts, portValue, portName, gwp)
+			gwPorts = AppendPortValue(gwPorts, portValue, portName, corev1.ProtocolTCP, gwp)
 		}
 	}
 
@@ -85,13 +95,13 @@ func SanitizePortName(name string) string {
 	return str
 }
 
-func AppendPortValue(gwPorts []HelmPort, port int32, name string, gwp *kgateway.GatewayParameters) []HelmPort {
-	if slices.IndexFunc(gwPorts, func(p HelmPort) bool { return *p.Port == port }) != -1 {
+func AppendPortValue(gwPorts []HelmPort, port int32, name string, protocol corev1.Protocol, gwp *kgateway.GatewayParameters) []HelmPort {
+	protocolName := string(protocol)
+	if slices.IndexFunc(gwPorts, func(p HelmPort) bool { return *p.Port == port && *p.Protocol == protocolName }) != -1 {
 		return gwPorts
 	}
 
 	portName := SanitizePortName(name)
-	protocol := "TCP"
 
 	// Search for static NodePort set from the GatewayParameters spec
 	// If not found the default value of `nil` will not render anything.
@@ -107,7 +117,7 @@ func AppendPortValue(gwPorts []HelmPort, port int32, name string, gwp *kgateway.
 		Port:       &port,
 		TargetPort: &port,
 		Name:       &portName,
-		Protocol:   &protocol,
+		Protocol:   &protocolName,
 		NodePort:   nodePort,
 	})
 }
@@ -278,6 +288,51 @@ func GetImageValues(image *kgateway.Image) *HelmImage {
 	return HelmImage
 }
 
+// GetAutoscalingValues returns the values of the HorizontalPodAutoscaler scaling the proxy
+// Deployment, with the CPU and memory utilization targets converted to resource metrics
+// preceding the additional metrics.
+func GetAutoscalingValues(autoscalingConfig *kgateway.ProxyAutoscaling) *HelmAutoscaling {
+	if autoscalingConfig == nil {
+		return nil
+	}
+	vals := &HelmAutoscaling{
+		MinReplicas: autoscalingConfig.GetMinReplicas(),
+		MaxReplicas: autoscalingConfig.GetMaxReplicas(),
+	}
+	if cpu := autoscalingConfig.GetTargetCPUUtilizationPercentage(); cpu != nil {
+		vals.Metrics = append(vals.Metrics, resourceUtilizationMetric(corev1.ResourceCPU, *cpu))
+	}
+	if memory := autoscalingConfig.GetTargetMemoryUtilizationPercentage(); memory != nil {
+		vals.Metrics = append(vals.Metrics, resourceUtilizationMetric(corev1.ResourceMemory, *memory))
+	}
+	vals.Metrics = append(vals.Metrics, autoscalingConfig.GetMetrics()...)
+	return vals
+}
+
+func resourceUtilizationMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &utilization,
+			},
+		},
+	}
+}
+
+// GetPodDisruptionBudgetValues returns the values of the PodDisruptionBudget of the proxy pods.
+func GetPodDisruptionBudgetValues(disruptionBudgetConfig *kgateway.ProxyDisruptionBudget) *HelmPodDisruptionBudget {
+	if disruptionBudgetConfig == nil {
+		return nil
+	}
+	return &HelmPodDisruptionBudget{
+		MinAvailable:   disruptionBudgetConfig.GetMinAvailable(),
+		MaxUnavailable: disruptionBudgetConfig.GetMaxUnavailable(),
+	}
+}
+
 // Get the stats values for the envoy listener in the configmap for bootstrap.
 func GetStatsValues(statsConfig *kgateway.StatsConfig) *HelmStatsConfig {
 	if statsConfig == nil {
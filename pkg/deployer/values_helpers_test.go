@@ -4,12 +4,16 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"istio.io/istio/pkg/util/smallset"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 )
 
 func TestComponentLogLevelsToString(t *testing.T) {
@@ -292,3 +296,123 @@ func TestGetServiceValues(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPortsValues(t *testing.T) {
+	tests := []struct {
+		name string
+		gw   *ir.GatewayForDeployer
+		want []HelmPort
+	}{
+		{
+			name: "tcp listener ports",
+			gw: &ir.GatewayForDeployer{
+				Ports: smallset.New[int32](8080),
+			},
+			want: []HelmPort{
+				{Port: new(int32(8080)), TargetPort: new(int32(8080)), Name: new("listener-8080"), Protocol: new("TCP")},
+			},
+		},
+		{
+			name: "udp listener ports",
+			gw: &ir.GatewayForDeployer{
+				Ports:    smallset.New[int32](5353, 8080),
+				UdpPorts: smallset.New[int32](5353),
+			},
+			want: []HelmPort{
+				{Port: new(int32(5353)), TargetPort: new(int32(5353)), Name: new("listener-5353"), Protocol: new("UDP")},
+				{Port: new(int32(8080)), TargetPort: new(int32(8080)), Name: new("listener-8080"), Protocol: new("TCP")},
+			},
+		},
+		{
+			name: "https listener ports with http3",
+			gw: &ir.GatewayForDeployer{
+				Ports:      smallset.New[int32](443, 8443),
+				Http3Ports: smallset.New[int32](443),
+			},
+			want: []HelmPort{
+				{Port: new(int32(443)), TargetPort: new(int32(443)), Name: new("listener-443"), Protocol: new("TCP")},
+				{Port: new(int32(8443)), TargetPort: new(int32(8443)), Name: new("listener-8443"), Protocol: new("TCP")},
+				{Port: new(int32(443)), TargetPort: new(int32(443)), Name: new("quic-443"), Protocol: new("UDP")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetPortsValues(tt.gw, nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetAutoscalingValues(t *testing.T) {
+	activeConnections := autoscalingv2.MetricSpec{
+		Type: autoscalingv2.PodsMetricSourceType,
+		Pods: &autoscalingv2.PodsMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{Name: "envoy_http_downstream_cx_active"},
+			Target: autoscalingv2.MetricTarget{
+				Type:         autoscalingv2.AverageValueMetricType,
+				AverageValue: new(resource.MustParse("1000")),
+			},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		input *kgateway.ProxyAutoscaling
+		want  *HelmAutoscaling
+	}{
+		{
+			name:  "nil autoscaling config returns nil",
+			input: nil,
+			want:  nil,
+		},
+		{
+			name:  "replicas only",
+			input: &kgateway.ProxyAutoscaling{MinReplicas: new(int32(2)), MaxReplicas: 5},
+			want:  &HelmAutoscaling{MinReplicas: new(int32(2)), MaxReplicas: 5},
+		},
+		{
+			name: "resource utilization targets precede custom metrics",
+			input: &kgateway.ProxyAutoscaling{
+				MaxReplicas:                       10,
+				TargetCPUUtilizationPercentage:    new(int32(75)),
+				TargetMemoryUtilizationPercentage: new(int32(90)),
+				Metrics:                           []autoscalingv2.MetricSpec{activeConnections},
+			},
+			want: &HelmAutoscaling{
+				MaxReplicas: 10,
+				Metrics: []autoscalingv2.MetricSpec{
+					{
+						Type: autoscalingv2.ResourceMetricSourceType,
+						Resource: &autoscalingv2.ResourceMetricSource{
+							Name: corev1.ResourceCPU,
+							Target: autoscalingv2.MetricTarget{
+								Type:               autoscalingv2.UtilizationMetricType,
+								AverageUtilization: new(int32(75)),
+							},
+						},
+					},
+					{
+						Type: autoscalingv2.ResourceMetricSourceType,
+						Resource: &autoscalingv2.ResourceMetricSource{
+							Name: corev1.ResourceMemory,
+							Target: autoscalingv2.MetricTarget{
+								Type:               autoscalingv2.UtilizationMetricType,
+								AverageUtilization: new(int32(90)),
+							},
+						},
+					},
+					activeConnections,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetAutoscalingValues(tt.input)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
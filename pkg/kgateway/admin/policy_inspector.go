@@ -0,0 +1,304 @@
+package admin
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/proxy_syncer"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/irtranslator"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+// GatewayPolicies lists the policies attached to a Gateway, its listeners and its routes,
+// and how the policies of each GroupKind are merged at each level.
+type GatewayPolicies struct {
+	Gateway   string             `json:"gateway"`
+	Listeners []ListenerPolicies `json:"listeners,omitempty"`
+}
+
+type ListenerPolicies struct {
+	Name string `json:"name"`
+	Port uint32 `json:"port"`
+	// Policies are the policies applied to the listener, i.e., the policies of the listener and the Gateway
+	Policies     []MergedPolicies      `json:"policies,omitempty"`
+	FilterChains []FilterChainPolicies `json:"filterChains,omitempty"`
+}
+
+type FilterChainPolicies struct {
+	// Name is the name of the filter chain, and of its route configuration
+	Name string `json:"name"`
+	// Policies are the policies applied to the route configuration, i.e., the policies of the filter chain and the Gateway
+	Policies     []MergedPolicies      `json:"policies,omitempty"`
+	VirtualHosts []VirtualHostPolicies `json:"virtualHosts,omitempty"`
+}
+
+type VirtualHostPolicies struct {
+	Name     string           `json:"name"`
+	Hostname string           `json:"hostname"`
+	Policies []MergedPolicies `json:"policies,omitempty"`
+	Routes   []RoutePolicies  `json:"routes,omitempty"`
+}
+
+type RoutePolicies struct {
+	// Name is the name of the translated xDS route
+	Name       string          `json:"name"`
+	Route      ir.ObjectSource `json:"route"`
+	Rule       string          `json:"rule,omitempty"`
+	MatchIndex int             `json:"matchIndex"`
+	// Policies are the policies applied to the route, i.e., the policies of the route and of its delegating parents
+	Policies []MergedPolicies `json:"policies,omitempty"`
+}
+
+// MergedPolicies are the policies of a GroupKind applying at a level of the config, and the result of their merge.
+type MergedPolicies struct {
+	GroupKind string `json:"groupKind"`
+	// Mergeable is false when the policies of the GroupKind are not merged but applied in order
+	Mergeable bool `json:"mergeable"`
+	// Policies are the attached policies, ordered from the highest to the lowest precedence
+	Policies []InspectedPolicy `json:"policies"`
+	// MergeOrigins are the policy refs contributing to each field of the merged policy
+	MergeOrigins map[string][]string `json:"mergeOrigins,omitempty"`
+}
+
+type InspectedPolicy struct {
+	// Ref is the ID of the policy, empty for the policies built from the route, e.g. its filters
+	Ref string `json:"ref,omitempty"`
+	// Scope is where the policy is attached, e.g. Rule, HTTPRoute, ParentRule, VirtualHost, FilterChain, Listener or Gateway
+	Scope string `json:"scope"`
+	// Precedence is the rank of the policy in the merge, 0 being the highest precedence
+	Precedence int `json:"precedence"`
+	// HierarchicalPriority is the priority of the delegation level the policy is attached to, 0 for the leaf route
+	HierarchicalPriority int `json:"hierarchicalPriority,omitempty"`
+	// InheritedPolicyPriority is the priority of the policy over the policies of the child routes, if set
+	InheritedPolicyPriority string `json:"inheritedPolicyPriority,omitempty"`
+	// Applied are the fields of the policy that survived the merge
+	Applied []string `json:"applied,omitempty"`
+	// Overridden maps the fields of the policy that did not survive the merge to the policies that set them
+	Overridden map[string][]string `json:"overridden,omitempty"`
+	Errors     []string            `json:"errors,omitempty"`
+}
+
+// scopedPolicies are the policies attached at a scope of the config.
+type scopedPolicies struct {
+	scope                string
+	policies             ir.AttachedPolicies
+	hierarchicalPriority int
+}
+
+// addPolicyInspectorHandler registers an endpoint listing the policies attached to every Gateway, listener and route,
+// optionally filtered with gateway=ns/name
+func addPolicyInspectorHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, translations *proxy_syncer.GatewayTranslations) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if translations == nil {
+			writeJSON(w, map[string]string{"error": "Gateway translations not available (Envoy controller may be disabled)"}, r)
+			return
+		}
+
+		var gws []*proxy_syncer.GatewayTranslation
+		if gw := r.URL.Query().Get("gateway"); gw != "" {
+			ns, name, ok := strings.Cut(gw, "/")
+			if !ok || ns == "" || name == "" {
+				writeJSON(w, map[string]string{"error": "gateway must be set as namespace/name"}, r)
+				return
+			}
+			translation := translations.Get(types.NamespacedName{Namespace: ns, Name: name})
+			if translation == nil {
+				writeJSON(w, map[string]string{"error": fmt.Sprintf("gateway %s not found or not translated", gw)}, r)
+				return
+			}
+			gws = append(gws, translation)
+		} else {
+			gws = translations.List()
+		}
+
+		out := make([]GatewayPolicies, 0, len(gws))
+		for _, gw := range gws {
+			out = append(out, inspectPolicies(gw))
+		}
+		writeJSON(w, out, r)
+	})
+	profiles[path] = func() string {
+		return "Policies attached to every Gateway, listener and route, with their precedence and the fields surviving the merge. Query params: gateway=ns/name"
+	}
+}
+
+// inspectPolicies lists the policies of the Gateway, merged the same way as by the irtranslator at each level.
+func inspectPolicies(translation *proxy_syncer.GatewayTranslation) GatewayPolicies {
+	gw := translation.Gateway
+	merge := func(scopes ...scopedPolicies) []MergedPolicies {
+		return mergePolicies(translation.MergePolicies, scopes)
+	}
+
+	out := GatewayPolicies{Gateway: translation.NamespacedName.String()}
+	for _, l := range gw.Listeners {
+		lp := ListenerPolicies{
+			Name: l.Name,
+			Port: l.BindPort,
+			Policies: merge(
+				scopedPolicies{scope: "Listener", policies: l.AttachedPolicies},
+				scopedPolicies{scope: "Gateway", policies: gw.AttachedHttpPolicies},
+			),
+		}
+		for _, fc := range l.HttpFilterChain {
+			fcp := FilterChainPolicies{
+				Name: fc.FilterChainName,
+				Policies: merge(
+					scopedPolicies{scope: "FilterChain", policies: fc.AttachedPolicies},
+					scopedPolicies{scope: "Gateway", policies: gw.AttachedHttpPolicies},
+				),
+			}
+			for _, vh := range fc.Vhosts {
+				vhp := VirtualHostPolicies{
+					Name:     vh.Name,
+					Hostname: vh.Hostname,
+					Policies: merge(scopedPolicies{scope: "VirtualHost", policies: vh.AttachedPolicies}),
+				}
+				for i, rule := range vh.Rules {
+					// delegating parent rules are not translated, their policies are merged into the ones of their children
+					if rule.Delegates {
+						continue
+					}
+					rp := RoutePolicies{
+						Name:       irtranslator.XdsRouteName(vh, i, rule),
+						Rule:       rule.Name,
+						MatchIndex: rule.MatchIndex,
+						Policies:   merge(routePolicyScopes(rule)...),
+					}
+					if rule.Parent != nil {
+						rp.Route = rule.Parent.ObjectSource
+					}
+					vhp.Routes = append(vhp.Routes, rp)
+				}
+				fcp.VirtualHosts = append(fcp.VirtualHosts, vhp)
+			}
+			lp.FilterChains = append(lp.FilterChains, fcp)
+		}
+		out.Listeners = append(out.Listeners, lp)
+	}
+	return out
+}
+
+// routePolicyScopes returns the scopes of the policies applied to the route, from the highest to the lowest priority.
+// Keep in sync with the order in which the irtranslator merges the policies of the routes.
+func routePolicyScopes(rule ir.HttpRouteRuleMatchIR) []scopedPolicies {
+	scopes := []scopedPolicies{
+		{scope: "ExtensionRef", policies: rule.ExtensionRefs},
+		{scope: "Rule", policies: rule.AttachedPolicies},
+	}
+	if rule.Parent != nil {
+		scopes = append(scopes, scopedPolicies{scope: "HTTPRoute", policies: rule.Parent.AttachedPolicies})
+	}
+	hierarchicalPriority := 0
+	for parent := rule.DelegatingParent; parent != nil; parent = parent.DelegatingParent {
+		hierarchicalPriority--
+		scopes = append(scopes,
+			scopedPolicies{scope: "ParentExtensionRef", policies: parent.ExtensionRefs, hierarchicalPriority: hierarchicalPriority},
+			scopedPolicies{scope: "ParentRule", policies: parent.AttachedPolicies, hierarchicalPriority: hierarchicalPriority},
+		)
+		if parent.Parent != nil {
+			scopes = append(scopes, scopedPolicies{scope: "ParentHTTPRoute", policies: parent.Parent.AttachedPolicies, hierarchicalPriority: hierarchicalPriority})
+		}
+	}
+	return scopes
+}
+
+// orderedGroupKinds returns the GroupKinds of the policies of the scopes, in the order they are applied.
+func orderedGroupKinds(scopes []scopedPolicies) []schema.GroupKind {
+	var all ir.AttachedPolicies
+	for _, s := range scopes {
+		all.Append(s.policies)
+	}
+	return all.ApplyOrderedGroupKinds()
+}
+
+// mergePolicies merges the policies of each GroupKind of the scopes, and attributes the fields of the merged
+// policy to the policies they come from.
+func mergePolicies(mergeFns map[schema.GroupKind]proxy_syncer.MergePoliciesFunc, scopes []scopedPolicies) []MergedPolicies {
+	var out []MergedPolicies
+	for _, gk := range orderedGroupKinds(scopes) {
+		// the policies are copied, as the hierarchical priority is set on them for merging
+		var pols []ir.PolicyAtt
+		var inspected []InspectedPolicy
+		for _, s := range scopes {
+			for _, pol := range s.policies.Policies[gk] {
+				pol.HierarchicalPriority = s.hierarchicalPriority
+				pols = append(pols, pol)
+
+				p := InspectedPolicy{
+					Scope:                   s.scope,
+					Precedence:              len(inspected),
+					HierarchicalPriority:    s.hierarchicalPriority,
+					InheritedPolicyPriority: string(pol.InheritedPolicyPriority),
+				}
+				if pol.PolicyRef != nil {
+					p.Ref = pol.PolicyRef.ID()
+				}
+				for _, err := range pol.Errors {
+					p.Errors = append(p.Errors, err.Error())
+				}
+				inspected = append(inspected, p)
+			}
+		}
+		if len(pols) == 0 {
+			continue
+		}
+
+		merged := MergedPolicies{
+			GroupKind: gk.String(),
+			Policies:  inspected,
+		}
+		mergeFn := mergeFns[gk]
+		if mergeFn != nil {
+			merged.Mergeable = true
+			origins := mergeFn(pols).MergeOrigins
+			merged.MergeOrigins = sortedMergeOrigins(origins)
+			for i := range inspected {
+				attributeFields(&inspected[i], pols[i], mergeFn, origins)
+			}
+		}
+		out = append(out, merged)
+	}
+	return out
+}
+
+// attributeFields sets the fields of the policy that survived the merge, and the ones overridden by other policies.
+// The fields of the policy are the ones it sets when merged on its own.
+func attributeFields(p *InspectedPolicy, pol ir.PolicyAtt, mergeFn proxy_syncer.MergePoliciesFunc, origins ir.MergeOrigins) {
+	if pol.PolicyRef == nil || len(pol.Errors) > 0 {
+		return
+	}
+	id := pol.PolicyRef.ID()
+	own := mergeFn([]ir.PolicyAtt{pol}).MergeOrigins
+	for _, field := range slices.Sorted(maps.Keys(own)) {
+		if origins[field].Has(id) {
+			p.Applied = append(p.Applied, field)
+			continue
+		}
+		if p.Overridden == nil {
+			p.Overridden = map[string][]string{}
+		}
+		p.Overridden[field] = sortedRefs(origins.Get(field))
+	}
+}
+
+func sortedMergeOrigins(origins ir.MergeOrigins) map[string][]string {
+	if !origins.IsSet() {
+		return nil
+	}
+	out := make(map[string][]string, len(origins))
+	for field := range origins {
+		out[field] = sortedRefs(origins.Get(field))
+	}
+	return out
+}
+
+func sortedRefs(refs []string) []string {
+	slices.Sort(refs)
+	return refs
+}
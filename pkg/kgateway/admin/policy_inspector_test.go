@@ -0,0 +1,195 @@
+package admin
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/proxy_syncer"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+// fakePolicy is a policy setting the given fields
+type fakePolicy struct {
+	fields []string
+}
+
+func (p *fakePolicy) CreationTime() time.Time { return time.Time{} }
+func (p *fakePolicy) Equals(in any) bool {
+	other, ok := in.(*fakePolicy)
+	return ok && slices.Equal(p.fields, other.fields)
+}
+
+// fakeMergePolicies shallow merges the policies, each field being set by the highest priority policy setting it
+func fakeMergePolicies(pols []ir.PolicyAtt) ir.PolicyAtt {
+	out := ir.PolicyAtt{MergeOrigins: ir.MergeOrigins{}}
+	for _, pol := range pols {
+		if len(pol.Errors) > 0 {
+			continue
+		}
+		for _, field := range pol.PolicyIr.(*fakePolicy).fields {
+			if _, ok := out.MergeOrigins[field]; !ok {
+				out.MergeOrigins.SetOne(field, pol.PolicyRef, pol.MergeOrigins)
+			}
+		}
+	}
+	return out
+}
+
+func testMatch(prefix string) gwv1.HTTPRouteMatch {
+	return gwv1.HTTPRouteMatch{
+		Path: &gwv1.HTTPPathMatch{Type: new(gwv1.PathMatchPathPrefix), Value: new(prefix)},
+	}
+}
+
+func fakePolicies(ns, name string, fields ...string) ir.AttachedPolicies {
+	pols := testPolicies(ns, name)
+	pols.Policies[trafficPolicyGK][0].PolicyIr = &fakePolicy{fields: fields}
+	return pols
+}
+
+func TestInspectPolicies(t *testing.T) {
+	parentRoute := testRoute("default", "parent")
+	parent := testRule(parentRoute, 0, testMatch("/api"))
+	parent.Delegates = true
+	parent.AttachedPolicies = fakePolicies("default", "parent", "retry", "rateLimit")
+
+	childRoute := testRoute("infra", "child")
+	childRoute.AttachedPolicies = fakePolicies("infra", "route-retry", "retry", "timeout")
+	child := testRule(childRoute, 0, testMatch("/api/users"))
+	child.DelegatingParent = &parent
+	child.AttachedPolicies = fakePolicies("infra", "rule-timeout", "timeout")
+
+	invalid := fakePolicies("default", "invalid", "cors")
+	invalid.Policies[trafficPolicyGK][0].Errors = []error{errors.New("invalid cors")}
+	vhPolicies := fakePolicies("default", "vhost", "cors")
+	vhPolicies.Policies[trafficPolicyGK] = append(invalid.Policies[trafficPolicyGK], vhPolicies.Policies[trafficPolicyGK]...)
+
+	vh := &ir.VirtualHost{
+		Name:             "listener~80~example_com",
+		Hostname:         "example.com",
+		Rules:            []ir.HttpRouteRuleMatchIR{parent, child},
+		AttachedPolicies: vhPolicies,
+	}
+	translation := &proxy_syncer.GatewayTranslation{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "gw"},
+		Gateway: &ir.GatewayIR{
+			Listeners: []ir.ListenerIR{{
+				Name:     "listener~80",
+				BindPort: 80,
+				HttpFilterChain: []ir.HttpFilterChainIR{{
+					FilterChainCommon: ir.FilterChainCommon{FilterChainName: "listener~80"},
+					Vhosts:            []*ir.VirtualHost{vh},
+				}},
+			}},
+			AttachedHttpPolicies: fakePolicies("default", "gw-defaults", "timeout", "cors"),
+		},
+		MergePolicies: map[schema.GroupKind]proxy_syncer.MergePoliciesFunc{
+			trafficPolicyGK: fakeMergePolicies,
+		},
+	}
+
+	out := inspectPolicies(translation)
+	assert.Equal(t, "default/gw", out.Gateway)
+	require.Len(t, out.Listeners, 1)
+	l := out.Listeners[0]
+
+	gwDefaults := InspectedPolicy{
+		Ref:     "gateway.kgateway.dev/TrafficPolicy/default/gw-defaults",
+		Scope:   "Gateway",
+		Applied: []string{"cors", "timeout"},
+	}
+	assert.Equal(t, []MergedPolicies{{
+		GroupKind: "TrafficPolicy.gateway.kgateway.dev",
+		Mergeable: true,
+		Policies:  []InspectedPolicy{gwDefaults},
+		MergeOrigins: map[string][]string{
+			"cors":    {"gateway.kgateway.dev/TrafficPolicy/default/gw-defaults"},
+			"timeout": {"gateway.kgateway.dev/TrafficPolicy/default/gw-defaults"},
+		},
+	}}, l.Policies)
+
+	require.Len(t, l.FilterChains, 1)
+	require.Len(t, l.FilterChains[0].VirtualHosts, 1)
+	vhp := l.FilterChains[0].VirtualHosts[0]
+	assert.Equal(t, []InspectedPolicy{
+		{
+			Ref:    "gateway.kgateway.dev/TrafficPolicy/default/invalid",
+			Scope:  "VirtualHost",
+			Errors: []string{"invalid cors"},
+		},
+		{
+			Ref:        "gateway.kgateway.dev/TrafficPolicy/default/vhost",
+			Scope:      "VirtualHost",
+			Precedence: 1,
+			Applied:    []string{"cors"},
+		},
+	}, vhp.Policies[0].Policies)
+
+	// the delegating parent rule is not translated
+	require.Len(t, vhp.Routes, 1)
+	route := vhp.Routes[0]
+	assert.Equal(t, "listener~80~example_com-route-1-httproute-child-infra-0-0-matcher-0", route.Name)
+	assert.Equal(t, childRoute.ObjectSource, route.Route)
+	require.Len(t, route.Policies, 1)
+	assert.Equal(t, map[string][]string{
+		"rateLimit": {"gateway.kgateway.dev/TrafficPolicy/default/parent"},
+		"retry":     {"gateway.kgateway.dev/TrafficPolicy/infra/route-retry"},
+		"timeout":   {"gateway.kgateway.dev/TrafficPolicy/infra/rule-timeout"},
+	}, route.Policies[0].MergeOrigins)
+	assert.Equal(t, []InspectedPolicy{
+		{
+			Ref:     "gateway.kgateway.dev/TrafficPolicy/infra/rule-timeout",
+			Scope:   "Rule",
+			Applied: []string{"timeout"},
+		},
+		{
+			Ref:        "gateway.kgateway.dev/TrafficPolicy/infra/route-retry",
+			Scope:      "HTTPRoute",
+			Precedence: 1,
+			Applied:    []string{"retry"},
+			Overridden: map[string][]string{"timeout": {"gateway.kgateway.dev/TrafficPolicy/infra/rule-timeout"}},
+		},
+		{
+			Ref:                  "gateway.kgateway.dev/TrafficPolicy/default/parent",
+			Scope:                "ParentRule",
+			Precedence:           2,
+			HierarchicalPriority: -1,
+			Applied:              []string{"rateLimit"},
+			Overridden:           map[string][]string{"retry": {"gateway.kgateway.dev/TrafficPolicy/infra/route-retry"}},
+		},
+	}, route.Policies[0].Policies)
+
+	// the hierarchical priority is only set on the merged copies of the policies
+	assert.Zero(t, parent.AttachedPolicies.Policies[trafficPolicyGK][0].HierarchicalPriority)
+}
+
+func TestInspectPoliciesNotMergeable(t *testing.T) {
+	translation := &proxy_syncer.GatewayTranslation{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "gw"},
+		Gateway: &ir.GatewayIR{
+			Listeners: []ir.ListenerIR{{
+				Name:             "listener~80",
+				BindPort:         80,
+				AttachedPolicies: fakePolicies("default", "listener", "timeout"),
+			}},
+		},
+	}
+
+	out := inspectPolicies(translation)
+	require.Len(t, out.Listeners, 1)
+	assert.Equal(t, []MergedPolicies{{
+		GroupKind: "TrafficPolicy.gateway.kgateway.dev",
+		Policies: []InspectedPolicy{{
+			Ref:   "gateway.kgateway.dev/TrafficPolicy/default/listener",
+			Scope: "Listener",
+		}},
+	}}, out.Listeners[0].Policies)
+}
@@ -0,0 +1,533 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/proxy_syncer"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/irtranslator"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/routeutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+// mergeMetadataKeyPrefix prefixes the filter metadata holding the merge origins of the policies of a GroupKind
+// in the translated route configurations, virtual hosts and routes.
+const mergeMetadataKeyPrefix = "merge."
+
+// RouteExplanation explains how a request is routed by a Gateway.
+type RouteExplanation struct {
+	Gateway  string             `json:"gateway"`
+	Request  ExplainedRequest   `json:"request"`
+	Listener *ExplainedListener `json:"listener,omitempty"`
+	// VirtualHost is the virtual host selected for the host of the request
+	VirtualHost *ExplainedVirtualHost `json:"virtualHost,omitempty"`
+	// Route is the route matching the request
+	Route *ExplainedRoute `json:"route,omitempty"`
+	// Policies are the policies applying to the request, from the most to the least specific
+	Policies []ExplainedPolicy `json:"policies,omitempty"`
+	// Reason explains why the request is not routed, if no route matches it
+	Reason string `json:"reason,omitempty"`
+}
+
+type ExplainedRequest struct {
+	Host    string      `json:"host"`
+	Path    string      `json:"path"`
+	Method  string      `json:"method"`
+	Headers http.Header `json:"headers,omitempty"`
+	Port    uint32      `json:"port,omitempty"`
+}
+
+type ExplainedListener struct {
+	Name               string `json:"name"`
+	Port               uint32 `json:"port"`
+	RouteConfiguration string `json:"routeConfiguration"`
+	// MergeOrigins are the policy refs contributing to each field of the merged policies of the route configuration, by GroupKind
+	MergeOrigins map[string]map[string][]string `json:"mergeOrigins,omitempty"`
+}
+
+type ExplainedVirtualHost struct {
+	Name     string `json:"name"`
+	Hostname string `json:"hostname"`
+	// MergeOrigins are the policy refs contributing to each field of the merged policies of the virtual host, by GroupKind
+	MergeOrigins map[string]map[string][]string `json:"mergeOrigins,omitempty"`
+}
+
+type ExplainedRoute struct {
+	// Name is the name of the translated xDS route
+	Name string `json:"name"`
+	ExplainedRouteRule
+	// DelegationChain holds the delegating parent route rules, from the closest parent to the root
+	DelegationChain []ExplainedRouteRule `json:"delegationChain,omitempty"`
+	// Action is the action of the xDS route, e.g. route, redirect or directResponse
+	Action string `json:"action,omitempty"`
+	// Clusters are the clusters the xDS route forwards the request to
+	Clusters []ExplainedCluster `json:"clusters,omitempty"`
+	Backends []ExplainedBackend `json:"backends,omitempty"`
+	// MergeOrigins are the policy refs contributing to each field of the merged policies of the route, by GroupKind
+	MergeOrigins map[string]map[string][]string `json:"mergeOrigins,omitempty"`
+	// Errors are the errors replacing the route or preventing its translation
+	Errors []string `json:"errors,omitempty"`
+}
+
+type ExplainedRouteRule struct {
+	Route      ir.ObjectSource     `json:"route"`
+	Rule       string              `json:"rule,omitempty"`
+	MatchIndex int                 `json:"matchIndex"`
+	Match      gwv1.HTTPRouteMatch `json:"match"`
+}
+
+type ExplainedCluster struct {
+	Name   string `json:"name"`
+	Weight uint32 `json:"weight,omitempty"`
+}
+
+type ExplainedBackend struct {
+	Cluster string           `json:"cluster"`
+	Weight  uint32           `json:"weight"`
+	Backend *ir.ObjectSource `json:"backend,omitempty"`
+	Error   string           `json:"error,omitempty"`
+}
+
+type ExplainedPolicy struct {
+	GroupKind string `json:"groupKind"`
+	// Ref is the ID of the policy, empty for the policies built from the route, e.g. its filters
+	Ref string `json:"ref,omitempty"`
+	// Scope is where the policy is attached, e.g. Rule, HTTPRoute, ParentRule, VirtualHost, FilterChain or Gateway
+	Scope  string   `json:"scope"`
+	Errors []string `json:"errors,omitempty"`
+}
+
+// addRouteExplainHandler registers an endpoint explaining how a request would be routed by a Gateway, e.g.
+// /routes/explain?gateway=ns/name&host=example.com&path=/foo?bar=baz&method=GET&header=x-user:alice
+func addRouteExplainHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, translations *proxy_syncer.GatewayTranslations) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if translations == nil {
+			writeJSON(w, map[string]string{"error": "Gateway translations not available (Envoy controller may be disabled)"}, r)
+			return
+		}
+		gw, req, err := parseExplainRequest(r.URL.Query())
+		if err != nil {
+			writeJSON(w, map[string]string{"error": err.Error()}, r)
+			return
+		}
+		translation := translations.Get(gw)
+		if translation == nil {
+			writeJSON(w, map[string]string{"error": fmt.Sprintf("gateway %s not found or not translated", gw)}, r)
+			return
+		}
+		writeJSON(w, explainRoute(r.Context(), translation, gw, req), r)
+	})
+	profiles[path] = func() string {
+		return "Explain how a request is routed by a Gateway. Query params: gateway=ns/name, host, path, method, header=name:value (repeated), port"
+	}
+}
+
+func parseExplainRequest(query url.Values) (types.NamespacedName, ExplainedRequest, error) {
+	var gw types.NamespacedName
+	ns, name, ok := strings.Cut(query.Get("gateway"), "/")
+	if !ok || ns == "" || name == "" {
+		return gw, ExplainedRequest{}, errors.New("gateway must be set as namespace/name")
+	}
+	gw = types.NamespacedName{Namespace: ns, Name: name}
+
+	req := ExplainedRequest{
+		Host:   query.Get("host"),
+		Path:   query.Get("path"),
+		Method: strings.ToUpper(query.Get("method")),
+	}
+	if req.Host == "" {
+		return gw, req, errors.New("host must be set")
+	}
+	if req.Path == "" {
+		req.Path = "/"
+	}
+	if req.Method == "" {
+		req.Method = http.MethodGet
+	}
+	for _, header := range query["header"] {
+		k, v, ok := strings.Cut(header, ":")
+		if !ok || k == "" {
+			return gw, req, fmt.Errorf("invalid header %q, must be set as name:value", header)
+		}
+		if req.Headers == nil {
+			req.Headers = http.Header{}
+		}
+		req.Headers.Add(strings.TrimSpace(k), strings.TrimSpace(v))
+	}
+	if port := query.Get("port"); port != "" {
+		p, err := strconv.ParseUint(port, 10, 16)
+		if err != nil {
+			return gw, req, fmt.Errorf("invalid port %q: %w", port, err)
+		}
+		req.Port = uint32(p)
+	}
+	return gw, req, nil
+}
+
+// explainRoute matches the request against the IR of the Gateway, the same way Envoy matches it against the
+// translated route configurations, and explains the matching route with its translated xDS route.
+func explainRoute(ctx context.Context, translation *proxy_syncer.GatewayTranslation, gw types.NamespacedName, req ExplainedRequest) *RouteExplanation {
+	out := &RouteExplanation{
+		Gateway: gw.String(),
+		Request: req,
+	}
+	// the port is ignored when matching the host, as the route configurations set IgnorePortInHostMatching
+	host := strings.ToLower(req.Host)
+	if h, _, ok := strings.Cut(host, ":"); ok {
+		host = h
+	}
+
+	// the host may be served by several listeners, e.g. on ports 80 and 443, so all the listeners
+	// are matched before reporting that no route matches the request
+	var candidates int
+	var matched, routed []*RouteExplanation
+	for _, l := range translation.Gateway.Listeners {
+		if req.Port != 0 && l.BindPort != req.Port {
+			continue
+		}
+		fc := selectFilterChain(l.HttpFilterChain, host)
+		if fc == nil {
+			continue
+		}
+		candidates++
+		vh := selectVirtualHost(fc.Vhosts, host)
+		if vh == nil {
+			continue
+		}
+		explained := explainListener(ctx, translation, l, fc, vh, *out, req)
+		matched = append(matched, explained)
+		if explained.Route != nil {
+			routed = append(routed, explained)
+		}
+	}
+
+	switch {
+	case len(routed) == 1:
+		return routed[0]
+	case len(routed) > 1:
+		ports := make([]string, 0, len(routed))
+		for _, r := range routed {
+			ports = append(ports, strconv.FormatUint(uint64(r.Listener.Port), 10))
+		}
+		out.Reason = fmt.Sprintf("the request is routed by the listeners on ports %s, set the port to select the listener", strings.Join(ports, ", "))
+	case len(matched) == 1:
+		return matched[0]
+	case len(matched) > 1:
+		vhosts := make([]string, 0, len(matched))
+		for _, m := range matched {
+			vhosts = append(vhosts, m.VirtualHost.Name)
+		}
+		out.Reason = fmt.Sprintf("no route of virtual hosts %s matches the request", strings.Join(vhosts, ", "))
+	case candidates == 0 && req.Port != 0:
+		out.Reason = fmt.Sprintf("no HTTP listener on port %d", req.Port)
+	case candidates == 0:
+		out.Reason = "no HTTP listener"
+	default:
+		out.Reason = fmt.Sprintf("no virtual host matches host %s", host)
+	}
+	return out
+}
+
+// explainListener explains how the request is routed by the virtual host of a listener.
+func explainListener(
+	ctx context.Context,
+	translation *proxy_syncer.GatewayTranslation,
+	l ir.ListenerIR,
+	fc *ir.HttpFilterChainIR,
+	vh *ir.VirtualHost,
+	out RouteExplanation,
+	req ExplainedRequest,
+) *RouteExplanation {
+	rc := translation.Routes[fc.FilterChainName]
+	out.Listener = &ExplainedListener{
+		Name:               l.Name,
+		Port:               l.BindPort,
+		RouteConfiguration: fc.FilterChainName,
+		MergeOrigins:       mergeOriginsFromMetadata(rc.GetMetadata().GetFilterMetadata()),
+	}
+	vhName := utils.SanitizeForEnvoy(ctx, vh.Name, "virtual host")
+	xdsVh := findVirtualHost(rc, vhName)
+	out.VirtualHost = &ExplainedVirtualHost{
+		Name:         vhName,
+		Hostname:     vh.Hostname,
+		MergeOrigins: mergeOriginsFromMetadata(xdsVh.GetMetadata().GetFilterMetadata()),
+	}
+
+	for i, rule := range vh.Rules {
+		// delegating parent rules are not translated, their children are
+		if rule.Delegates || !matchRequest(rule.Match, req) {
+			continue
+		}
+		out.Route = explainRouteRule(vh, i, rule, xdsVh)
+		out.Policies = explainPolicies(rule, vh, fc, translation.Gateway)
+		return &out
+	}
+	out.Reason = fmt.Sprintf("no route of virtual host %s matches the request", vh.Name)
+	return &out
+}
+
+func explainRouteRule(vh *ir.VirtualHost, idx int, rule ir.HttpRouteRuleMatchIR, xdsVh *envoyroutev3.VirtualHost) *ExplainedRoute {
+	name := irtranslator.XdsRouteName(vh, idx, rule)
+	out := &ExplainedRoute{
+		Name:               name,
+		ExplainedRouteRule: explainRule(rule),
+	}
+	for parent := rule.DelegatingParent; parent != nil; parent = parent.DelegatingParent {
+		out.DelegationChain = append(out.DelegationChain, explainRule(*parent))
+	}
+	for _, b := range rule.Backends {
+		backend := ExplainedBackend{
+			Cluster: b.Backend.ClusterName,
+			Weight:  b.Backend.Weight,
+		}
+		if b.Backend.BackendObject != nil {
+			backend.Backend = &b.Backend.BackendObject.ObjectSource
+		}
+		if b.Backend.Err != nil {
+			backend.Error = b.Backend.Err.Error()
+		}
+		out.Backends = append(out.Backends, backend)
+	}
+	for _, err := range []error{rule.RouteAcceptanceError, rule.RouteReplacementError} {
+		if err != nil {
+			out.Errors = append(out.Errors, err.Error())
+		}
+	}
+
+	var xdsRoute *envoyroutev3.Route
+	for _, r := range xdsVh.GetRoutes() {
+		if r.GetName() == name {
+			xdsRoute = r
+			break
+		}
+	}
+	if xdsRoute == nil {
+		out.Errors = append(out.Errors, "route not found in the translated virtual host, it was dropped or its virtual host was replaced")
+		return out
+	}
+	out.MergeOrigins = mergeOriginsFromMetadata(xdsRoute.GetMetadata().GetFilterMetadata())
+	switch action := xdsRoute.GetAction().(type) {
+	case *envoyroutev3.Route_Route:
+		out.Action = "route"
+		if cluster := action.Route.GetCluster(); cluster != "" {
+			out.Clusters = []ExplainedCluster{{Name: cluster}}
+		}
+		for _, c := range action.Route.GetWeightedClusters().GetClusters() {
+			out.Clusters = append(out.Clusters, ExplainedCluster{Name: c.GetName(), Weight: c.GetWeight().GetValue()})
+		}
+	case *envoyroutev3.Route_Redirect:
+		out.Action = "redirect"
+	case *envoyroutev3.Route_DirectResponse:
+		out.Action = "directResponse"
+	}
+	return out
+}
+
+func explainRule(rule ir.HttpRouteRuleMatchIR) ExplainedRouteRule {
+	out := ExplainedRouteRule{
+		Rule:       rule.Name,
+		MatchIndex: rule.MatchIndex,
+		Match:      rule.Match,
+	}
+	if rule.Parent != nil {
+		out.Route = rule.Parent.ObjectSource
+	}
+	return out
+}
+
+// explainPolicies lists the policies applying to the request, in the order they are merged by the irtranslator.
+func explainPolicies(rule ir.HttpRouteRuleMatchIR, vh *ir.VirtualHost, fc *ir.HttpFilterChainIR, gw *ir.GatewayIR) []ExplainedPolicy {
+	scopes := append(routePolicyScopes(rule),
+		scopedPolicies{scope: "VirtualHost", policies: vh.AttachedPolicies},
+		scopedPolicies{scope: "FilterChain", policies: fc.AttachedPolicies},
+		scopedPolicies{scope: "Gateway", policies: gw.AttachedHttpPolicies},
+	)
+
+	var out []ExplainedPolicy
+	for _, s := range scopes {
+		for _, gk := range s.policies.ApplyOrderedGroupKinds() {
+			for _, pol := range s.policies.Policies[gk] {
+				p := ExplainedPolicy{
+					GroupKind: gk.String(),
+					Scope:     s.scope,
+				}
+				if pol.PolicyRef != nil {
+					p.Ref = pol.PolicyRef.ID()
+				}
+				for _, err := range pol.Errors {
+					p.Errors = append(p.Errors, err.Error())
+				}
+				out = append(out, p)
+			}
+		}
+	}
+	return out
+}
+
+// selectFilterChain returns the HTTP filter chain serving the host, matching its SNI domains if any.
+func selectFilterChain(fcs []ir.HttpFilterChainIR, host string) *ir.HttpFilterChainIR {
+	var fallback *ir.HttpFilterChainIR
+	best := -1
+	var selected *ir.HttpFilterChainIR
+	for i := range fcs {
+		fc := &fcs[i]
+		if len(fc.Matcher.SniDomains) == 0 {
+			if fallback == nil {
+				fallback = fc
+			}
+			continue
+		}
+		for _, domain := range fc.Matcher.SniDomains {
+			if score := matchDomain(domain, host); score > best {
+				best = score
+				selected = fc
+			}
+		}
+	}
+	if selected != nil {
+		return selected
+	}
+	return fallback
+}
+
+// selectVirtualHost returns the virtual host selected by Envoy for the host: an exact domain first, then the
+// longest wildcard domain, then the catch-all domain.
+func selectVirtualHost(vhosts []*ir.VirtualHost, host string) *ir.VirtualHost {
+	best := -1
+	var selected *ir.VirtualHost
+	for _, vh := range vhosts {
+		domain := vh.Hostname
+		if domain == "" {
+			domain = "*"
+		}
+		if score := matchDomain(domain, host); score > best {
+			best = score
+			selected = vh
+		}
+	}
+	return selected
+}
+
+// matchDomain returns how specific the match of the domain is for the host, or -1 if it does not match.
+func matchDomain(domain, host string) int {
+	domain = strings.ToLower(domain)
+	switch {
+	case domain == host:
+		return 2 * (len(host) + 1)
+	case domain == "*":
+		return 0
+	case strings.HasPrefix(domain, "*") && len(host) > len(domain)-1 && strings.HasSuffix(host, domain[1:]):
+		return 2 * len(domain)
+	}
+	return -1
+}
+
+func findVirtualHost(rc *envoyroutev3.RouteConfiguration, name string) *envoyroutev3.VirtualHost {
+	for _, xdsVh := range rc.GetVirtualHosts() {
+		if xdsVh.GetName() == name {
+			return xdsVh
+		}
+	}
+	return nil
+}
+
+// matchRequest matches the request the same way as the Envoy route match translated from the HTTPRouteMatch.
+func matchRequest(match gwv1.HTTPRouteMatch, req ExplainedRequest) bool {
+	path, rawQuery, _ := strings.Cut(req.Path, "?")
+	if !matchPath(match.Path, path) {
+		return false
+	}
+	if match.Method != nil && string(*match.Method) != req.Method {
+		return false
+	}
+	for _, h := range match.Headers {
+		values := req.Headers.Values(string(h.Name))
+		regex := h.Type != nil && *h.Type == gwv1.HeaderMatchRegularExpression
+		if !slices.ContainsFunc(values, func(v string) bool { return matchValue(h.Value, v, regex) }) {
+			return false
+		}
+	}
+	if len(match.QueryParams) > 0 {
+		query, err := url.ParseQuery(rawQuery)
+		if err != nil {
+			return false
+		}
+		for _, q := range match.QueryParams {
+			values, ok := query[string(q.Name)]
+			regex := q.Type != nil && *q.Type == gwv1.QueryParamMatchRegularExpression
+			if !ok || !matchValue(q.Value, values[0], regex) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func matchPath(match *gwv1.HTTPPathMatch, path string) bool {
+	pathType, value := routeutils.ParsePath(match)
+	switch pathType {
+	case gwv1.PathMatchExact:
+		return path == value
+	case gwv1.PathMatchRegularExpression:
+		return matchRegex(value, path)
+	default:
+		if !strings.HasPrefix(path, value) {
+			return false
+		}
+		// prefixes not ending with a slash are translated to path separated prefixes
+		return strings.HasSuffix(value, "/") || len(path) == len(value) || path[len(value)] == '/'
+	}
+}
+
+// matchValue matches a header or query param value, an empty expected value only requiring it to be present.
+func matchValue(expected, value string, regex bool) bool {
+	switch {
+	case expected == "":
+		return true
+	case regex:
+		return matchRegex(expected, value)
+	default:
+		return expected == value
+	}
+}
+
+// matchRegex fully matches the value against the regex, as Envoy safe regexes do.
+func matchRegex(regex, value string) bool {
+	re, err := regexp.Compile("^(?:" + regex + ")$")
+	return err == nil && re.MatchString(value)
+}
+
+// mergeOriginsFromMetadata returns the merge origins of the policies recorded in the filter metadata, by GroupKind.
+func mergeOriginsFromMetadata(metadata map[string]*structpb.Struct) map[string]map[string][]string {
+	var out map[string]map[string][]string
+	for key, s := range metadata {
+		gk, ok := strings.CutPrefix(key, mergeMetadataKeyPrefix)
+		if !ok {
+			continue
+		}
+		origins := map[string][]string{}
+		for field, refs := range s.GetFields() {
+			for _, ref := range refs.GetListValue().GetValues() {
+				origins[field] = append(origins[field], ref.GetStringValue())
+			}
+		}
+		if out == nil {
+			out = map[string]map[string][]string{}
+		}
+		out[gk] = origins
+	}
+	return out
+}
@@ -0,0 +1,450 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/proxy_syncer"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+var (
+	trafficPolicyGK = schema.GroupKind{Group: "gateway.kgateway.dev", Kind: "TrafficPolicy"}
+	gwNN            = types.NamespacedName{Namespace: "default", Name: "gw"}
+)
+
+func TestParseExplainRequest(t *testing.T) {
+	testCases := []struct {
+		name    string
+		query   string
+		wantGw  types.NamespacedName
+		want    ExplainedRequest
+		wantErr string
+	}{
+		{
+			name:   "defaults",
+			query:  "gateway=default/gw&host=example.com",
+			wantGw: gwNN,
+			want:   ExplainedRequest{Host: "example.com", Path: "/", Method: http.MethodGet},
+		},
+		{
+			name:   "all params",
+			query:  "gateway=default/gw&host=example.com&path=/foo%3Fa%3Db&method=post&header=x-user:%20alice&header=x-user:bob&port=8080",
+			wantGw: gwNN,
+			want: ExplainedRequest{
+				Host:    "example.com",
+				Path:    "/foo?a=b",
+				Method:  http.MethodPost,
+				Headers: http.Header{"X-User": {"alice", "bob"}},
+				Port:    8080,
+			},
+		},
+		{
+			name:    "missing gateway namespace",
+			query:   "gateway=gw&host=example.com",
+			wantErr: "gateway must be set as namespace/name",
+		},
+		{
+			name:    "missing host",
+			query:   "gateway=default/gw",
+			wantErr: "host must be set",
+		},
+		{
+			name:    "invalid header",
+			query:   "gateway=default/gw&host=example.com&header=x-user",
+			wantErr: `invalid header "x-user"`,
+		},
+		{
+			name:    "invalid port",
+			query:   "gateway=default/gw&host=example.com&port=http",
+			wantErr: `invalid port "http"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tc.query)
+			require.NoError(t, err)
+
+			gw, req, err := parseExplainRequest(query)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantGw, gw)
+			assert.Equal(t, tc.want, req)
+		})
+	}
+}
+
+func TestExplainRoute(t *testing.T) {
+	translation := testGatewayTranslation()
+
+	testCases := []struct {
+		name string
+		req  ExplainedRequest
+		// wantRoute is the name of the matched xDS route
+		wantRoute  string
+		wantVhost  string
+		wantReason string
+	}{
+		{
+			name:      "exact path",
+			req:       ExplainedRequest{Host: "example.com", Path: "/exact", Method: http.MethodGet},
+			wantVhost: "listener~80~example_com",
+			wantRoute: "listener~80~example_com-route-0-httproute-exact-default-0-0-matcher-0",
+		},
+		{
+			name:      "port of host is ignored",
+			req:       ExplainedRequest{Host: "example.com:80", Path: "/exact", Method: http.MethodGet},
+			wantVhost: "listener~80~example_com",
+			wantRoute: "listener~80~example_com-route-0-httproute-exact-default-0-0-matcher-0",
+		},
+		{
+			name:      "header match",
+			req:       ExplainedRequest{Host: "example.com", Path: "/api/users", Method: http.MethodGet, Headers: http.Header{"X-Version": {"v2"}}},
+			wantVhost: "listener~80~example_com",
+			wantRoute: "listener~80~example_com-route-1-httproute-api-default-0-0-matcher-0",
+		},
+		{
+			name:      "header mismatch falls through to the next route",
+			req:       ExplainedRequest{Host: "example.com", Path: "/api/users", Method: http.MethodGet, Headers: http.Header{"X-Version": {"v1"}}},
+			wantVhost: "listener~80~example_com",
+			wantRoute: "listener~80~example_com-route-3-httproute-child-infra-0-0-matcher-0",
+		},
+		{
+			name:      "path separated prefix does not match a longer segment",
+			req:       ExplainedRequest{Host: "example.com", Path: "/apiv2", Method: http.MethodGet},
+			wantVhost: "listener~80~example_com",
+			wantRoute: "listener~80~example_com-route-4-httproute-catchall-default-0-0-matcher-0",
+		},
+		{
+			name:      "query string is not part of the path",
+			req:       ExplainedRequest{Host: "example.com", Path: "/search?q=kgateway", Method: http.MethodGet},
+			wantVhost: "listener~80~example_com",
+			wantRoute: "listener~80~example_com-route-4-httproute-catchall-default-0-0-matcher-0",
+		},
+		{
+			name:      "wildcard host",
+			req:       ExplainedRequest{Host: "foo.example.com", Path: "/", Method: http.MethodGet},
+			wantVhost: "listener~80~*_example_com",
+			wantRoute: "listener~80~*_example_com-route-0-httproute-wildcard-default-0-0-matcher-0",
+		},
+		{
+			name:       "no matching route",
+			req:        ExplainedRequest{Host: "foo.example.com", Path: "/", Method: http.MethodPost},
+			wantVhost:  "listener~80~*_example_com",
+			wantReason: "no route of virtual host listener~80~*_example_com matches the request",
+		},
+		{
+			name:       "no matching virtual host",
+			req:        ExplainedRequest{Host: "example.org", Path: "/", Method: http.MethodGet},
+			wantReason: "no virtual host matches host example.org",
+		},
+		{
+			name:       "no listener on port",
+			req:        ExplainedRequest{Host: "example.com", Path: "/", Method: http.MethodGet, Port: 443},
+			wantReason: "no HTTP listener on port 443",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := explainRoute(context.Background(), translation, gwNN, tc.req)
+			assert.Equal(t, tc.wantReason, out.Reason)
+			if tc.wantVhost == "" {
+				assert.Nil(t, out.VirtualHost)
+			} else {
+				require.NotNil(t, out.VirtualHost)
+				assert.Equal(t, tc.wantVhost, out.VirtualHost.Name)
+			}
+			if tc.wantRoute == "" {
+				assert.Nil(t, out.Route)
+				return
+			}
+			require.NotNil(t, out.Route)
+			assert.Equal(t, tc.wantRoute, out.Route.Name)
+			assert.Empty(t, out.Route.Errors)
+		})
+	}
+}
+
+func TestExplainRouteDelegation(t *testing.T) {
+	out := explainRoute(context.Background(), testGatewayTranslation(), gwNN, ExplainedRequest{
+		Host:   "example.com",
+		Path:   "/api/users",
+		Method: http.MethodGet,
+	})
+
+	require.NotNil(t, out.Route)
+	assert.Equal(t, ir.ObjectSource{Kind: "HTTPRoute", Namespace: "infra", Name: "child"}, out.Route.Route)
+	require.Len(t, out.Route.DelegationChain, 1)
+	assert.Equal(t, ir.ObjectSource{Kind: "HTTPRoute", Namespace: "default", Name: "api"}, out.Route.DelegationChain[0].Route)
+	assert.Equal(t, "httproute-api-default-1-0", out.Route.DelegationChain[0].Rule)
+
+	assert.Equal(t, "route", out.Route.Action)
+	assert.Equal(t, []ExplainedCluster{{Name: "kube_infra_users_8080"}}, out.Route.Clusters)
+	assert.Equal(t, []ExplainedBackend{{
+		Cluster: "kube_infra_users_8080",
+		Weight:  1,
+		Backend: &ir.ObjectSource{Kind: "Service", Namespace: "infra", Name: "users"},
+	}}, out.Route.Backends)
+	assert.Equal(t, map[string]map[string][]string{
+		"TrafficPolicy.gateway.kgateway.dev": {
+			"timeouts":  {"gateway.kgateway.dev/TrafficPolicy/infra/child-timeouts"},
+			"rateLimit": {"gateway.kgateway.dev/TrafficPolicy/default/parent-ratelimit"},
+		},
+	}, out.Route.MergeOrigins)
+
+	assert.Equal(t, []ExplainedPolicy{
+		{GroupKind: "TrafficPolicy.gateway.kgateway.dev", Ref: "gateway.kgateway.dev/TrafficPolicy/infra/child-timeouts", Scope: "HTTPRoute"},
+		{GroupKind: "TrafficPolicy.gateway.kgateway.dev", Ref: "gateway.kgateway.dev/TrafficPolicy/default/parent-ratelimit", Scope: "ParentRule"},
+		{GroupKind: "TrafficPolicy.gateway.kgateway.dev", Ref: "gateway.kgateway.dev/TrafficPolicy/default/gw-cors", Scope: "Gateway"},
+	}, out.Policies)
+}
+
+func TestExplainRouteMultipleListeners(t *testing.T) {
+	// the host is served by a listener on port 443 before the listener on port 80
+	translation := testGatewayTranslation()
+	secure := testRule(testRoute("default", "secure"), 0, gwv1.HTTPRouteMatch{
+		Path: &gwv1.HTTPPathMatch{Type: new(gwv1.PathMatchExact), Value: new("/secure")},
+	})
+	translation.Gateway.Listeners = append([]ir.ListenerIR{{
+		Name:     "listener~443",
+		BindPort: 443,
+		HttpFilterChain: []ir.HttpFilterChainIR{{
+			FilterChainCommon: ir.FilterChainCommon{FilterChainName: "listener~443"},
+			Vhosts: []*ir.VirtualHost{{
+				Name:     "listener~443~example_com",
+				Hostname: "example.com",
+				Rules:    []ir.HttpRouteRuleMatchIR{secure},
+			}},
+		}},
+	}}, translation.Gateway.Listeners...)
+	translation.Routes["listener~443"] = &envoyroutev3.RouteConfiguration{
+		Name: "listener~443",
+		VirtualHosts: []*envoyroutev3.VirtualHost{{
+			Name:    "listener~443~example_com",
+			Domains: []string{"example.com"},
+			Routes: []*envoyroutev3.Route{{
+				Name: "listener~443~example_com-route-0-httproute-secure-default-0-0-matcher-0",
+				Action: &envoyroutev3.Route_Route{Route: &envoyroutev3.RouteAction{
+					ClusterSpecifier: &envoyroutev3.RouteAction_Cluster{Cluster: "secure"},
+				}},
+			}},
+		}},
+	}
+
+	testCases := []struct {
+		name         string
+		req          ExplainedRequest
+		wantListener string
+		wantRoute    string
+		wantReason   string
+	}{
+		{
+			name:         "routed by the second listener only",
+			req:          ExplainedRequest{Host: "example.com", Path: "/exact", Method: http.MethodGet},
+			wantListener: "listener~80",
+			wantRoute:    "listener~80~example_com-route-0-httproute-exact-default-0-0-matcher-0",
+		},
+		{
+			name:       "routed by both listeners",
+			req:        ExplainedRequest{Host: "example.com", Path: "/secure", Method: http.MethodGet},
+			wantReason: "the request is routed by the listeners on ports 443, 80, set the port to select the listener",
+		},
+		{
+			name:         "routed by the listener on the port",
+			req:          ExplainedRequest{Host: "example.com", Path: "/secure", Method: http.MethodGet, Port: 443},
+			wantListener: "listener~443",
+			wantRoute:    "listener~443~example_com-route-0-httproute-secure-default-0-0-matcher-0",
+		},
+		{
+			name:         "not routed by the listener on the port",
+			req:          ExplainedRequest{Host: "example.com", Path: "/exact", Method: http.MethodGet, Port: 443},
+			wantListener: "listener~443",
+			wantReason:   "no route of virtual host listener~443~example_com matches the request",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := explainRoute(context.Background(), translation, gwNN, tc.req)
+			assert.Equal(t, tc.wantReason, out.Reason)
+			if tc.wantListener == "" {
+				assert.Nil(t, out.Listener)
+			} else {
+				require.NotNil(t, out.Listener)
+				assert.Equal(t, tc.wantListener, out.Listener.Name)
+			}
+			if tc.wantRoute == "" {
+				assert.Nil(t, out.Route)
+				return
+			}
+			require.NotNil(t, out.Route)
+			assert.Equal(t, tc.wantRoute, out.Route.Name)
+		})
+	}
+}
+
+func testPolicies(ns, name string) ir.AttachedPolicies {
+	return ir.AttachedPolicies{
+		Policies: map[schema.GroupKind][]ir.PolicyAtt{
+			trafficPolicyGK: {{
+				GroupKind: trafficPolicyGK,
+				PolicyRef: &ir.AttachedPolicyRef{
+					Group:     trafficPolicyGK.Group,
+					Kind:      trafficPolicyGK.Kind,
+					Namespace: ns,
+					Name:      name,
+				},
+			}},
+		},
+	}
+}
+
+func testRule(route *ir.HttpRouteIR, ruleIdx int, match gwv1.HTTPRouteMatch) ir.HttpRouteRuleMatchIR {
+	return ir.HttpRouteRuleMatchIR{
+		Parent: route,
+		Match:  match,
+		Name:   fmt.Sprintf("httproute-%s-%s-%d-0", route.Name, route.Namespace, ruleIdx),
+	}
+}
+
+func testRoute(ns, name string) *ir.HttpRouteIR {
+	return &ir.HttpRouteIR{
+		ObjectSource: ir.ObjectSource{Kind: "HTTPRoute", Namespace: ns, Name: name},
+	}
+}
+
+func testGatewayTranslation() *proxy_syncer.GatewayTranslation {
+	exact := testRule(testRoute("default", "exact"), 0, gwv1.HTTPRouteMatch{
+		Path: &gwv1.HTTPPathMatch{Type: new(gwv1.PathMatchExact), Value: new("/exact")},
+	})
+
+	api := testRoute("default", "api")
+	apiV2 := testRule(api, 0, gwv1.HTTPRouteMatch{
+		Path:    &gwv1.HTTPPathMatch{Type: new(gwv1.PathMatchPathPrefix), Value: new("/api")},
+		Headers: []gwv1.HTTPHeaderMatch{{Name: "x-version", Value: "v2"}},
+	})
+	apiDelegate := testRule(api, 1, gwv1.HTTPRouteMatch{
+		Path: &gwv1.HTTPPathMatch{Type: new(gwv1.PathMatchPathPrefix), Value: new("/api")},
+	})
+	apiDelegate.Delegates = true
+	apiDelegate.AttachedPolicies = testPolicies("default", "parent-ratelimit")
+
+	child := testRoute("infra", "child")
+	child.AttachedPolicies = testPolicies("infra", "child-timeouts")
+	users := testRule(child, 0, gwv1.HTTPRouteMatch{
+		Path: &gwv1.HTTPPathMatch{Type: new(gwv1.PathMatchPathPrefix), Value: new("/api/users")},
+	})
+	users.DelegatingParent = &apiDelegate
+	users.Backends = []ir.HttpBackend{{
+		Backend: ir.BackendRefIR{
+			ClusterName: "kube_infra_users_8080",
+			Weight:      1,
+			BackendObject: &ir.BackendObjectIR{
+				ObjectSource: ir.ObjectSource{Kind: "Service", Namespace: "infra", Name: "users"},
+			},
+		},
+	}}
+
+	catchAll := testRule(testRoute("default", "catchall"), 0, gwv1.HTTPRouteMatch{
+		Path: &gwv1.HTTPPathMatch{Type: new(gwv1.PathMatchPathPrefix), Value: new("/")},
+	})
+
+	wildcard := testRule(testRoute("default", "wildcard"), 0, gwv1.HTTPRouteMatch{
+		Path:   &gwv1.HTTPPathMatch{Type: new(gwv1.PathMatchPathPrefix), Value: new("/")},
+		Method: new(gwv1.HTTPMethodGet),
+	})
+
+	vhosts := []*ir.VirtualHost{
+		{
+			Name:     "listener~80~example_com",
+			Hostname: "example.com",
+			// the second api rule is the delegating parent of the users rule
+			Rules: []ir.HttpRouteRuleMatchIR{exact, apiV2, apiDelegate, users, catchAll},
+		},
+		{
+			Name:     "listener~80~*_example_com",
+			Hostname: "*.example.com",
+			Rules:    []ir.HttpRouteRuleMatchIR{wildcard},
+		},
+	}
+
+	routeMergeOrigins := &envoycorev3.Metadata{
+		FilterMetadata: map[string]*structpb.Struct{
+			"merge.TrafficPolicy.gateway.kgateway.dev": {
+				Fields: map[string]*structpb.Value{
+					"timeouts":  structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("gateway.kgateway.dev/TrafficPolicy/infra/child-timeouts")}}),
+					"rateLimit": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("gateway.kgateway.dev/TrafficPolicy/default/parent-ratelimit")}}),
+				},
+			},
+		},
+	}
+	clusterAction := func(cluster string) *envoyroutev3.Route_Route {
+		return &envoyroutev3.Route_Route{Route: &envoyroutev3.RouteAction{
+			ClusterSpecifier: &envoyroutev3.RouteAction_Cluster{Cluster: cluster},
+		}}
+	}
+
+	return &proxy_syncer.GatewayTranslation{
+		Gateway: &ir.GatewayIR{
+			Listeners: []ir.ListenerIR{{
+				Name:     "listener~80",
+				BindPort: 80,
+				HttpFilterChain: []ir.HttpFilterChainIR{{
+					FilterChainCommon: ir.FilterChainCommon{FilterChainName: "listener~80"},
+					Vhosts:            vhosts,
+				}},
+			}},
+			AttachedHttpPolicies: testPolicies("default", "gw-cors"),
+		},
+		Routes: map[string]*envoyroutev3.RouteConfiguration{
+			"listener~80": {
+				Name: "listener~80",
+				VirtualHosts: []*envoyroutev3.VirtualHost{
+					{
+						Name:    "listener~80~example_com",
+						Domains: []string{"example.com"},
+						Routes: []*envoyroutev3.Route{
+							{Name: "listener~80~example_com-route-0-httproute-exact-default-0-0-matcher-0", Action: clusterAction("exact")},
+							{Name: "listener~80~example_com-route-1-httproute-api-default-0-0-matcher-0", Action: clusterAction("api")},
+							{Name: "listener~80~example_com-route-3-httproute-child-infra-0-0-matcher-0", Action: clusterAction("kube_infra_users_8080"), Metadata: routeMergeOrigins},
+							{Name: "listener~80~example_com-route-4-httproute-catchall-default-0-0-matcher-0", Action: clusterAction("catchall")},
+						},
+					},
+					{
+						Name:    "listener~80~*_example_com",
+						Domains: []string{"*.example.com"},
+						Routes: []*envoyroutev3.Route{{
+							Name: "listener~80~*_example_com-route-0-httproute-wildcard-default-0-0-matcher-0",
+							Action: &envoyroutev3.Route_Route{Route: &envoyroutev3.RouteAction{
+								ClusterSpecifier: &envoyroutev3.RouteAction_WeightedClusters{WeightedClusters: &envoyroutev3.WeightedCluster{
+									Clusters: []*envoyroutev3.WeightedCluster_ClusterWeight{
+										{Name: "a", Weight: wrapperspb.UInt32(1)},
+										{Name: "b", Weight: wrapperspb.UInt32(3)},
+									},
+								}},
+							}},
+						}},
+					},
+				},
+			},
+		},
+	}
+}
@@ -13,14 +13,18 @@ import (
 	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	"istio.io/istio/pkg/kube/krt"
 
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/controller"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/proxy_syncer"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
 	"github.com/kgateway-dev/kgateway/v2/pkg/version"
 )
 
 func RunAdminServer(ctx context.Context, setupOpts *controller.SetupOpts) error {
 	// serverHandlers defines the custom handlers that the Admin Server will support
-	serverHandlers := getServerHandlers(ctx, setupOpts.KrtDebugger, setupOpts.Cache)
+	serverHandlers := getServerHandlers(ctx, setupOpts.KrtDebugger, setupOpts.Cache, setupOpts.SnapshotTracker, setupOpts.GatewayTranslations,
+		setupOpts.GlobalSettings, setupOpts.XdsValidator)
 
 	startHandlers(ctx, serverHandlers)
 
@@ -33,12 +37,28 @@ type dynamicProfileDescription func() string
 
 // getServerHandlers returns the custom handlers for the Admin Server, which will be bound to the http.ServeMux
 // These endpoints serve as the basis for an Admin Interface for the Control Plane (https://github.com/kgateway-dev/kgateway/issues/6494)
-func getServerHandlers(_ context.Context, dbg *krt.DebugHandler, cache envoycache.SnapshotCache) func(mux *http.ServeMux, profiles map[string]dynamicProfileDescription) {
+func getServerHandlers(
+	_ context.Context,
+	dbg *krt.DebugHandler,
+	cache envoycache.SnapshotCache,
+	snapshotTracker *proxy_syncer.SnapshotTracker,
+	translations *proxy_syncer.GatewayTranslations,
+	globalSettings *apisettings.Settings,
+	xdsValidator validator.Validator,
+) func(mux *http.ServeMux, profiles map[string]dynamicProfileDescription) {
 	return func(m *http.ServeMux, profiles map[string]dynamicProfileDescription) {
 		addXdsSnapshotHandler("/snapshots/xds", m, profiles, cache)
 
+		addPinnedXdsResourcesHandler("/snapshots/xds/pinned", m, profiles, snapshotTracker)
+
+		addRouteExplainHandler("/routes/explain", m, profiles, translations)
+
+		addPolicyInspectorHandler("/policies", m, profiles, translations)
+
 		addKrtSnapshotHandler("/snapshots/krt", m, profiles, dbg)
 
+		addSettingsHandler("/settings", m, profiles, globalSettings, xdsValidator)
+
 		addLoggingHandler("/logging", m, profiles)
 
 		addPprofHandler("/debug/pprof/", m, profiles)
@@ -151,3 +171,24 @@ func addVersionHandler(path string, mux *http.ServeMux, profiles map[string]dyna
 	})
 	profiles[path] = func() string { return "Controller version and commit information" }
 }
+
+// addSettingsHandler registers a /settings endpoint that exposes the global settings of the controller
+// and the validator used for the xDS configuration
+func addSettingsHandler(
+	path string,
+	mux *http.ServeMux,
+	profiles map[string]dynamicProfileDescription,
+	globalSettings *apisettings.Settings,
+	xdsValidator validator.Validator,
+) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		payload := map[string]any{
+			"settings": globalSettings,
+		}
+		if xdsValidator != nil {
+			payload["xdsValidator"] = fmt.Sprint(xdsValidator)
+		}
+		writeJSON(w, payload, r)
+	})
+	profiles[path] = func() string { return "Controller settings and the xDS validator in use" }
+}
@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/proxy_syncer"
+)
+
+// The pinned xDS resources are the resources rejected (NACKed) by the proxies, which the Control Plane
+// keeps serving at their last accepted version. Resources are only pinned when KGW_XDS_NACK_ROLLBACK is enabled.
+func addPinnedXdsResourcesHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, tracker *proxy_syncer.SnapshotTracker) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if tracker == nil {
+			writeJSON(w, map[string]string{"error": "Envoy xDS snapshot tracker not available (Envoy controller may be disabled)"}, r)
+			return
+		}
+		writeJSON(w, tracker.PinnedResources(), r)
+	})
+	profiles[path] = func() string { return "xDS resources pinned to their last ACKed version (Envoy only)" }
+}
@@ -16,7 +16,10 @@ import (
 	"istio.io/istio/pkg/kube/kclient"
 	"istio.io/istio/pkg/kube/krt"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -64,6 +67,8 @@ type gatewayReconciler struct {
 	deploymentClient kclient.Client[*appsv1.Deployment]
 	svcAccountClient kclient.Client[*corev1.ServiceAccount]
 	configMapClient  kclient.Client[*corev1.ConfigMap]
+	hpaClient        kclient.Client[*autoscalingv2.HorizontalPodAutoscaler]
+	pdbClient        kclient.Client[*policyv1.PodDisruptionBudget]
 
 	controllerExtension pluginsdk.GatewayControllerExtension
 
@@ -92,6 +97,8 @@ func NewGatewayReconciler(
 		deploymentClient: kclient.NewFiltered[*appsv1.Deployment](cfg.Client, filter),
 		svcAccountClient: kclient.NewFiltered[*corev1.ServiceAccount](cfg.Client, filter),
 		configMapClient:  kclient.NewFiltered[*corev1.ConfigMap](cfg.Client, filter),
+		hpaClient:        kclient.NewFiltered[*autoscalingv2.HorizontalPodAutoscaler](cfg.Client, filter),
+		pdbClient:        kclient.NewFiltered[*policyv1.PodDisruptionBudget](cfg.Client, filter),
 	}
 
 	// Reuse the parameter client from the deployer to avoid duplicate watches
@@ -212,6 +219,8 @@ func NewGatewayReconciler(
 	r.svcAccountClient.AddEventHandler(parentHandler)
 	r.svcClient.AddEventHandler(parentHandler)
 	r.configMapClient.AddEventHandler(parentHandler)
+	r.hpaClient.AddEventHandler(parentHandler)
+	r.pdbClient.AddEventHandler(parentHandler)
 
 	// Register controller extensions
 	if controllerExtension != nil {
@@ -240,6 +249,8 @@ func (r *gatewayReconciler) Start(ctx context.Context) error {
 		r.svcAccountClient.HasSynced,
 		r.svcClient.HasSynced,
 		r.configMapClient.HasSynced,
+		r.hpaClient.HasSynced,
+		r.pdbClient.HasSynced,
 	}
 	// Add GatewayParameters cache sync handlers
 	hasSynced = append(hasSynced, r.gwParams.GetCacheSyncHandlers()...)
@@ -260,6 +271,8 @@ func (r *gatewayReconciler) Start(ctx context.Context) error {
 		r.svcAccountClient,
 		r.svcClient,
 		r.configMapClient,
+		r.hpaClient,
+		r.pdbClient,
 	}
 	if r.gwParamClient != nil {
 		clients = append(clients, r.gwParamClient)
@@ -344,6 +357,14 @@ func (r *gatewayReconciler) Reconcile(req types.NamespacedName) (rErr error) {
 	if err != nil {
 		return err
 	}
+	// the HorizontalPodAutoscaler and PodDisruptionBudget are optional, so delete them once
+	// they are no longer rendered
+	if err := deleteUnrendered(r.hpaClient, gw, objs); err != nil {
+		return err
+	}
+	if err := deleteUnrendered(r.pdbClient, gw, objs); err != nil {
+		return err
+	}
 
 	// find the name/ns of the service we own so we can grab addresses
 	// from it for status
@@ -363,6 +384,29 @@ func (r *gatewayReconciler) Reconcile(req types.NamespacedName) (rErr error) {
 	return nil
 }
 
+// deleteUnrendered deletes the objects of the client controlled by the Gateway that are not part of the rendered objects.
+func deleteUnrendered[T controllers.ComparableObject](cli kclient.Client[T], gw *gwv1.Gateway, objs []client.Object) error {
+	for _, existing := range cli.List(gw.Namespace, labels.Everything()) {
+		controller := metav1.GetControllerOf(existing)
+		if controller == nil || controller.UID != gw.UID {
+			continue
+		}
+		rendered := slices.ContainsFunc(objs, func(obj client.Object) bool {
+			_, ok := obj.(T)
+			return ok && obj.GetName() == existing.GetName()
+		})
+		if rendered {
+			continue
+		}
+		logger.Debug("deleting object no longer rendered for Gateway",
+			"kind", fmt.Sprintf("%T", existing), "ref", kubeutils.NamespacedNameFrom(existing), "gateway", kubeutils.NamespacedNameFrom(gw))
+		if err := cli.Delete(existing.GetName(), existing.GetNamespace()); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s: %w", kubeutils.NamespacedNameFrom(existing), err)
+		}
+	}
+	return nil
+}
+
 func (r *gatewayReconciler) updateStatus(ctx context.Context, gw *gwv1.Gateway, svcMeta *metav1.ObjectMeta) error {
 	var svc *corev1.Service
 	if svcMeta != nil {
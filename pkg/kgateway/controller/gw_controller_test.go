@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/kclient"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/apiclient/fake"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+)
+
+func TestDeleteUnrendered(t *testing.T) {
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default", UID: types.UID("gw-uid")},
+	}
+	hpa := func(name string, owner types.UID) *autoscalingv2.HorizontalPodAutoscaler {
+		return &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: wellknown.GatewayGVK.GroupVersion().String(),
+					Kind:       wellknown.GatewayGVK.Kind,
+					Name:       "gw",
+					UID:        owner,
+					Controller: new(true),
+				}},
+			},
+		}
+	}
+
+	cli := fake.NewClient(t,
+		hpa("rendered", gw.UID),
+		hpa("stale", gw.UID),
+		hpa("other-gateway", types.UID("other-uid")),
+	)
+	hpaClient := kclient.New[*autoscalingv2.HorizontalPodAutoscaler](cli)
+	cli.RunAndWait(t.Context().Done())
+
+	err := deleteUnrendered(hpaClient, gw, []client.Object{hpa("rendered", gw.UID)})
+	require.NoError(t, err)
+
+	hpas, err := cli.Kube().AutoscalingV2().HorizontalPodAutoscalers("default").List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	var remaining []string
+	for _, obj := range hpas.Items {
+		remaining = append(remaining, obj.Name)
+	}
+	assert.ElementsMatch(t, []string{"rendered", "other-gateway"}, remaining)
+}
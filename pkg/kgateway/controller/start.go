@@ -51,6 +51,22 @@ type SetupOpts struct {
 	// Used by the Gateway controller to trigger reconciliation on cert changes
 	CertWatcher *certwatcher.CertWatcher
 
+	// XdsRejections tracks the xDS responses rejected by the proxies
+	// Used by the StatusSyncer to report the rejections on the status of the Gateways
+	XdsRejections *xds.RejectionTracker
+
+	// SnapshotTracker tracks the xDS resources last ACKed by the proxies
+	// Used by the proxy syncer to pin the resources NACKed by the proxies to their last ACKed version
+	SnapshotTracker *proxy_syncer.SnapshotTracker
+
+	// GatewayTranslations gives access to the latest translation of the Gateways
+	// Used by the admin server to explain how requests are routed
+	GatewayTranslations *proxy_syncer.GatewayTranslations
+
+	// XdsValidator validates the xDS configuration in STRICT validation mode
+	// Used by the admin server to report the validator in use
+	XdsValidator validator.Validator
+
 	PprofBindAddress       string
 	HealthProbeBindAddress string
 	MetricsBindAddress     string
@@ -157,6 +173,8 @@ func NewControllerBuilder(ctx context.Context, cfg StartConfig) (*ControllerBuil
 			mergedPlugins,
 			cfg.CommonCollections,
 			cfg.SetupOpts.Cache,
+			cfg.SetupOpts.SnapshotTracker,
+			cfg.SetupOpts.GatewayTranslations,
 			cfg.Validator,
 		)
 		proxySyncer.Init(ctx, cfg.KrtOptions)
@@ -174,7 +192,9 @@ func NewControllerBuilder(ctx context.Context, cfg StartConfig) (*ControllerBuil
 			proxySyncer.ReportQueue(),
 			proxySyncer.BackendPolicyReportQueue(),
 			proxySyncer.CacheSyncs(),
-			cfg.StatusSyncerOptions...,
+			append([]proxy_syncer.StatusSyncerOption{
+				proxy_syncer.WithXdsRejections(cfg.SetupOpts.XdsRejections, cfg.SetupOpts.GatewayTranslations),
+			}, cfg.StatusSyncerOptions...)...,
 		)
 		if err := cfg.Manager.Add(statusSyncer); err != nil {
 			setupLog.Error(err, "unable to add statusSyncer runnable")
@@ -252,6 +272,7 @@ func (c *ControllerBuilder) Build(ctx context.Context) error {
 			XdsPort:      xdsPort,
 			XdsTLS:       globalSettings.XdsTLS,
 			XdsTlsCaPath: xds.TLSRootCAPath,
+			XdsDelta:     globalSettings.XdsDelta,
 		},
 		IstioAutoMtlsEnabled: istioAutoMtlsEnabled,
 		ImageInfo: &deployer.ImageInfo{
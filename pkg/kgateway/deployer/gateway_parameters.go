@@ -352,6 +352,7 @@ func (k *kgatewayParameters) getValues(gw *gwv1.Gateway, gwParam *kgateway.Gatew
 				Enabled: new(k.inputs.ControlPlane.XdsTLS),
 				CaCert:  new(k.inputs.ControlPlane.XdsTlsCaPath),
 			},
+			Delta: new(k.inputs.ControlPlane.XdsDelta),
 		},
 	}
 	if i := gw.Spec.Infrastructure; i != nil {
@@ -387,6 +388,7 @@ func (k *kgatewayParameters) getValues(gw *gwv1.Gateway, gwParam *kgateway.Gatew
 
 	kubeProxyConfig := gwParam.Spec.Kube
 	deployConfig := kubeProxyConfig.GetDeployment()
+	autoscalingConfig := kubeProxyConfig.GetAutoscaling()
 	podConfig := kubeProxyConfig.GetPodTemplate()
 	envoyContainerConfig := kubeProxyConfig.GetEnvoyContainer()
 	svcConfig := kubeProxyConfig.GetService()
@@ -400,11 +402,18 @@ func (k *kgatewayParameters) getValues(gw *gwv1.Gateway, gwParam *kgateway.Gatew
 	gateway := vals.Gateway
 
 	// deployment values
-	if deployConfig.GetReplicas() != nil {
+	// The replicas are owned by the HPA when autoscaling is enabled, so they are not rendered
+	// to avoid the Deployment being scaled back on every reconciliation. The deployer hands off the
+	// replicas applied before autoscaling was enabled to the HPA, see Deployer.DeployObjsWithSource.
+	if deployConfig.GetReplicas() != nil && autoscalingConfig == nil {
 		gateway.ReplicaCount = new(uint32(*deployConfig.GetReplicas())) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
 	}
 	gateway.Strategy = deployConfig.GetStrategy()
 
+	// horizontalpodautoscaler and poddisruptionbudget values
+	gateway.Autoscaling = deployer.GetAutoscalingValues(autoscalingConfig)
+	gateway.PodDisruptionBudget = deployer.GetPodDisruptionBudgetValues(kubeProxyConfig.GetDisruptionBudget())
+
 	// service values
 	gateway.Service = deployer.GetServiceValues(svcConfig)
 	// Extract loadBalancerIP from Gateway.spec.addresses and set it on the service if service type is LoadBalancer
@@ -59,6 +59,29 @@ type LoadBalancingInfo struct {
 type PriorityInfo struct {
 	FailoverPriority *Prioritizer
 	Failover         []*v1alpha3.LocalityLoadBalancerSetting_Failover
+	// MinEndpoints is the minimum number of endpoints for FailoverPriority to be applied.
+	// Backends with fewer endpoints have all their endpoints at the same priority.
+	MinEndpoints int
+	// OverprovisioningFactor is set on the ClusterLoadAssignment when not zero, to control
+	// when traffic fails over to the next priority.
+	OverprovisioningFactor uint32
+	// LocalityWeights replaces the weights of the localities, and the locality failover.
+	// Localities that match no weight are left without weight, and so receive no traffic
+	// with locality weighted load balancing.
+	LocalityWeights []LocalityWeight
+}
+
+// LocalityWeight is the load balancing weight of the localities that match Locality.
+// Empty Zone and Subzone match all zones and subzones.
+type LocalityWeight struct {
+	Locality ir.PodLocality
+	Weight   uint32
+}
+
+func (w LocalityWeight) matches(l *envoycorev3.Locality) bool {
+	return w.Locality.Region == l.GetRegion() &&
+		(w.Locality.Zone == "" || w.Locality.Zone == l.GetZone()) &&
+		(w.Locality.Subzone == "" || w.Locality.Subzone == l.GetSubZone())
 }
 
 type Prioritizer struct {
@@ -115,6 +138,15 @@ func prioritizeWithLbInfo(logger *slog.Logger, ep ir.EndpointsForBackend, lbInfo
 	cla := &envoyendpointv3.ClusterLoadAssignment{
 		ClusterName: ep.ClusterName,
 	}
+	if lbInfo.PriorityInfo != nil && lbInfo.PriorityInfo.MinEndpoints > 0 &&
+		lbInfo.PriorityInfo.FailoverPriority != nil && countEndpoints(ep) < lbInfo.PriorityInfo.MinEndpoints {
+		// too few endpoints to prioritize, keep them all at the same priority.
+		// a Prioritizer without labels is used, as no Prioritizer means locality failover.
+		priorityInfo := *lbInfo.PriorityInfo
+		priorityInfo.FailoverPriority = &Prioritizer{}
+		lbInfo.PriorityInfo = &priorityInfo
+	}
+
 	totalEndpoints := 0
 	for loc, eps := range ep.LbEps {
 		var l *envoycorev3.Locality
@@ -137,7 +169,9 @@ func prioritizeWithLbInfo(logger *slog.Logger, ep ir.EndpointsForBackend, lbInfo
 		cla.Endpoints = append(cla.GetEndpoints(), endpoints...)
 	}
 
-	if lbInfo.PriorityInfo != nil && lbInfo.PriorityInfo.FailoverPriority == nil {
+	if lbInfo.PriorityInfo != nil && len(lbInfo.PriorityInfo.LocalityWeights) > 0 {
+		applyLocalityWeights(cla, lbInfo.PriorityInfo.LocalityWeights)
+	} else if lbInfo.PriorityInfo != nil && lbInfo.PriorityInfo.FailoverPriority == nil {
 		// if no priorities, fallback to failover
 		proxyLocality := envoycorev3.Locality{
 			Region:  lbInfo.PodLocality.Region,
@@ -146,6 +180,11 @@ func prioritizeWithLbInfo(logger *slog.Logger, ep ir.EndpointsForBackend, lbInfo
 		}
 		applyLocalityFailover(&proxyLocality, cla, lbInfo.PriorityInfo.Failover)
 	}
+	if lbInfo.PriorityInfo != nil && lbInfo.PriorityInfo.OverprovisioningFactor > 0 {
+		cla.Policy = &envoyendpointv3.ClusterLoadAssignment_Policy{
+			OverprovisioningFactor: wrapperspb.UInt32(lbInfo.PriorityInfo.OverprovisioningFactor),
+		}
+	}
 	if logger != nil {
 		logger.Debug("created cla", "cluster", cla.GetClusterName(), "total_endpoints", totalEndpoints)
 	}
@@ -156,6 +195,27 @@ func prioritizeWithLbInfo(logger *slog.Logger, ep ir.EndpointsForBackend, lbInfo
 	return cla
 }
 
+func countEndpoints(ep ir.EndpointsForBackend) int {
+	var count int
+	for _, eps := range ep.LbEps {
+		count += len(filterInvalidEps(eps))
+	}
+	return count
+}
+
+// applyLocalityWeights sets the weight of each LocalityLbEndpoints to the first matching weight.
+func applyLocalityWeights(loadAssignment *envoyendpointv3.ClusterLoadAssignment, weights []LocalityWeight) {
+	for _, localityEndpoints := range loadAssignment.GetEndpoints() {
+		localityEndpoints.LoadBalancingWeight = nil
+		for _, w := range weights {
+			if w.matches(localityEndpoints.GetLocality()) {
+				localityEndpoints.LoadBalancingWeight = wrapperspb.UInt32(w.Weight)
+				break
+			}
+		}
+	}
+}
+
 // ensure we don't send invalid endpoints to envoy and cause NACKs
 func filterInvalidEps(eps []ir.EndpointWithMd) []ir.EndpointWithMd {
 	return slices.Filter(eps, func(ewm ir.EndpointWithMd) bool {
@@ -2,6 +2,8 @@ package backendconfigpolicy
 
 import (
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
@@ -12,6 +14,34 @@ func translateCircuitBreakers(cb *kgateway.CircuitBreakers) *envoyclusterv3.Circ
 		return nil
 	}
 
+	threshold := translateThresholds(kgateway.CircuitBreakerThresholds{
+		MaxConnections:     cb.MaxConnections,
+		MaxPendingRequests: cb.MaxPendingRequests,
+		MaxRequests:        cb.MaxRequests,
+		MaxRetries:         cb.MaxRetries,
+		RetryBudget:        cb.RetryBudget,
+		MaxConnectionPools: cb.MaxConnectionPools,
+	})
+	out := &envoyclusterv3.CircuitBreakers{
+		Thresholds: []*envoyclusterv3.CircuitBreakers_Thresholds{threshold},
+	}
+
+	if cb.HighPriority != nil {
+		highThreshold := translateThresholds(*cb.HighPriority)
+		highThreshold.Priority = envoycorev3.RoutingPriority_HIGH
+		out.Thresholds = append(out.Thresholds, highThreshold)
+	}
+
+	if cb.TrackRemaining != nil && *cb.TrackRemaining {
+		for _, t := range out.GetThresholds() {
+			t.TrackRemaining = true
+		}
+	}
+
+	return out
+}
+
+func translateThresholds(cb kgateway.CircuitBreakerThresholds) *envoyclusterv3.CircuitBreakers_Thresholds {
 	threshold := &envoyclusterv3.CircuitBreakers_Thresholds{}
 
 	if cb.MaxConnections != nil {
@@ -26,8 +56,23 @@ func translateCircuitBreakers(cb *kgateway.CircuitBreakers) *envoyclusterv3.Circ
 	if cb.MaxRetries != nil {
 		threshold.MaxRetries = wrapperspb.UInt32(uint32(*cb.MaxRetries)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
 	}
+	if cb.MaxConnectionPools != nil {
+		threshold.MaxConnectionPools = wrapperspb.UInt32(uint32(*cb.MaxConnectionPools)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	if cb.RetryBudget != nil {
+		threshold.RetryBudget = translateRetryBudget(cb.RetryBudget)
+	}
 
-	return &envoyclusterv3.CircuitBreakers{
-		Thresholds: []*envoyclusterv3.CircuitBreakers_Thresholds{threshold},
+	return threshold
+}
+
+func translateRetryBudget(budget *kgateway.RetryBudget) *envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget {
+	out := &envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget{}
+	if budget.BudgetPercent != nil {
+		out.BudgetPercent = &typev3.Percent{Value: float64(*budget.BudgetPercent)}
+	}
+	if budget.MinRetryConcurrency != nil {
+		out.MinRetryConcurrency = wrapperspb.UInt32(uint32(*budget.MinRetryConcurrency)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
 	}
+	return out
 }
@@ -7,6 +7,7 @@ import (
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	stateful_sessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/stateful_session/v3"
 	envoycommonv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/common/v3"
 	envoyleastrequestv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/least_request/v3"
 	envoymaglevv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/maglev/v3"
@@ -20,6 +21,8 @@ import (
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/policy"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
 )
 
 const (
@@ -33,6 +36,10 @@ type LoadBalancerConfigIR struct {
 	commonLbConfig        *envoyclusterv3.Cluster_CommonLbConfig
 	loadBalancingPolicy   *envoyclusterv3.LoadBalancingPolicy
 	useHostnameForHashing bool
+	// locality is applied on the endpoints of the backend, not on the cluster
+	locality *localityConfigIR
+	// sessionPersistence is applied on the routes to the backend, not on the cluster
+	sessionPersistence *stateful_sessionv3.StatefulSessionPerRoute
 }
 
 func translateLoadBalancerConfig(config *kgateway.LoadBalancer, policyName, policyNamespace string) (*LoadBalancerConfigIR, error) {
@@ -72,6 +79,18 @@ func translateLoadBalancerConfig(config *kgateway.LoadBalancer, policyName, poli
 		return nil, err
 	}
 
+	if config.Locality != nil {
+		out.locality, err = translateLocality(config.Locality)
+		if err != nil {
+			return nil, fmt.Errorf("invalid locality: %w", err)
+		}
+	}
+
+	out.sessionPersistence, err = policy.BuildSessionPersistence(config.SessionPersistence)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session persistence: %w", err)
+	}
+
 	return out, nil
 }
 
@@ -82,7 +101,7 @@ func buildLeastRequestPolicy(config *kgateway.LoadBalancer, policyName, policyNa
 		},
 		SlowStartConfig: toSlowStartConfig(config.LeastRequest.SlowStart, policyName, policyNamespace),
 	}
-	if config.LocalityType != nil {
+	if localityWeightedLb(config) {
 		leastRequest.LocalityLbConfig = &envoycommonv3.LocalityLbConfig{
 			LocalityConfigSpecifier: &envoycommonv3.LocalityLbConfig_LocalityWeightedLbConfig_{
 				LocalityWeightedLbConfig: &envoycommonv3.LocalityLbConfig_LocalityWeightedLbConfig{},
@@ -107,7 +126,7 @@ func buildRoundRobinPolicy(config *kgateway.LoadBalancer, policyName, policyName
 	roundRobin := &envoyroundrobinv3.RoundRobin{
 		SlowStartConfig: toSlowStartConfig(config.RoundRobin.SlowStart, policyName, policyNamespace),
 	}
-	if config.LocalityType != nil {
+	if localityWeightedLb(config) {
 		roundRobin.LocalityLbConfig = &envoycommonv3.LocalityLbConfig{
 			LocalityConfigSpecifier: &envoycommonv3.LocalityLbConfig_LocalityWeightedLbConfig_{
 				LocalityWeightedLbConfig: &envoycommonv3.LocalityLbConfig_LocalityWeightedLbConfig{},
@@ -152,7 +171,7 @@ func buildRingHashPolicy(config *kgateway.LoadBalancer) (*envoyclusterv3.LoadBal
 		ringHash.ConsistentHashingLbConfig = hashingLBConfig
 	}
 
-	if config.LocalityType != nil {
+	if localityWeightedLb(config) {
 		ringHash.LocalityWeightedLbConfig = &envoycommonv3.LocalityLbConfig_LocalityWeightedLbConfig{}
 	}
 	ringHashAny, err := utils.MessageToAny(ringHash)
@@ -182,7 +201,7 @@ func buildMaglevPolicy(config *kgateway.LoadBalancer) (*envoyclusterv3.LoadBalan
 		hashingLBConfig.HashPolicy = constructHashPolicy(config.Maglev.HashPolicies)
 		maglev.ConsistentHashingLbConfig = hashingLBConfig
 	}
-	if config.LocalityType != nil {
+	if localityWeightedLb(config) {
 		maglev.LocalityWeightedLbConfig = &envoycommonv3.LocalityLbConfig_LocalityWeightedLbConfig{}
 	}
 	maglevAny, err := utils.MessageToAny(maglev)
@@ -201,7 +220,7 @@ func buildMaglevPolicy(config *kgateway.LoadBalancer) (*envoyclusterv3.LoadBalan
 
 func buildRandomPolicy(config *kgateway.LoadBalancer) (*envoyclusterv3.LoadBalancingPolicy, error) {
 	random := &envoyrandomv3.Random{}
-	if config.LocalityType != nil {
+	if localityWeightedLb(config) {
 		random.LocalityLbConfig = &envoycommonv3.LocalityLbConfig{
 			LocalityConfigSpecifier: &envoycommonv3.LocalityLbConfig_LocalityWeightedLbConfig_{
 				LocalityWeightedLbConfig: &envoycommonv3.LocalityLbConfig_LocalityWeightedLbConfig{},
@@ -325,6 +344,14 @@ func (a *LoadBalancerConfigIR) Equals(b *LoadBalancerConfigIR) bool {
 	if !proto.Equal(a.loadBalancingPolicy, b.loadBalancingPolicy) {
 		return false
 	}
+	if !proto.Equal(a.sessionPersistence, b.sessionPersistence) {
+		return false
+	}
+	if !cmputils.CompareWithNils(a.locality, b.locality, func(a, b *localityConfigIR) bool {
+		return a.hash == b.hash
+	}) {
+		return false
+	}
 
 	return true
 }
@@ -15,13 +15,17 @@ import (
 	roundrobinv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/round_robin/v3"
 	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/endpoints"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 )
 
 func TestApplyLoadBalancerConfig(t *testing.T) {
@@ -450,6 +454,39 @@ func TestApplyLoadBalancerConfig(t *testing.T) {
 				}
 			}(),
 		},
+		{
+			name: "Locality weights enable locality weighted load balancing",
+			config: &kgateway.LoadBalancer{
+				RoundRobin: &kgateway.LoadBalancerRoundRobinConfig{},
+				Locality: &kgateway.LocalityLoadBalancing{
+					Weights: []kgateway.LocalityWeight{{
+						Region: "us-east-1",
+						Weight: 1,
+					}},
+				},
+			},
+			expected: func() *envoyclusterv3.Cluster {
+				msg, _ := utils.MessageToAny(&roundrobinv3.RoundRobin{
+					LocalityLbConfig: &envoycommonv3.LocalityLbConfig{
+						LocalityConfigSpecifier: &envoycommonv3.LocalityLbConfig_LocalityWeightedLbConfig_{
+							LocalityWeightedLbConfig: &envoycommonv3.LocalityLbConfig_LocalityWeightedLbConfig{},
+						},
+					},
+				})
+				return &envoyclusterv3.Cluster{
+					Name: "test",
+					LoadBalancingPolicy: &envoyclusterv3.LoadBalancingPolicy{
+						Policies: []*envoyclusterv3.LoadBalancingPolicy_Policy{{
+							TypedExtensionConfig: &envoycorev3.TypedExtensionConfig{
+								Name:        "envoy.load_balancing_policies.round_robin",
+								TypedConfig: msg,
+							},
+						}},
+					},
+					CommonLbConfig: &envoyclusterv3.Cluster_CommonLbConfig{},
+				}
+			}(),
+		},
 	}
 
 	for _, test := range tests {
@@ -471,6 +508,60 @@ func TestApplyLoadBalancerConfig(t *testing.T) {
 	}
 }
 
+func TestTranslateLocality(t *testing.T) {
+	proxyLabels := map[string]string{
+		corev1.LabelTopologyRegion: "us-east-1",
+		corev1.LabelTopologyZone:   "us-east-1a",
+	}
+
+	t.Run("zone aware", func(t *testing.T) {
+		locality, err := translateLocality(&kgateway.LocalityLoadBalancing{
+			ZoneAware: &kgateway.ZoneAwareRouting{
+				MinClusterSize:    new(int32(6)),
+				MinHealthyPercent: new(int32(80)),
+			},
+		})
+		require.NoError(t, err)
+		priorityInfo := locality.priorityInfo
+		assert.Equal(t, 6, priorityInfo.MinEndpoints)
+		assert.Equal(t, uint32(125), priorityInfo.OverprovisioningFactor)
+		assert.Equal(t, 0, priorityInfo.FailoverPriority.GetPriority(proxyLabels, proxyLabels))
+		assert.Equal(t, 1, priorityInfo.FailoverPriority.GetPriority(proxyLabels, map[string]string{
+			corev1.LabelTopologyRegion: "us-east-1",
+			corev1.LabelTopologyZone:   "us-east-1b",
+		}))
+		assert.Equal(t, 2, priorityInfo.FailoverPriority.GetPriority(proxyLabels, map[string]string{
+			corev1.LabelTopologyRegion: "us-west-2",
+			corev1.LabelTopologyZone:   "us-west-2a",
+		}))
+	})
+
+	t.Run("failover priority and weights", func(t *testing.T) {
+		locality, err := translateLocality(&kgateway.LocalityLoadBalancing{
+			FailoverPriority: []string{"topology.kubernetes.io/region=us-west-2"},
+			Weights: []kgateway.LocalityWeight{
+				{Region: "us-west-2", Zone: new("us-west-2a"), Subzone: new("rack1"), Weight: 3},
+				{Region: "us-west-2", Weight: 1},
+			},
+		})
+		require.NoError(t, err)
+		priorityInfo := locality.priorityInfo
+		assert.Equal(t, 1, priorityInfo.FailoverPriority.GetPriority(proxyLabels, proxyLabels))
+		assert.Equal(t, []endpoints.LocalityWeight{
+			{Locality: ir.PodLocality{Region: "us-west-2", Zone: "us-west-2a", Subzone: "rack1"}, Weight: 3},
+			{Locality: ir.PodLocality{Region: "us-west-2"}, Weight: 1},
+		}, priorityInfo.LocalityWeights)
+	})
+
+	t.Run("hash changes with the settings", func(t *testing.T) {
+		a, err := translateLocality(&kgateway.LocalityLoadBalancing{ZoneAware: &kgateway.ZoneAwareRouting{}})
+		require.NoError(t, err)
+		b, err := translateLocality(&kgateway.LocalityLoadBalancing{ZoneAware: &kgateway.ZoneAwareRouting{MinClusterSize: new(int32(3))}})
+		require.NoError(t, err)
+		assert.NotEqual(t, a.hash, b.hash)
+	})
+}
+
 func TestConstructHashPolicy(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -0,0 +1,112 @@
+package backendconfigpolicy
+
+import (
+	"context"
+	"encoding/json"
+
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/endpoints"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+// zoneAwarePriorities are the labels used to prioritize the endpoints in the zone, then the region, of the proxy.
+var zoneAwarePriorities = []string{
+	corev1.LabelTopologyRegion,
+	corev1.LabelTopologyZone,
+}
+
+// localityConfigIR holds the priorities and weights applied to the endpoints of the backend.
+type localityConfigIR struct {
+	priorityInfo *endpoints.PriorityInfo
+	// hash of the locality settings, as the Prioritizer can't be compared
+	hash uint64
+}
+
+func translateLocality(locality *kgateway.LocalityLoadBalancing) (*localityConfigIR, error) {
+	priorityInfo := &endpoints.PriorityInfo{}
+	if zoneAware := locality.ZoneAware; zoneAware != nil {
+		priorityInfo.FailoverPriority = endpoints.NewPriorities(zoneAwarePriorities)
+		if zoneAware.MinClusterSize != nil {
+			priorityInfo.MinEndpoints = int(*zoneAware.MinClusterSize)
+		}
+		if zoneAware.MinHealthyPercent != nil {
+			priorityInfo.OverprovisioningFactor = overprovisioningFactor(*zoneAware.MinHealthyPercent)
+		}
+	}
+	if len(locality.FailoverPriority) > 0 {
+		priorityInfo.FailoverPriority = endpoints.NewPriorities(locality.FailoverPriority)
+	}
+	for _, weight := range locality.Weights {
+		w := endpoints.LocalityWeight{
+			Locality: ir.PodLocality{Region: weight.Region},
+			Weight:   uint32(weight.Weight), //nolint:gosec // G115: kubebuilder validation ensures 1 <= value <= 1000
+		}
+		if weight.Zone != nil {
+			w.Locality.Zone = *weight.Zone
+		}
+		if weight.Subzone != nil {
+			w.Locality.Subzone = *weight.Subzone
+		}
+		priorityInfo.LocalityWeights = append(priorityInfo.LocalityWeights, w)
+	}
+
+	data, err := json.Marshal(locality)
+	if err != nil {
+		return nil, err
+	}
+	return &localityConfigIR{
+		priorityInfo: priorityInfo,
+		hash:         utils.HashString(string(data)),
+	}, nil
+}
+
+// overprovisioningFactor returns the overprovisioning factor, in percent, for which a priority
+// starts failing over when less than minHealthyPercent of its endpoints are healthy.
+func overprovisioningFactor(minHealthyPercent int32) uint32 {
+	return uint32((10000 + minHealthyPercent/2) / minHealthyPercent) //nolint:gosec // G115: kubebuilder validation ensures 1 <= value <= 100
+}
+
+// localityWeightedLb returns whether locality weighted load balancing must be enabled.
+func localityWeightedLb(config *kgateway.LoadBalancer) bool {
+	return config.LocalityType != nil || (config.Locality != nil && len(config.Locality.Weights) > 0)
+}
+
+// processEndpoints sets the PriorityInfo of the endpoints of the backends whose BackendConfigPolicy
+// configures locality load balancing. As with the load balancer of the cluster, the last attached
+// policy wins.
+// The BackendIndex is read from the collections when the endpoints are processed, as it is
+// initialized after the plugins.
+func processEndpoints(commoncol *collections.CommonCollections) sdk.EndpointPlugin {
+	gk := wellknown.BackendConfigPolicyGVK.GroupKind()
+	return func(kctx krt.HandlerContext, _ context.Context, _ ir.UniqlyConnectedClient, out *endpoints.EndpointsInputs) uint64 {
+		var locality *localityConfigIR
+		for _, col := range commoncol.BackendIndex.BackendsWithPolicy() {
+			backend := krt.FetchOne(kctx, col, krt.FilterKey(out.EndpointsForBackend.UpstreamResourceName))
+			if backend == nil {
+				continue
+			}
+			for _, polAtt := range (*backend).AttachedPolicies.Policies[gk] {
+				pol, ok := polAtt.PolicyIr.(*BackendConfigPolicyIR)
+				if !ok || len(polAtt.Errors) > 0 || pol.loadBalancerConfig == nil {
+					continue
+				}
+				if pol.loadBalancerConfig.locality != nil {
+					locality = pol.loadBalancerConfig.locality
+				}
+			}
+			break
+		}
+		if locality == nil {
+			return 0
+		}
+		out.PriorityInfo = locality.priorityInfo
+		return locality.hash
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	stateful_sessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/stateful_session/v3"
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"google.golang.org/protobuf/proto"
@@ -49,12 +50,23 @@ type BackendConfigPolicyIR struct {
 
 var logger = logging.New("plugin/backendconfigpolicy")
 
-var _ ir.PolicyIR = &BackendConfigPolicyIR{}
+var (
+	_ ir.PolicyIR                   = &BackendConfigPolicyIR{}
+	_ ir.SessionPersistencePolicyIR = &BackendConfigPolicyIR{}
+)
 
 func (d *BackendConfigPolicyIR) CreationTime() time.Time {
 	return d.ct
 }
 
+// GetSessionPersistence returns the session persistence configured by the load balancer settings of the policy.
+func (d *BackendConfigPolicyIR) GetSessionPersistence() *stateful_sessionv3.StatefulSessionPerRoute {
+	if d.loadBalancerConfig == nil {
+		return nil
+	}
+	return d.loadBalancerConfig.sessionPersistence
+}
+
 func (d *BackendConfigPolicyIR) Equals(other any) bool {
 	d2, ok := other.(*BackendConfigPolicyIR)
 	if !ok {
@@ -180,6 +192,7 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, v
 				Policies:                        backendConfigPolicyCol,
 				ProcessPolicyStaleStatusMarkers: processMarkers,
 				ProcessBackend:                  processBackend,
+				PerClientProcessEndpoints:       processEndpoints(commoncol),
 				GetPolicyStatus:                 getPolicyStatusFn(cli),
 				PatchPolicyStatus:               patchPolicyStatusFn(cli),
 			},
@@ -9,6 +9,7 @@ import (
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	preserve_case_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/header_formatters/preserve_case/v3"
 	envoy_upstreams_http_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
@@ -280,6 +281,72 @@ func TestBackendConfigPolicyTranslation(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "circuit breakers retry budget",
+			policy: &kgateway.BackendConfigPolicy{
+				Spec: kgateway.BackendConfigPolicySpec{
+					CircuitBreakers: &kgateway.CircuitBreakers{
+						MaxConnections: new(int32(100)),
+						RetryBudget: &kgateway.RetryBudget{
+							BudgetPercent:       new(int32(25)),
+							MinRetryConcurrency: new(int32(5)),
+						},
+						MaxConnectionPools: new(int32(10)),
+					},
+				},
+			},
+			want: &envoyclusterv3.Cluster{
+				CircuitBreakers: &envoyclusterv3.CircuitBreakers{
+					Thresholds: []*envoyclusterv3.CircuitBreakers_Thresholds{
+						{
+							MaxConnections: &wrapperspb.UInt32Value{Value: 100},
+							RetryBudget: &envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget{
+								BudgetPercent:       &typev3.Percent{Value: 25},
+								MinRetryConcurrency: &wrapperspb.UInt32Value{Value: 5},
+							},
+							MaxConnectionPools: &wrapperspb.UInt32Value{Value: 10},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "circuit breakers high priority thresholds",
+			policy: &kgateway.BackendConfigPolicy{
+				Spec: kgateway.BackendConfigPolicySpec{
+					CircuitBreakers: &kgateway.CircuitBreakers{
+						MaxRequests: new(int32(1000)),
+						HighPriority: &kgateway.CircuitBreakerThresholds{
+							MaxRequests: new(int32(200)),
+							RetryBudget: &kgateway.RetryBudget{
+								BudgetPercent: new(int32(10)),
+							},
+						},
+						TrackRemaining: new(true),
+					},
+				},
+			},
+			want: &envoyclusterv3.Cluster{
+				CircuitBreakers: &envoyclusterv3.CircuitBreakers{
+					Thresholds: []*envoyclusterv3.CircuitBreakers_Thresholds{
+						{
+							MaxRequests:    &wrapperspb.UInt32Value{Value: 1000},
+							TrackRemaining: true,
+						},
+						{
+							Priority:    envoycorev3.RoutingPriority_HIGH,
+							MaxRequests: &wrapperspb.UInt32Value{Value: 200},
+							RetryBudget: &envoyclusterv3.CircuitBreakers_Thresholds_RetryBudget{
+								BudgetPercent: &typev3.Percent{Value: 10},
+							},
+							TrackRemaining: true,
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
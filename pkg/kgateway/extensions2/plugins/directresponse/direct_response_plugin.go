@@ -4,26 +4,38 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/proto"
 	"istio.io/istio/pkg/kube/kclient"
 	"istio.io/istio/pkg/kube/krt"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
 	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 )
 
+// maxBodyFromSize is the maximum size of a body sourced from a ConfigMap.
+const maxBodyFromSize = 64 * 1024
+
 type directResponse struct {
 	// +noKrtEquals
-	ct   time.Time
-	spec kgateway.DirectResponseSpec
+	ct         time.Time
+	statusCode uint32
+	// body is the body inlined in the spec or sourced from a ConfigMap
+	body       *string
+	headers    []*envoycorev3.HeaderValueOption
+	formatBody bool
 }
 
 // in case multiple policies attached to the same resource, we sort by policy creation time.
@@ -36,7 +48,65 @@ func (d *directResponse) Equals(in any) bool {
 	if !ok {
 		return false
 	}
-	return d.spec == d2.spec
+	if d.statusCode != d2.statusCode || d.formatBody != d2.formatBody {
+		return false
+	}
+	if !ptr.Equal(d.body, d2.body) {
+		return false
+	}
+	return slices.EqualFunc(d.headers, d2.headers, func(a, b *envoycorev3.HeaderValueOption) bool {
+		return proto.Equal(a, b)
+	})
+}
+
+// buildDirectResponse returns the IR of the DirectResponse, resolving the body from the
+// ConfigMap it references, if any. Fetching the ConfigMap through the krt context ensures
+// that the DirectResponse is updated when the ConfigMap changes.
+func buildDirectResponse(
+	krtctx krt.HandlerContext,
+	configMaps *krtcollections.ConfigMapIndex,
+	in *kgateway.DirectResponse,
+) (*directResponse, error) {
+	out := &directResponse{
+		ct:         in.CreationTimestamp.Time,
+		statusCode: uint32(in.Spec.StatusCode), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		body:       in.Spec.Body,
+		formatBody: ptr.Deref(in.Spec.FormatBody, false),
+	}
+	for _, h := range in.Spec.Headers {
+		out.headers = append(out.headers, &envoycorev3.HeaderValueOption{
+			Header: &envoycorev3.HeaderValue{
+				Key:   string(h.Name),
+				Value: h.Value,
+			},
+			AppendAction: envoycorev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+		})
+	}
+
+	if in.Spec.BodyFrom == nil {
+		return out, nil
+	}
+	ref := in.Spec.BodyFrom.ConfigMapRef
+	cm, err := configMaps.GetConfigMap(krtctx, krtcollections.From{
+		GroupKind: wellknown.DirectResponseGVK.GroupKind(),
+		Namespace: in.Namespace,
+	}, gwv1.ObjectReference{
+		Kind:      "ConfigMap",
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+	})
+	if err != nil {
+		return out, fmt.Errorf("failed to resolve DirectResponse body: %w", err)
+	}
+	body, ok := cm.Data[ref.Key]
+	if !ok {
+		return out, fmt.Errorf("key %q not found in ConfigMap %s/%s", ref.Key, cm.Namespace, cm.Name)
+	}
+	if len(body) > maxBodyFromSize {
+		return out, fmt.Errorf("body of key %q in ConfigMap %s/%s exceeds the maximum size of %d bytes", ref.Key, cm.Namespace, cm.Name, maxBodyFromSize)
+	}
+	out.body = &body
+	return out, nil
 }
 
 type directResponsePluginGwPass struct {
@@ -55,6 +125,11 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 
 	gk := wellknown.DirectResponseGVK.GroupKind()
 	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, i *kgateway.DirectResponse) *ir.PolicyWrapper {
+		dr, err := buildDirectResponse(krtctx, commoncol.ConfigMaps, i)
+		var errs []error
+		if err != nil {
+			errs = append(errs, err)
+		}
 		pol := &ir.PolicyWrapper{
 			ObjectSource: ir.ObjectSource{
 				Group:     gk.Group,
@@ -63,7 +138,8 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 				Name:      i.Name,
 			},
 			Policy:   i,
-			PolicyIR: &directResponse{ct: i.CreationTimestamp.Time, spec: i.Spec},
+			PolicyIR: dr,
+			Errors:   errs,
 			// no target refs for direct response
 		}
 		return pol
@@ -106,15 +182,26 @@ func (p *directResponsePluginGwPass) ApplyForRoute(pCtx *ir.RouteContext, output
 	}
 
 	drAction := &envoyroutev3.DirectResponseAction{
-		Status: uint32(dr.spec.StatusCode), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		Status: dr.statusCode,
 	}
-	if dr.spec.Body != nil {
-		drAction.Body = &envoycorev3.DataSource{
+	if dr.body != nil {
+		body := &envoycorev3.DataSource{
 			Specifier: &envoycorev3.DataSource_InlineString{
-				InlineString: *dr.spec.Body,
+				InlineString: *dr.body,
 			},
 		}
+		if dr.formatBody {
+			drAction.BodyFormat = &envoycorev3.SubstitutionFormatString{
+				Format: &envoycorev3.SubstitutionFormatString_TextFormatSource{
+					TextFormatSource: body,
+				},
+			}
+		} else {
+			drAction.Body = body
+		}
 	}
+	// headers of the route are also added to direct responses
+	outputRoute.ResponseHeadersToAdd = append(outputRoute.GetResponseHeadersToAdd(), dr.headers...)
 	outputRoute.Action = &envoyroutev3.Route_DirectResponse{
 		DirectResponse: drAction,
 	}
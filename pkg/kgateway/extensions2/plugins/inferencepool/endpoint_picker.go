@@ -0,0 +1,246 @@
+package inferencepool
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/annotations"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/sslutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/utils"
+	kgwutils "github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
+)
+
+const (
+	// endpointPickerPrefix is the prefix of the ext_proc filter and cluster names of endpoint pickers
+	endpointPickerPrefix = "endpointpicker"
+
+	endpointPickerConnectTimeout = 5 * time.Second
+)
+
+// endpointPickerIr is the internal representation of the endpoint picker (EPP) referenced by an InferencePool.
+type endpointPickerIr struct {
+	// filterName is the name of the ext_proc filter calling the endpoint picker
+	filterName string
+	// clusterName is the name of the cluster of the endpoint picker service
+	clusterName string
+	hostname    string
+	port        uint32
+	failOpen    bool
+	// tlsMode is how the connection to the endpoint picker is secured, set by the EndpointPickerTLS annotation
+	tlsMode annotations.EndpointPickerTLSMode
+	// caCert is the CA certificate the certificate of the endpoint picker is verified against in Verify mode
+	caCert string
+}
+
+func (e *endpointPickerIr) Equals(other *endpointPickerIr) bool {
+	return cmputils.CompareWithNils(e, other, func(a, b *endpointPickerIr) bool {
+		return *a == *b
+	})
+}
+
+// buildEndpointPickerIr resolves the endpoint picker referenced by the pool. Only Services are supported.
+func buildEndpointPickerIr(
+	krtctx krt.HandlerContext,
+	services krt.Collection[*corev1.Service],
+	configMaps *krtcollections.ConfigMapIndex,
+	pool *inf.InferencePool,
+) (*endpointPickerIr, error) {
+	ref := pool.Spec.EndpointPickerRef
+	if ref.Group != nil && *ref.Group != "" {
+		return nil, fmt.Errorf("unsupported endpoint picker group %q: only core Services are supported", *ref.Group)
+	}
+	if ref.Kind != "" && ref.Kind != "Service" {
+		return nil, fmt.Errorf("unsupported endpoint picker kind %q: only Services are supported", ref.Kind)
+	}
+	if ref.Name == "" {
+		return nil, errors.New("endpoint picker name is required")
+	}
+	if ref.Port == nil {
+		return nil, fmt.Errorf("endpoint picker port is required for Service %s", ref.Name)
+	}
+
+	nn := types.NamespacedName{Namespace: pool.GetNamespace(), Name: string(ref.Name)}
+	svc := krt.FetchOne(krtctx, services, krt.FilterObjectName(nn))
+	if svc == nil {
+		return nil, fmt.Errorf("endpoint picker Service %s not found", nn)
+	}
+	if (*svc).Spec.Type == corev1.ServiceTypeExternalName {
+		return nil, fmt.Errorf("endpoint picker Service %s is of unsupported type ExternalName", nn)
+	}
+
+	tlsMode, caCert, err := resolveEndpointPickerTLS(krtctx, configMaps, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf("%s_%s_%s", endpointPickerPrefix, pool.GetNamespace(), pool.GetName())
+	return &endpointPickerIr{
+		filterName:  endpointPickerPrefix + "/" + pool.GetNamespace() + "/" + pool.GetName(),
+		clusterName: name,
+		hostname:    kubeutils.GetServiceHostname(nn.Name, nn.Namespace),
+		port:        uint32(ref.Port.Number), //nolint:gosec // G115: port number is validated to be in range by the CRD
+		failOpen:    ref.FailureMode == inf.EndpointPickerFailOpen,
+		tlsMode:     tlsMode,
+		caCert:      caCert,
+	}, nil
+}
+
+// resolveEndpointPickerTLS returns the TLS mode set by the EndpointPickerTLS annotation of the pool and, in Verify
+// mode, the CA certificate of the ConfigMap referenced by the EndpointPickerCACertificate annotation.
+func resolveEndpointPickerTLS(
+	krtctx krt.HandlerContext,
+	configMaps *krtcollections.ConfigMapIndex,
+	pool *inf.InferencePool,
+) (annotations.EndpointPickerTLSMode, string, error) {
+	mode := annotations.EndpointPickerTLSMode(pool.GetAnnotations()[annotations.EndpointPickerTLS])
+	switch mode {
+	case "":
+		return annotations.EndpointPickerTLSInsecure, "", nil
+	case annotations.EndpointPickerTLSInsecure, annotations.EndpointPickerTLSDisabled:
+		return mode, "", nil
+	case annotations.EndpointPickerTLSVerify:
+	default:
+		return "", "", fmt.Errorf("invalid %s annotation %q: must be one of %s, %s or %s", annotations.EndpointPickerTLS, mode,
+			annotations.EndpointPickerTLSInsecure, annotations.EndpointPickerTLSDisabled, annotations.EndpointPickerTLSVerify)
+	}
+
+	cmName := pool.GetAnnotations()[annotations.EndpointPickerCACertificate]
+	if cmName == "" {
+		return "", "", fmt.Errorf("the %s annotation is required when %s is %s",
+			annotations.EndpointPickerCACertificate, annotations.EndpointPickerTLS, annotations.EndpointPickerTLSVerify)
+	}
+	cm, err := configMaps.GetConfigMap(krtctx, krtcollections.From{
+		GroupKind: wellknown.InferencePoolGVK.GroupKind(),
+		Namespace: pool.GetNamespace(),
+	}, gwv1.ObjectReference{
+		Kind: "ConfigMap",
+		Name: gwv1.ObjectName(cmName),
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to resolve endpoint picker CA certificate: %w", err)
+	}
+	caCert, err := sslutils.GetCACertFromConfigMap(cm)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid endpoint picker CA certificate in ConfigMap %s/%s: %w", cm.Namespace, cm.Name, err)
+	}
+	return mode, caCert, nil
+}
+
+// extProcFilter builds the ext_proc filter calling the endpoint picker. Request and response bodies are
+// streamed in full duplex mode so that the endpoint picker can inspect the request payload (e.g. the
+// model name) and the response usage.
+func (e *endpointPickerIr) extProcFilter() *envoy_ext_proc_v3.ExternalProcessor {
+	return &envoy_ext_proc_v3.ExternalProcessor{
+		GrpcService: &envoycorev3.GrpcService{
+			TargetSpecifier: &envoycorev3.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &envoycorev3.GrpcService_EnvoyGrpc{
+					ClusterName: e.clusterName,
+					Authority:   fmt.Sprintf("%s:%d", e.hostname, e.port),
+				},
+			},
+		},
+		ProcessingMode: &envoy_ext_proc_v3.ProcessingMode{
+			RequestHeaderMode:   envoy_ext_proc_v3.ProcessingMode_SEND,
+			RequestBodyMode:     envoy_ext_proc_v3.ProcessingMode_FULL_DUPLEX_STREAMED,
+			RequestTrailerMode:  envoy_ext_proc_v3.ProcessingMode_SEND,
+			ResponseHeaderMode:  envoy_ext_proc_v3.ProcessingMode_SEND,
+			ResponseBodyMode:    envoy_ext_proc_v3.ProcessingMode_FULL_DUPLEX_STREAMED,
+			ResponseTrailerMode: envoy_ext_proc_v3.ProcessingMode_SEND,
+		},
+		FailureModeAllow: e.failOpen,
+	}
+}
+
+// cluster builds the cluster of the endpoint picker service. The connection is secured according to the TLS mode
+// of the pool: the endpoint picker serves gRPC over TLS with a self-signed certificate by default, so its certificate
+// is only verified in Verify mode.
+func (e *endpointPickerIr) cluster() *envoyclusterv3.Cluster {
+	out := &envoyclusterv3.Cluster{
+		Name:                 e.clusterName,
+		ConnectTimeout:       durationpb.New(endpointPickerConnectTimeout),
+		ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_STRICT_DNS},
+		LbPolicy:             envoyclusterv3.Cluster_LEAST_REQUEST,
+		LoadAssignment: &envoyendpointv3.ClusterLoadAssignment{
+			ClusterName: e.clusterName,
+			Endpoints: []*envoyendpointv3.LocalityLbEndpoints{{
+				LbEndpoints: []*envoyendpointv3.LbEndpoint{{
+					HostIdentifier: &envoyendpointv3.LbEndpoint_Endpoint{
+						Endpoint: &envoyendpointv3.Endpoint{
+							Address: &envoycorev3.Address{
+								Address: &envoycorev3.Address_SocketAddress{
+									SocketAddress: &envoycorev3.SocketAddress{
+										Address: e.hostname,
+										PortSpecifier: &envoycorev3.SocketAddress_PortValue{
+											PortValue: e.port,
+										},
+									},
+								},
+							},
+						},
+					},
+				}},
+			}},
+		},
+	}
+	if err := utils.SetHttp2options(out); err != nil {
+		logger.Error("failed to set http2 options on endpoint picker cluster", "cluster", e.clusterName, "error", err)
+	}
+	if e.tlsMode == annotations.EndpointPickerTLSDisabled {
+		return out
+	}
+	upstreamTlsContext, err := e.upstreamTlsContext()
+	if err != nil {
+		logger.Error("failed to build tls context for endpoint picker cluster", "cluster", e.clusterName, "error", err)
+		return out
+	}
+	tlsContext, err := kgwutils.MessageToAny(upstreamTlsContext)
+	if err != nil {
+		logger.Error("failed to build tls context for endpoint picker cluster", "cluster", e.clusterName, "error", err)
+		return out
+	}
+	out.TransportSocket = &envoycorev3.TransportSocket{
+		Name: envoywellknown.TransportSocketTls,
+		ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+			TypedConfig: tlsContext,
+		},
+	}
+	return out
+}
+
+// upstreamTlsContext returns the TLS context of the endpoint picker cluster. In Verify mode, the certificate of the
+// endpoint picker must be signed by the configured CA and issued for the hostname of its Service.
+func (e *endpointPickerIr) upstreamTlsContext() (*envoytlsv3.UpstreamTlsContext, error) {
+	if e.tlsMode != annotations.EndpointPickerTLSVerify {
+		return &envoytlsv3.UpstreamTlsContext{
+			Sni: e.hostname,
+		}, nil
+	}
+	return pluginutils.ResolveUpstreamSslConfigFromCA(e.caCert, &envoytlsv3.CertificateValidationContext{
+		MatchTypedSubjectAltNames: []*envoytlsv3.SubjectAltNameMatcher{{
+			SanType: envoytlsv3.SubjectAltNameMatcher_DNS,
+			Matcher: &envoymatcherv3.StringMatcher{
+				MatchPattern: &envoymatcherv3.StringMatcher_Exact{Exact: e.hostname},
+			},
+		}},
+	}, e.hostname)
+}
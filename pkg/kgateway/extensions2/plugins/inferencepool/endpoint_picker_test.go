@@ -0,0 +1,75 @@
+package inferencepool
+
+import (
+	"testing"
+
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/annotations"
+)
+
+func TestResolveEndpointPickerTLS(t *testing.T) {
+	pool := func(annos map[string]string) *inf.InferencePool {
+		return &inf.InferencePool{ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default", Annotations: annos}}
+	}
+
+	mode, _, err := resolveEndpointPickerTLS(nil, nil, pool(nil))
+	require.NoError(t, err)
+	assert.Equal(t, annotations.EndpointPickerTLSInsecure, mode)
+
+	mode, _, err = resolveEndpointPickerTLS(nil, nil, pool(map[string]string{annotations.EndpointPickerTLS: "Disabled"}))
+	require.NoError(t, err)
+	assert.Equal(t, annotations.EndpointPickerTLSDisabled, mode)
+
+	_, _, err = resolveEndpointPickerTLS(nil, nil, pool(map[string]string{annotations.EndpointPickerTLS: "Strict"}))
+	assert.ErrorContains(t, err, `invalid kgateway.dev/endpoint-picker-tls annotation "Strict"`)
+
+	_, _, err = resolveEndpointPickerTLS(nil, nil, pool(map[string]string{annotations.EndpointPickerTLS: "Verify"}))
+	assert.ErrorContains(t, err, "the kgateway.dev/endpoint-picker-ca-configmap annotation is required")
+}
+
+func TestEndpointPickerCluster(t *testing.T) {
+	epp := &endpointPickerIr{
+		clusterName: "endpointpicker_default_pool",
+		hostname:    "epp.default.svc.cluster.local",
+		port:        9002,
+	}
+	upstreamTlsContext := func(t *testing.T) *envoytlsv3.UpstreamTlsContext {
+		t.Helper()
+		ts := epp.cluster().GetTransportSocket()
+		require.NotNil(t, ts)
+		out := &envoytlsv3.UpstreamTlsContext{}
+		require.NoError(t, ts.GetTypedConfig().UnmarshalTo(out))
+		return out
+	}
+
+	assert.Equal(t, int64(5), epp.cluster().GetConnectTimeout().GetSeconds())
+
+	t.Run("insecure", func(t *testing.T) {
+		epp.tlsMode = annotations.EndpointPickerTLSInsecure
+		tlsContext := upstreamTlsContext(t)
+		assert.Equal(t, "epp.default.svc.cluster.local", tlsContext.GetSni())
+		assert.Nil(t, tlsContext.GetCommonTlsContext().GetValidationContext())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		epp.tlsMode = annotations.EndpointPickerTLSDisabled
+		assert.Nil(t, epp.cluster().GetTransportSocket())
+	})
+
+	t.Run("verify", func(t *testing.T) {
+		epp.tlsMode = annotations.EndpointPickerTLSVerify
+		epp.caCert = "ca-cert"
+		tlsContext := upstreamTlsContext(t)
+		assert.Equal(t, "epp.default.svc.cluster.local", tlsContext.GetSni())
+		validation := tlsContext.GetCommonTlsContext().GetValidationContext()
+		require.NotNil(t, validation)
+		assert.Equal(t, "ca-cert", validation.GetTrustedCa().GetInlineString())
+		require.Len(t, validation.GetMatchTypedSubjectAltNames(), 1)
+		assert.Equal(t, "epp.default.svc.cluster.local", validation.GetMatchTypedSubjectAltNames()[0].GetMatcher().GetExact())
+	})
+}
@@ -0,0 +1,97 @@
+package inferencepool
+
+import (
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
+)
+
+// endpointsCollection builds the endpoints of the cluster of every InferencePool from the pods selected by the pool.
+// The locality and augmented labels of the pods are looked up the same way as for the endpoints of Services.
+func endpointsCollection(
+	backends krt.Collection[ir.BackendObjectIR],
+	pods krt.Collection[krtcollections.WrappedPod],
+	localityPods krt.Collection[krtcollections.LocalityPod],
+	krtOpts krtutil.KrtOptions,
+) krt.Collection[ir.EndpointsForBackend] {
+	podsByNamespace := krt.NewNamespaceIndex(pods)
+	return krt.NewCollection(backends, func(krtctx krt.HandlerContext, be ir.BackendObjectIR) *ir.EndpointsForBackend {
+		pool, ok := be.Obj.(*inf.InferencePool)
+		if !ok {
+			return nil
+		}
+		selected := krt.Fetch(krtctx, pods, krt.FilterIndex(podsByNamespace, pool.GetNamespace()), krt.FilterGeneric(func(o any) bool {
+			return selectsPod(pool, o.(krtcollections.WrappedPod))
+		}))
+		localities := make(map[string]krtcollections.LocalityPod, len(selected))
+		for _, pod := range selected {
+			lp := krt.FetchOne(krtctx, localityPods, krt.FilterObjectName(types.NamespacedName{
+				Namespace: pod.Namespace,
+				Name:      pod.Name,
+			}))
+			if lp != nil {
+				localities[pod.Name] = *lp
+			}
+		}
+		return buildEndpoints(be, pool, selected, localities)
+	}, krtOpts.ToOptions("InferencePoolEndpoints")...)
+}
+
+// buildEndpoints returns an endpoint for every target port of every address of every ready pod selected by the pool.
+// These are the only hosts envoy routes to: an address picked by the endpoint picker that is not one of them is
+// ignored. Endpoints are grouped by the locality of their pod, found in localities by pod name, so that locality
+// aware load balancing applies when the endpoint picker falls back to the cluster load balancer.
+func buildEndpoints(
+	be ir.BackendObjectIR,
+	pool *inf.InferencePool,
+	pods []krtcollections.WrappedPod,
+	localities map[string]krtcollections.LocalityPod,
+) *ir.EndpointsForBackend {
+	eps := ir.NewEndpointsForBackend(be)
+	for _, pod := range pods {
+		if !pod.Ready || pod.Terminal || pod.DeletionTimestamp != nil || len(pod.PodIPs) == 0 {
+			continue
+		}
+		var l ir.PodLocality
+		labels := pod.Labels
+		if lp, ok := localities[pod.Name]; ok {
+			l = lp.Locality
+			labels = lp.AugmentedLabels
+		}
+		seen := sets.New[string]()
+		for _, podIP := range pod.PodIPs {
+			if podIP.IP == "" || seen.Has(podIP.IP) {
+				continue
+			}
+			seen.Insert(podIP.IP)
+			for _, port := range pool.Spec.TargetPorts {
+				eps.Add(l, ir.EndpointWithMd{
+					LbEndpoint: krtcollections.CreateLBEndpoint(podIP.IP, uint32(port.Number), labels, false), //nolint:gosec // G115: port number is validated to be in range by the CRD
+					EndpointMd: ir.EndpointMetadata{
+						Labels: labels,
+					},
+				})
+			}
+		}
+	}
+	return eps
+}
+
+// selectsPod returns true if the pod is in the namespace of the pool and matches all the labels of its selector.
+// A pool with an empty selector selects no pods.
+func selectsPod(pool *inf.InferencePool, pod krtcollections.WrappedPod) bool {
+	if pod.Namespace != pool.GetNamespace() || len(pool.Spec.Selector.MatchLabels) == 0 {
+		return false
+	}
+	for k, v := range pool.Spec.Selector.MatchLabels {
+		if pod.Labels[string(k)] != string(v) {
+			return false
+		}
+	}
+	return true
+}
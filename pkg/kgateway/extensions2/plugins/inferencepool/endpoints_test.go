@@ -0,0 +1,94 @@
+package inferencepool
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestBuildEndpoints(t *testing.T) {
+	pool := &inf.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+		Spec: inf.InferencePoolSpec{
+			Selector: inf.LabelSelector{
+				MatchLabels: map[inf.LabelKey]inf.LabelValue{"app": "vllm"},
+			},
+			TargetPorts: []inf.Port{{Number: 8000}, {Number: 8001}},
+		},
+	}
+	gk := wellknown.InferencePoolGVK.GroupKind()
+	be := ir.NewBackendObjectIR(ir.ObjectSource{Group: gk.Group, Kind: gk.Kind, Namespace: "default", Name: "pool"}, 0, "")
+	be.Obj = pool
+
+	dualStack := testPod("dual-stack", "default", "10.0.0.3", true, map[string]string{"app": "vllm"})
+	dualStack.PodIPs = append(dualStack.PodIPs, corev1.PodIP{IP: "fd00::3"}, corev1.PodIP{IP: "10.0.0.3"})
+	pods := []krtcollections.WrappedPod{
+		testPod("ready", "default", "10.0.0.1", true, map[string]string{"app": "vllm"}),
+		testPod("not-ready", "default", "10.0.0.2", false, map[string]string{"app": "vllm"}),
+		testPod("no-ip", "default", "", true, map[string]string{"app": "vllm"}),
+		dualStack,
+	}
+	zoneA := ir.PodLocality{Region: "region", Zone: "zone-a"}
+	localities := map[string]krtcollections.LocalityPod{
+		"dual-stack": {
+			Named:           krt.Named{Name: "dual-stack", Namespace: "default"},
+			Locality:        zoneA,
+			AugmentedLabels: map[string]string{"app": "vllm", corev1.LabelTopologyZone: "zone-a"},
+		},
+	}
+
+	eps := buildEndpoints(be, pool, pods, localities)
+	addresses := func(l ir.PodLocality) []string {
+		var out []string
+		for _, ep := range eps.LbEps[l] {
+			sa := ep.LbEndpoint.GetEndpoint().GetAddress().GetSocketAddress()
+			out = append(out, fmt.Sprintf("%s:%d", sa.GetAddress(), sa.GetPortValue()))
+		}
+		return out
+	}
+	assert.Len(t, eps.LbEps, 2)
+	assert.Equal(t, []string{"10.0.0.1:8000", "10.0.0.1:8001"}, addresses(ir.PodLocality{}))
+	assert.Equal(t, []string{"10.0.0.3:8000", "10.0.0.3:8001", "fd00::3:8000", "fd00::3:8001"}, addresses(zoneA))
+	for _, ep := range eps.LbEps[zoneA] {
+		assert.Equal(t, "zone-a", ep.EndpointMd.Labels[corev1.LabelTopologyZone])
+	}
+}
+
+func TestSelectsPod(t *testing.T) {
+	pool := &inf.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"},
+		Spec: inf.InferencePoolSpec{
+			Selector: inf.LabelSelector{
+				MatchLabels: map[inf.LabelKey]inf.LabelValue{"app": "vllm"},
+			},
+		},
+	}
+
+	assert.True(t, selectsPod(pool, testPod("pod", "default", "10.0.0.1", true, map[string]string{"app": "vllm", "other": "label"})))
+	assert.False(t, selectsPod(pool, testPod("pod", "default", "10.0.0.1", true, map[string]string{"app": "other"})))
+	assert.False(t, selectsPod(pool, testPod("pod", "other", "10.0.0.1", true, map[string]string{"app": "vllm"})))
+
+	pool.Spec.Selector.MatchLabels = nil
+	assert.False(t, selectsPod(pool, testPod("pod", "default", "10.0.0.1", true, map[string]string{"app": "vllm"})))
+}
+
+func testPod(name, namespace, ip string, ready bool, labels map[string]string) krtcollections.WrappedPod {
+	pod := krtcollections.WrappedPod{
+		Named:  krt.Named{Name: name, Namespace: namespace},
+		Labels: labels,
+		Ready:  ready,
+	}
+	if ip != "" {
+		pod.PodIPs = []corev1.PodIP{{IP: ip}}
+	}
+	return pod
+}
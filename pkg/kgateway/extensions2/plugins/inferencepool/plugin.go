@@ -0,0 +1,292 @@
+package inferencepool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	envoyleastrequestv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/least_request/v3"
+	envoyoverridehostv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/load_balancing_policies/override_host/v3"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+
+	kgwutils "github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/filters"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+)
+
+var logger = logging.New("plugin/inferencepool")
+
+const (
+	ExtensionName = "inferencepool"
+
+	// destinationEndpointHeader is the header set by the endpoint picker to the address of the
+	// selected model server pod. It is used by the override host load balancer to route the request.
+	destinationEndpointHeader = "x-gateway-destination-endpoint"
+)
+
+// inferencePoolIr is the internal representation of an InferencePool.
+type inferencePoolIr struct {
+	// endpointPicker is nil when the endpoint picker reference of the pool could not be resolved.
+	endpointPicker *endpointPickerIr
+	// errors are compared so that status is updated when the reason a pool is invalid changes
+	errors []error
+}
+
+func (p *inferencePoolIr) Equals(other any) bool {
+	otherPool, ok := other.(*inferencePoolIr)
+	if !ok {
+		return false
+	}
+	if !p.endpointPicker.Equals(otherPool.endpointPicker) {
+		return false
+	}
+	return slices.EqualFunc(p.errors, otherPool.errors, func(a, b error) bool {
+		return a.Error() == b.Error()
+	})
+}
+
+func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sdk.Plugin {
+	cli := kclient.NewFilteredDelayed[*inf.InferencePool](
+		commoncol.Client,
+		wellknown.InferencePoolGVR,
+		kclient.Filter{ObjectFilter: commoncol.Client.ObjectFilter()},
+	)
+	pools := krt.WrapClient(cli, commoncol.KrtOpts.ToOptions("InferencePools")...)
+
+	gk := wellknown.InferencePoolGVK.GroupKind()
+	bcol := krt.NewCollection(pools, func(krtctx krt.HandlerContext, pool *inf.InferencePool) *ir.BackendObjectIR {
+		poolIr := buildInferencePoolIr(krtctx, commoncol.Services, commoncol.ConfigMaps, pool)
+		if len(poolIr.errors) > 0 {
+			logger.Error("failed to translate inference pool", "inference_pool", pool.GetName(), "error", errors.Join(poolIr.errors...))
+		}
+		objSrc := ir.ObjectSource{
+			Kind:      gk.Kind,
+			Group:     gk.Group,
+			Namespace: pool.GetNamespace(),
+			Name:      pool.GetName(),
+		}
+		// HTTPRoutes reference an InferencePool without a port; the model server port is
+		// carried in the endpoint picked by the endpoint picker.
+		backend := ir.NewBackendObjectIR(objSrc, 0, "")
+		backend.GvPrefix = ExtensionName
+		backend.Obj = pool
+		backend.ObjIr = poolIr
+		backend.Errors = poolIr.errors
+
+		// Parse common annotations
+		ir.ParseObjectAnnotations(&backend, pool)
+
+		return &backend
+	}, commoncol.KrtOpts.ToOptions("InferencePoolBackends")...)
+
+	endpoints := endpointsCollection(bcol, commoncol.WrappedPods, commoncol.LocalityPods, commoncol.KrtOpts)
+	statuses := buildPoolStatuses(commoncol.KrtOpts, bcol, commoncol.HTTPRoutes, commoncol.ControllerName)
+
+	return sdk.Plugin{
+		ContributesBackends: map[schema.GroupKind]sdk.BackendPlugin{
+			gk: {
+				BackendInit: ir.BackendInit{
+					InitEnvoyBackend: processBackendForEnvoy,
+				},
+				Backends:  bcol,
+				Endpoints: endpoints,
+			},
+		},
+		ContributesPolicies: map[schema.GroupKind]sdk.PolicyPlugin{
+			gk: {
+				Name:                      ExtensionName,
+				NewGatewayTranslationPass: newPlug,
+			},
+		},
+		ContributesLeaderAction: map[schema.GroupKind]func(){
+			gk: buildRegisterCallback(cli, statuses),
+		},
+	}
+}
+
+func buildInferencePoolIr(
+	krtctx krt.HandlerContext,
+	services krt.Collection[*corev1.Service],
+	configMaps *krtcollections.ConfigMapIndex,
+	pool *inf.InferencePool,
+) *inferencePoolIr {
+	var poolIr inferencePoolIr
+	if len(pool.Spec.TargetPorts) == 0 {
+		poolIr.errors = append(poolIr.errors, errors.New("inference pool must define at least one target port"))
+	}
+	epp, err := buildEndpointPickerIr(krtctx, services, configMaps, pool)
+	if err != nil {
+		poolIr.errors = append(poolIr.errors, err)
+	}
+	poolIr.endpointPicker = epp
+	return &poolIr
+}
+
+// processBackendForEnvoy turns the InferencePool into an EDS cluster of the pods selected by the pool. The endpoint
+// picker chooses one of them per request and sets its address in the destinationEndpointHeader. The override host
+// load balancing policy only honours that address if it is one of the cluster's hosts, so the header can never
+// route a request outside of the pool.
+func processBackendForEnvoy(ctx context.Context, in ir.BackendObjectIR, out *envoyclusterv3.Cluster) *ir.EndpointsForBackend {
+	out.ClusterDiscoveryType = &envoyclusterv3.Cluster_Type{
+		Type: envoyclusterv3.Cluster_EDS,
+	}
+	out.EdsClusterConfig = &envoyclusterv3.Cluster_EdsClusterConfig{
+		EdsConfig: &envoycorev3.ConfigSource{
+			ResourceApiVersion: envoycorev3.ApiVersion_V3,
+			ConfigSourceSpecifier: &envoycorev3.ConfigSource_Ads{
+				Ads: &envoycorev3.AggregatedConfigSource{},
+			},
+		},
+	}
+	lbPolicy, err := overrideHostLbPolicy()
+	if err != nil {
+		logger.Error("failed to build override host load balancing policy", "cluster", out.GetName(), "error", err)
+		return nil
+	}
+	out.LoadBalancingPolicy = lbPolicy
+	return nil
+}
+
+// overrideHostLbPolicy routes to the host picked by the endpoint picker. Requests without a picked host (e.g. when
+// the endpoint picker fails open) are load balanced across the pool by the least request fallback policy.
+func overrideHostLbPolicy() (*envoyclusterv3.LoadBalancingPolicy, error) {
+	fallback, err := kgwutils.MessageToAny(&envoyleastrequestv3.LeastRequest{})
+	if err != nil {
+		return nil, err
+	}
+	overrideHost, err := kgwutils.MessageToAny(&envoyoverridehostv3.OverrideHost{
+		OverrideHostSources: []*envoyoverridehostv3.OverrideHost_OverrideHostSource{{
+			Header: destinationEndpointHeader,
+		}},
+		FallbackPolicy: &envoyclusterv3.LoadBalancingPolicy{
+			Policies: []*envoyclusterv3.LoadBalancingPolicy_Policy{{
+				TypedExtensionConfig: &envoycorev3.TypedExtensionConfig{
+					Name:        "envoy.load_balancing_policies.least_request",
+					TypedConfig: fallback,
+				},
+			}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &envoyclusterv3.LoadBalancingPolicy{
+		Policies: []*envoyclusterv3.LoadBalancingPolicy_Policy{{
+			TypedExtensionConfig: &envoycorev3.TypedExtensionConfig{
+				Name:        "envoy.load_balancing_policies.override_host",
+				TypedConfig: overrideHost,
+			},
+		}},
+	}, nil
+}
+
+type inferencePoolPlugin struct {
+	ir.UnimplementedProxyTranslationPass
+	// endpointPickers holds the endpoint pickers used by routes, per filter chain
+	endpointPickers map[string]map[string]*endpointPickerIr
+}
+
+var _ ir.ProxyTranslationPass = &inferencePoolPlugin{}
+
+func newPlug(tctx ir.GwTranslationCtx, reporter reporter.Reporter) ir.ProxyTranslationPass {
+	return &inferencePoolPlugin{}
+}
+
+func (p *inferencePoolPlugin) Name() string {
+	return ExtensionName
+}
+
+// ApplyForBackend enables the ext_proc filter of the pool's endpoint picker on routes to the pool.
+func (p *inferencePoolPlugin) ApplyForBackend(pCtx *ir.RouteBackendContext, in ir.HttpBackend, out *envoyroutev3.Route) error {
+	poolIr, ok := pCtx.Backend.ObjIr.(*inferencePoolIr)
+	if !ok {
+		return fmt.Errorf("unexpected inference pool ir type %T", pCtx.Backend.ObjIr)
+	}
+	epp := poolIr.endpointPicker
+	if epp == nil {
+		// the backend has errors and is translated to a blackhole cluster
+		return nil
+	}
+
+	if p.endpointPickers == nil {
+		p.endpointPickers = make(map[string]map[string]*endpointPickerIr)
+	}
+	if p.endpointPickers[pCtx.FilterChainName] == nil {
+		p.endpointPickers[pCtx.FilterChainName] = make(map[string]*endpointPickerIr)
+	}
+	p.endpointPickers[pCtx.FilterChainName][epp.filterName] = epp
+
+	pCtx.TypedFilterConfig.AddTypedConfig(epp.filterName, &envoy_ext_proc_v3.ExtProcPerRoute{
+		Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
+			Overrides: &envoy_ext_proc_v3.ExtProcOverrides{},
+		},
+	})
+	return nil
+}
+
+// called 1 time per listener
+// if a plugin emits new filters, they must be with a plugin unique name.
+// any filter returned from route config must be disabled, so it doesnt impact other routes.
+func (p *inferencePoolPlugin) HttpFilters(_ ir.HttpFiltersContext, fc ir.FilterChainCommon) ([]filters.StagedHttpFilter, error) {
+	result := []filters.StagedHttpFilter{}
+
+	var errs []error
+	for _, epp := range sortedEndpointPickers(p.endpointPickers[fc.FilterChainName]) {
+		f, err := filters.NewStagedFilter(
+			epp.filterName,
+			epp.extProcFilter(),
+			filters.BeforeStage(filters.RouteStage),
+		)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		// only enabled on routes to the inference pool
+		f.Filter.Disabled = true
+		result = append(result, f)
+	}
+	return result, errors.Join(errs...)
+}
+
+// called 1 time (per envoy proxy). replaces GeneratedResources
+func (p *inferencePoolPlugin) ResourcesToAdd() ir.Resources {
+	resources := ir.Resources{}
+
+	all := map[string]*endpointPickerIr{}
+	for _, epps := range p.endpointPickers {
+		for name, epp := range epps {
+			all[name] = epp
+		}
+	}
+	for _, epp := range sortedEndpointPickers(all) {
+		resources.Clusters = append(resources.Clusters, epp.cluster())
+	}
+	return resources
+}
+
+func sortedEndpointPickers(in map[string]*endpointPickerIr) []*endpointPickerIr {
+	out := make([]*endpointPickerIr, 0, len(in))
+	for _, epp := range in {
+		out = append(out, epp)
+	}
+	slices.SortFunc(out, func(a, b *endpointPickerIr) int {
+		return strings.Compare(a.filterName, b.filterName)
+	})
+	return out
+}
@@ -0,0 +1,299 @@
+package inferencepool
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/ptr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
+	kubekrtutil "github.com/kgateway-dev/kgateway/v2/pkg/utils/krtutil"
+)
+
+// maxParents is the maximum number of parents reported in the status of an InferencePool
+const maxParents = 32
+
+// reasonPending is the reason of the Accepted condition of InferencePools that are not referenced by any route.
+const reasonPending inf.InferencePoolReason = "Pending"
+
+// defaultParentRef is the parent under which the status of InferencePools that are not referenced by any route
+// is reported.
+var defaultParentRef = inf.ParentReference{
+	Kind: "Status",
+	Name: "default",
+}
+
+// poolStatus is the desired status of an InferencePool
+type poolStatus struct {
+	pool    types.NamespacedName
+	parents []inf.ParentStatus
+}
+
+func (s poolStatus) ResourceName() string {
+	return s.pool.String()
+}
+
+func (s poolStatus) Equals(other poolStatus) bool {
+	return s.pool == other.pool && parentsEqual(s.parents, other.parents)
+}
+
+// buildPoolStatuses computes the status of each InferencePool from the HTTPRoutes referencing it.
+// The parents of a pool are the Gateways managed by this controller that the referencing routes are attached to.
+func buildPoolStatuses(
+	krtOpts krtutil.KrtOptions,
+	bcol krt.Collection[ir.BackendObjectIR],
+	httpRoutes krt.Collection[*gwv1.HTTPRoute],
+	controllerName string,
+) krt.Collection[poolStatus] {
+	routesByPool := kubekrtutil.UnnamedIndex(httpRoutes, referencedPools)
+	return krt.NewCollection(bcol, func(krtctx krt.HandlerContext, in ir.BackendObjectIR) *poolStatus {
+		pool, ok := in.Obj.(*inf.InferencePool)
+		if !ok {
+			return nil
+		}
+		poolIr, ok := in.ObjIr.(*inferencePoolIr)
+		if !ok {
+			return nil
+		}
+		nn := types.NamespacedName{Namespace: pool.GetNamespace(), Name: pool.GetName()}
+		routes := krt.Fetch(krtctx, httpRoutes, krt.FilterIndex(routesByPool, nn))
+		return &poolStatus{
+			pool:    nn,
+			parents: buildParentStatuses(pool, poolIr.errors, routes, controllerName),
+		}
+	}, krtOpts.ToOptions("InferencePoolStatuses")...)
+}
+
+// referencedPools returns the InferencePools referenced by the backendRefs of the route.
+func referencedPools(route *gwv1.HTTPRoute) []types.NamespacedName {
+	var pools []types.NamespacedName
+	for _, rule := range route.Spec.Rules {
+		for _, ref := range rule.BackendRefs {
+			if ptr.OrEmpty(ref.Group) != gwv1.Group(wellknown.InferencePoolGVK.Group) ||
+				ptr.OrEmpty(ref.Kind) != gwv1.Kind(wellknown.InferencePoolGVK.Kind) {
+				continue
+			}
+			ns := route.GetNamespace()
+			if ref.Namespace != nil {
+				ns = string(*ref.Namespace)
+			}
+			nn := types.NamespacedName{Namespace: ns, Name: string(ref.Name)}
+			if !slices.Contains(pools, nn) {
+				pools = append(pools, nn)
+			}
+		}
+	}
+	return pools
+}
+
+// buildParentStatuses builds a parent status for every Gateway managed by this controller that a route
+// referencing the pool is attached to. The pool is accepted by a parent if at least one of these routes is.
+// A pool without any such parent gets a single pending status under the default parent.
+func buildParentStatuses(
+	pool *inf.InferencePool,
+	poolErrs []error,
+	routes []*gwv1.HTTPRoute,
+	controllerName string,
+) []inf.ParentStatus {
+	accepted := map[types.NamespacedName]bool{}
+	for _, route := range routes {
+		for _, ps := range route.Status.Parents {
+			if string(ps.ControllerName) != controllerName {
+				continue
+			}
+			if ptr.OrDefault(ps.ParentRef.Group, gwv1.GroupName) != gwv1.GroupName ||
+				ptr.OrDefault(ps.ParentRef.Kind, wellknown.GatewayKind) != wellknown.GatewayKind {
+				continue
+			}
+			ns := route.GetNamespace()
+			if ps.ParentRef.Namespace != nil {
+				ns = string(*ps.ParentRef.Namespace)
+			}
+			gw := types.NamespacedName{Namespace: ns, Name: string(ps.ParentRef.Name)}
+			accepted[gw] = accepted[gw] || meta.IsStatusConditionTrue(ps.Conditions, string(gwv1.RouteConditionAccepted))
+		}
+	}
+
+	gateways := make([]types.NamespacedName, 0, len(accepted))
+	for gw := range accepted {
+		gateways = append(gateways, gw)
+	}
+	slices.SortFunc(gateways, func(a, b types.NamespacedName) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+	if len(gateways) > maxParents {
+		gateways = gateways[:maxParents]
+	}
+
+	resolvedRefs := metav1.Condition{
+		Type:               string(inf.InferencePoolConditionResolvedRefs),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(inf.InferencePoolReasonResolvedRefs),
+		Message:            "All references resolved",
+		ObservedGeneration: pool.GetGeneration(),
+	}
+	if len(poolErrs) > 0 {
+		resolvedRefs.Status = metav1.ConditionFalse
+		resolvedRefs.Reason = string(inf.InferencePoolReasonInvalidExtensionRef)
+		resolvedRefs.Message = errors.Join(poolErrs...).Error()
+	}
+
+	if len(gateways) == 0 {
+		// the pool is not referenced by any route attached to a Gateway managed by this controller:
+		// report it under the default parent so that users can see it is not in use and why it may be invalid.
+		return []inf.ParentStatus{{
+			ParentRef: defaultParentRef,
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(inf.InferencePoolConditionAccepted),
+					Status:             metav1.ConditionUnknown,
+					Reason:             string(reasonPending),
+					Message:            "InferencePool is not referenced by any HTTPRoute attached to a Gateway",
+					ObservedGeneration: pool.GetGeneration(),
+				},
+				resolvedRefs,
+			},
+		}}
+	}
+
+	parents := make([]inf.ParentStatus, 0, len(gateways))
+	for _, gw := range gateways {
+		acceptedCond := metav1.Condition{
+			Type:               string(inf.InferencePoolConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(inf.InferencePoolReasonAccepted),
+			Message:            "InferencePool has been accepted",
+			ObservedGeneration: pool.GetGeneration(),
+		}
+		if !accepted[gw] {
+			acceptedCond.Status = metav1.ConditionFalse
+			acceptedCond.Reason = string(inf.InferencePoolReasonHTTPRouteNotAccepted)
+			acceptedCond.Message = "No HTTPRoute referencing the InferencePool has been accepted by the parent"
+		}
+		parents = append(parents, inf.ParentStatus{
+			ParentRef: inf.ParentReference{
+				Group:     ptr.Of(inf.Group(gwv1.GroupName)),
+				Kind:      inf.Kind(wellknown.GatewayKind),
+				Name:      inf.ObjectName(gw.Name),
+				Namespace: inf.Namespace(gw.Namespace),
+			},
+			Conditions: []metav1.Condition{acceptedCond, resolvedRefs},
+		})
+	}
+	return parents
+}
+
+// parentsEqual compares parent statuses, ignoring the last transition time of conditions.
+func parentsEqual(a, b []inf.ParentStatus) bool {
+	return slices.EqualFunc(a, b, func(x, y inf.ParentStatus) bool {
+		if !parentRefEqual(x.ParentRef, y.ParentRef) {
+			return false
+		}
+		return slices.EqualFunc(x.Conditions, y.Conditions, func(c, d metav1.Condition) bool {
+			return c.Type == d.Type &&
+				c.Status == d.Status &&
+				c.Reason == d.Reason &&
+				c.Message == d.Message &&
+				c.ObservedGeneration == d.ObservedGeneration
+		})
+	})
+}
+
+func parentRefEqual(a, b inf.ParentReference) bool {
+	return ptr.OrEmpty(a.Group) == ptr.OrEmpty(b.Group) &&
+		a.Kind == b.Kind &&
+		a.Name == b.Name &&
+		a.Namespace == b.Namespace
+}
+
+// mergeParentStatuses returns the desired parents, keeping the last transition time of
+// conditions that did not change.
+func mergeParentStatuses(existing, desired []inf.ParentStatus) []inf.ParentStatus {
+	out := make([]inf.ParentStatus, 0, len(desired))
+	for _, d := range desired {
+		var conditions []metav1.Condition
+		if idx := slices.IndexFunc(existing, func(e inf.ParentStatus) bool {
+			return parentRefEqual(e.ParentRef, d.ParentRef)
+		}); idx >= 0 {
+			conditions = slices.Clone(existing[idx].Conditions)
+		}
+		for _, c := range d.Conditions {
+			meta.SetStatusCondition(&conditions, c)
+		}
+		out = append(out, inf.ParentStatus{
+			ParentRef:  d.ParentRef,
+			Conditions: conditions,
+		})
+	}
+	return out
+}
+
+func buildRegisterCallback(
+	cl kclient.Client[*inf.InferencePool],
+	statuses krt.Collection[poolStatus],
+) func() {
+	return func() {
+		statuses.Register(func(o krt.Event[poolStatus]) {
+			if o.Event == controllers.EventDelete {
+				return
+			}
+			in := o.Latest()
+			resNN := in.pool
+
+			err := retry.Do(
+				func() error {
+					cur := cl.Get(resNN.Name, resNN.Namespace)
+					if cur == nil {
+						logger.Error("error getting inference pool", "ref", resNN, "error", pluginsdk.ErrNotFound)
+						return pluginsdk.ErrNotFound
+					}
+
+					if parentsEqual(cur.Status.Parents, in.parents) {
+						// status is already up-to-date, nothing to do
+						return nil
+					}
+
+					if _, err := cl.UpdateStatus(&inf.InferencePool{
+						ObjectMeta: pluginsdk.CloneObjectMetaForStatus(cur.ObjectMeta),
+						Status: inf.InferencePoolStatus{
+							Parents: mergeParentStatuses(cur.Status.Parents, in.parents),
+						},
+					}); err != nil {
+						if apierrors.IsConflict(err) {
+							logger.Debug("error updating stale status", "ref", resNN, "error", err)
+							return nil // let the conflicting Status update trigger a KRT event to requeue the updated object
+						}
+						return fmt.Errorf("error updating status for InferencePool %s: %w", resNN, err)
+					}
+					return nil
+				},
+				retry.Attempts(5),
+				retry.Delay(100*time.Millisecond),
+				retry.DelayType(retry.BackOffDelay),
+			)
+			if err != nil {
+				logger.Error(
+					"all attempts failed updating inference pool status",
+					"inference_pool", resNN.String(),
+					"error", err,
+				)
+			}
+		})
+	}
+}
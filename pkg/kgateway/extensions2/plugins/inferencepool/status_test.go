@@ -0,0 +1,147 @@
+package inferencepool
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"istio.io/istio/pkg/ptr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+const testControllerName = "kgateway.dev/kgateway"
+
+func TestReferencedPools(t *testing.T) {
+	route := &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "default"},
+		Spec: gwv1.HTTPRouteSpec{
+			Rules: []gwv1.HTTPRouteRule{
+				{
+					BackendRefs: []gwv1.HTTPBackendRef{
+						poolBackendRef("pool-a", nil),
+						poolBackendRef("pool-b", ptr.Of(gwv1.Namespace("other"))),
+						{BackendRef: gwv1.BackendRef{BackendObjectReference: gwv1.BackendObjectReference{Name: "svc"}}},
+					},
+				},
+				{
+					BackendRefs: []gwv1.HTTPBackendRef{
+						poolBackendRef("pool-a", nil),
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []types.NamespacedName{
+		{Namespace: "default", Name: "pool-a"},
+		{Namespace: "other", Name: "pool-b"},
+	}, referencedPools(route))
+}
+
+func TestBuildParentStatuses(t *testing.T) {
+	pool := &inf.InferencePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default", Generation: 2},
+	}
+	routes := []*gwv1.HTTPRoute{
+		routeWithParents(
+			parentStatus("gw-b", testControllerName, metav1.ConditionTrue),
+			parentStatus("gw-a", testControllerName, metav1.ConditionFalse),
+			parentStatus("other-gw", "example.com/other-controller", metav1.ConditionTrue),
+		),
+		routeWithParents(
+			parentStatus("gw-b", testControllerName, metav1.ConditionFalse),
+		),
+	}
+
+	t.Run("valid pool", func(t *testing.T) {
+		parents := buildParentStatuses(pool, nil, routes, testControllerName)
+		assert.Len(t, parents, 2)
+
+		assert.Equal(t, inf.ObjectName("gw-a"), parents[0].ParentRef.Name)
+		assert.Equal(t, inf.Namespace("default"), parents[0].ParentRef.Namespace)
+		assert.Equal(t, inf.Kind("Gateway"), parents[0].ParentRef.Kind)
+		assert.Equal(t, string(inf.InferencePoolReasonHTTPRouteNotAccepted), parents[0].Conditions[0].Reason)
+		assert.Equal(t, metav1.ConditionFalse, parents[0].Conditions[0].Status)
+
+		assert.Equal(t, inf.ObjectName("gw-b"), parents[1].ParentRef.Name)
+		assert.Equal(t, string(inf.InferencePoolReasonAccepted), parents[1].Conditions[0].Reason)
+		assert.Equal(t, metav1.ConditionTrue, parents[1].Conditions[0].Status)
+		assert.Equal(t, int64(2), parents[1].Conditions[0].ObservedGeneration)
+		assert.Equal(t, string(inf.InferencePoolReasonResolvedRefs), parents[1].Conditions[1].Reason)
+		assert.Equal(t, metav1.ConditionTrue, parents[1].Conditions[1].Status)
+	})
+
+	t.Run("invalid endpoint picker reference", func(t *testing.T) {
+		parents := buildParentStatuses(pool, []error{errors.New("endpoint picker Service default/epp not found")}, routes, testControllerName)
+		for _, p := range parents {
+			assert.Equal(t, string(inf.InferencePoolConditionResolvedRefs), p.Conditions[1].Type)
+			assert.Equal(t, metav1.ConditionFalse, p.Conditions[1].Status)
+			assert.Equal(t, string(inf.InferencePoolReasonInvalidExtensionRef), p.Conditions[1].Reason)
+			assert.Equal(t, "endpoint picker Service default/epp not found", p.Conditions[1].Message)
+		}
+	})
+
+	t.Run("no routes", func(t *testing.T) {
+		parents := buildParentStatuses(pool, []error{errors.New("endpoint picker Service default/epp not found")}, nil, testControllerName)
+		assert.Len(t, parents, 1)
+
+		assert.Equal(t, inf.Kind("Status"), parents[0].ParentRef.Kind)
+		assert.Equal(t, inf.ObjectName("default"), parents[0].ParentRef.Name)
+		assert.Equal(t, metav1.ConditionUnknown, parents[0].Conditions[0].Status)
+		assert.Equal(t, "Pending", parents[0].Conditions[0].Reason)
+		assert.Equal(t, metav1.ConditionFalse, parents[0].Conditions[1].Status)
+		assert.Equal(t, string(inf.InferencePoolReasonInvalidExtensionRef), parents[0].Conditions[1].Reason)
+	})
+}
+
+func TestMergeParentStatuses(t *testing.T) {
+	pool := &inf.InferencePool{ObjectMeta: metav1.ObjectMeta{Name: "pool", Namespace: "default"}}
+	routes := []*gwv1.HTTPRoute{routeWithParents(parentStatus("gw", testControllerName, metav1.ConditionTrue))}
+	desired := buildParentStatuses(pool, nil, routes, testControllerName)
+
+	existing := mergeParentStatuses(nil, desired)
+	assert.True(t, parentsEqual(existing, desired))
+	transitionTime := metav1.NewTime(existing[0].Conditions[0].LastTransitionTime.Add(-time.Hour))
+	existing[0].Conditions[0].LastTransitionTime = transitionTime
+
+	merged := mergeParentStatuses(existing, desired)
+	assert.True(t, parentsEqual(merged, desired))
+	assert.Equal(t, transitionTime, merged[0].Conditions[0].LastTransitionTime, "unchanged conditions keep their transition time")
+}
+
+func poolBackendRef(name string, ns *gwv1.Namespace) gwv1.HTTPBackendRef {
+	return gwv1.HTTPBackendRef{
+		BackendRef: gwv1.BackendRef{
+			BackendObjectReference: gwv1.BackendObjectReference{
+				Group:     ptr.Of(gwv1.Group(inf.GroupName)),
+				Kind:      ptr.Of(gwv1.Kind("InferencePool")),
+				Name:      gwv1.ObjectName(name),
+				Namespace: ns,
+			},
+		},
+	}
+}
+
+func routeWithParents(parents ...gwv1.RouteParentStatus) *gwv1.HTTPRoute {
+	return &gwv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: "default"},
+		Status: gwv1.HTTPRouteStatus{
+			RouteStatus: gwv1.RouteStatus{Parents: parents},
+		},
+	}
+}
+
+func parentStatus(gateway, controllerName string, accepted metav1.ConditionStatus) gwv1.RouteParentStatus {
+	return gwv1.RouteParentStatus{
+		ParentRef:      gwv1.ParentReference{Name: gwv1.ObjectName(gateway)},
+		ControllerName: gwv1.GatewayController(controllerName),
+		Conditions: []metav1.Condition{{
+			Type:   string(gwv1.RouteConditionAccepted),
+			Status: accepted,
+		}},
+	}
+}
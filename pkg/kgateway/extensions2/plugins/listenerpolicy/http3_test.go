@@ -0,0 +1,60 @@
+package listenerpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConvertHttp3Config(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *kgateway.HTTP3Config
+		expected *ir.Http3IR
+	}{
+		{
+			name:     "nil config",
+			config:   nil,
+			expected: nil,
+		},
+		{
+			name:     "empty config uses default max age",
+			config:   &kgateway.HTTP3Config{},
+			expected: &ir.Http3IR{AltSvcMaxAge: 86400},
+		},
+		{
+			name: "explicit max age",
+			config: &kgateway.HTTP3Config{
+				AltSvcMaxAge: &metav1.Duration{Duration: 90 * time.Second},
+			},
+			expected: &ir.Http3IR{AltSvcMaxAge: 90},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, convertHttp3Config(tt.config))
+		})
+	}
+}
+
+func TestGetHttp3(t *testing.T) {
+	http3 := &ir.Http3IR{AltSvcMaxAge: 3600}
+	pol := &ListenerPolicyIR{
+		defaultPolicy: listenerPolicy{http3: http3},
+		perPortPolicy: map[uint32]listenerPolicy{
+			8443: {},
+		},
+	}
+
+	assert.Equal(t, http3, pol.GetHttp3(443), "default policy applies to ports without a per-port policy")
+	assert.Nil(t, pol.GetHttp3(8443), "per-port policy overrides the default policy")
+
+	var nilPol *ListenerPolicyIR
+	assert.Nil(t, nilPol.GetHttp3(443))
+}
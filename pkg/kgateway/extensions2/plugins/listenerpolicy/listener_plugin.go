@@ -42,6 +42,9 @@ import (
 
 var logger = logging.New("plugin/listenerpolicy")
 
+// defaultAltSvcMaxAge is the max age of the HTTP/3 alt-svc advertisement when not specified
+const defaultAltSvcMaxAge = 24 * time.Hour
+
 type ListenerPolicyIR struct {
 	ct            time.Time
 	defaultPolicy listenerPolicy
@@ -54,6 +57,7 @@ type ListenerPolicyIR struct {
 type listenerPolicy struct {
 	proxyProtocol                 *anypb.Any
 	perConnectionBufferLimitBytes *uint32
+	http3                         *ir.Http3IR
 	// only for default policy
 	clientCertificateValidation *ir.ClientCertificateValidationIR
 	// +noKrtEquals
@@ -76,6 +80,7 @@ func newListenerPolicy(
 	return listenerPolicy{
 		proxyProtocol:                 convertProxyProtocolConfig(objSrc, i.ProxyProtocol),
 		perConnectionBufferLimitBytes: perConnectionBufferLimitBytes,
+		http3:                         convertHttp3Config(i.HTTP3),
 		http:                          http,
 	}, errs
 }
@@ -128,6 +133,18 @@ func (d *ListenerPolicyIR) GetClientCertificateValidation() *ir.ClientCertificat
 	return d.defaultPolicy.clientCertificateValidation
 }
 
+// GetHttp3 returns the HTTP/3 configuration of the ListenerPolicy for the given port.
+func (d *ListenerPolicyIR) GetHttp3(port uint32) *ir.Http3IR {
+	if d == nil {
+		return nil
+	}
+
+	if perPortCfg, found := d.perPortPolicy[port]; found {
+		return perPortCfg.http3
+	}
+	return d.defaultPolicy.http3
+}
+
 func (d listenerPolicy) Equals(d2 listenerPolicy) bool {
 	if !proto.Equal(d.proxyProtocol, d2.proxyProtocol) {
 		return false
@@ -137,6 +154,10 @@ func (d listenerPolicy) Equals(d2 listenerPolicy) bool {
 		return false
 	}
 
+	if !d.http3.Equals(d2.http3) {
+		return false
+	}
+
 	if (d.clientCertificateValidation == nil) != (d2.clientCertificateValidation == nil) {
 		return false
 	}
@@ -188,7 +209,10 @@ func patchPolicyStatusFn(
 	}
 }
 
-var _ ir.PolicyIR = &ListenerPolicyIR{}
+var (
+	_ ir.PolicyIR      = &ListenerPolicyIR{}
+	_ ir.Http3PolicyIR = &ListenerPolicyIR{}
+)
 
 type listenerPolicyPluginGwPass struct {
 	ir.UnimplementedProxyTranslationPass
@@ -521,6 +545,20 @@ func convertProxyProtocolConfig(objSrc ir.ObjectSource, config *kgateway.ProxyPr
 	return proxyProtocolAny
 }
 
+func convertHttp3Config(config *kgateway.HTTP3Config) *ir.Http3IR {
+	if config == nil {
+		return nil
+	}
+
+	maxAge := defaultAltSvcMaxAge
+	if config.AltSvcMaxAge != nil {
+		maxAge = config.AltSvcMaxAge.Duration
+	}
+	return &ir.Http3IR{
+		AltSvcMaxAge: uint32(maxAge.Seconds()),
+	}
+}
+
 func convertClientCertificateValidationConfig(_ ir.ObjectSource, config *kgateway.ClientCertificateValidationConfig) *ir.ClientCertificateValidationIR {
 	if config == nil {
 		return nil
@@ -89,6 +89,7 @@ func mergeListenerPolicy(
 	mergeFuncs := []func(string, *listenerPolicy, *listenerPolicy, *ir.AttachedPolicyRef, ir.MergeOrigins, policy.MergeOptions, ir.MergeOrigins){
 		mergeProxyProtocol,
 		mergePerConnectionBufferLimitBytes,
+		mergeHttp3,
 		// Not merging ClientCertificateValidation since its only used for tls config.
 		mergeHttpSettings,
 	}
@@ -130,6 +131,22 @@ func mergePerConnectionBufferLimitBytes(
 	mergeOrigins.SetOne(origin+"perConnectionBufferLimitBytes", p2Ref, p2MergeOrigins)
 }
 
+func mergeHttp3(
+	origin string,
+	p1, p2 *listenerPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.http3, p2.http3, opts) {
+		return
+	}
+
+	p1.http3 = p2.http3
+	mergeOrigins.SetOne(origin+"http3", p2Ref, p2MergeOrigins)
+}
+
 func mergeHttpSettings(
 	origin string,
 	p1, p2 *listenerPolicy,
@@ -0,0 +1,225 @@
+package trafficpolicy
+
+import (
+	"fmt"
+
+	xdscorev3 "github.com/cncf/xds/go/xds/core/v3"
+	xdsmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	asyncfilesv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/async_files/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	cachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cache/v3"
+	envoycompositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	filesystemcachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/cache/file_system_http_cache/v3"
+	simplecachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/cache/simple_http_cache/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	cacheFilterName = "envoy.filters.http.cache"
+	// cacheCompositeName is the name of the composite filter that executes the cache filter
+	// configured for a route
+	cacheCompositeName = "composite_cache"
+)
+
+type cacheIR struct {
+	// config is the cache filter configuration. It is nil when the policy disables caching.
+	config *cachev3.CacheConfig
+	// perRoute selects the cache filter configuration for the routes the policy applies to.
+	// The cache filter has no per-route configuration of its own, so it is wrapped in a composite
+	// filter whose matcher is overridden per route to execute the cache filter with config.
+	perRoute *envoymatchingv3.ExtensionWithMatcherPerRoute
+}
+
+var _ PolicySubIR = &cacheIR{}
+
+func (c *cacheIR) Equals(other PolicySubIR) bool {
+	otherCache, ok := other.(*cacheIR)
+	if !ok {
+		return false
+	}
+	if c == nil || otherCache == nil {
+		return c == nil && otherCache == nil
+	}
+	return proto.Equal(c.config, otherCache.config)
+}
+
+func (c *cacheIR) Validate() error {
+	if c == nil || c.config == nil {
+		return nil
+	}
+	return c.config.ValidateAll()
+}
+
+// constructCache constructs the response cache policy IR from the policy specification.
+func constructCache(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) error {
+	if spec.Cache == nil {
+		return nil
+	}
+	if spec.Cache.Disable != nil {
+		out.cache = &cacheIR{}
+		return nil
+	}
+
+	config, err := buildCacheConfig(spec.Cache)
+	if err != nil {
+		return fmt.Errorf("cache: %w", err)
+	}
+	out.cache = &cacheIR{
+		config:   config,
+		perRoute: buildCachePerRouteConfig(config),
+	}
+	return nil
+}
+
+func buildCacheConfig(in *kgateway.ResponseCache) (*cachev3.CacheConfig, error) {
+	storage, err := buildCacheStorage(in.Storage)
+	if err != nil {
+		return nil, err
+	}
+	storageAny, err := utils.MessageToAny(storage)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &cachev3.CacheConfig{
+		TypedConfig:                     storageAny,
+		IgnoreRequestCacheControlHeader: ptr.Deref(in.IgnoreRequestCacheControl, false),
+	}
+	for _, header := range in.AllowedVaryHeaders {
+		config.AllowedVaryHeaders = append(config.AllowedVaryHeaders, toEnvoyStringMatcher(header))
+	}
+	if in.MaxBodySize != nil {
+		config.MaxBodyBytes = uint32(in.MaxBodySize.Value()) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	if in.Key != nil {
+		config.KeyCreatorParams = &cachev3.CacheConfig_KeyCreatorParams{
+			ExcludeScheme:           ptr.Deref(in.Key.ExcludeScheme, false),
+			ExcludeHost:             ptr.Deref(in.Key.ExcludeHost, false),
+			QueryParametersIncluded: toQueryParameterMatchers(in.Key.IncludedQueryParameters),
+			QueryParametersExcluded: toQueryParameterMatchers(in.Key.ExcludedQueryParameters),
+		}
+	}
+	return config, nil
+}
+
+// buildCacheStorage returns the configuration of the cache storage, defaulting to an in-memory cache.
+func buildCacheStorage(in *kgateway.CacheStorage) (proto.Message, error) {
+	if in == nil || in.FileSystem == nil {
+		return &simplecachev3.SimpleHttpCacheConfig{}, nil
+	}
+
+	fs := in.FileSystem
+	threadPool := &asyncfilesv3.AsyncFileManagerConfig_ThreadPool{}
+	if fs.ThreadCount != nil {
+		threadPool.ThreadCount = uint32(*fs.ThreadCount) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	out := &filesystemcachev3.FileSystemHttpCacheConfig{
+		ManagerConfig: &asyncfilesv3.AsyncFileManagerConfig{
+			// Reusing a manager id with a different configuration is an error,
+			// so use a manager per cache directory
+			Id: fs.Path,
+			ManagerType: &asyncfilesv3.AsyncFileManagerConfig_ThreadPool_{
+				ThreadPool: threadPool,
+			},
+		},
+		CachePath: fs.Path,
+	}
+	if fs.MaxSize != nil {
+		if fs.MaxSize.Sign() <= 0 {
+			return nil, fmt.Errorf("maxSize must be greater than 0")
+		}
+		out.MaxCacheSizeBytes = wrapperspb.UInt64(uint64(fs.MaxSize.Value())) // nolint:gosec // G115: checked to be positive above
+	}
+	if fs.MaxEntrySize != nil {
+		if fs.MaxEntrySize.Sign() <= 0 {
+			return nil, fmt.Errorf("maxEntrySize must be greater than 0")
+		}
+		out.MaxIndividualCacheEntrySizeBytes = wrapperspb.UInt64(uint64(fs.MaxEntrySize.Value())) // nolint:gosec // G115: checked to be positive above
+	}
+	if fs.MaxEntries != nil {
+		out.MaxCacheEntryCount = wrapperspb.UInt64(uint64(*fs.MaxEntries)) // nolint:gosec // G115: kubebuilder validation ensures positive value
+	}
+	return out, nil
+}
+
+func toQueryParameterMatchers(names []string) []*envoyroutev3.QueryParameterMatcher {
+	var out []*envoyroutev3.QueryParameterMatcher
+	for _, name := range names {
+		out = append(out, &envoyroutev3.QueryParameterMatcher{
+			Name: name,
+			QueryParameterMatchSpecifier: &envoyroutev3.QueryParameterMatcher_PresentMatch{
+				PresentMatch: true,
+			},
+		})
+	}
+	return out
+}
+
+// buildCacheFilter returns the cache filter added to the filter chain. The filter does nothing until
+// a route overrides its matcher with the cache configuration of the route.
+func buildCacheFilter() *envoymatchingv3.ExtensionWithMatcher {
+	return &envoymatchingv3.ExtensionWithMatcher{
+		ExtensionConfig: &envoycorev3.TypedExtensionConfig{
+			Name:        cacheCompositeName,
+			TypedConfig: utils.MustMessageToAny(&envoycompositev3.Composite{}),
+		},
+		XdsMatcher: &xdsmatcherv3.Matcher{},
+	}
+}
+
+// buildCachePerRouteConfig returns the matcher override that executes the cache filter with config
+// for all the requests of a route.
+func buildCachePerRouteConfig(config *cachev3.CacheConfig) *envoymatchingv3.ExtensionWithMatcherPerRoute {
+	return &envoymatchingv3.ExtensionWithMatcherPerRoute{
+		XdsMatcher: &xdsmatcherv3.Matcher{
+			OnNoMatch: &xdsmatcherv3.Matcher_OnMatch{
+				OnMatch: &xdsmatcherv3.Matcher_OnMatch_Action{
+					Action: &xdscorev3.TypedExtensionConfig{
+						Name: "composite-action",
+						TypedConfig: utils.MustMessageToAny(&envoycompositev3.ExecuteFilterAction{
+							TypedConfig: &envoycorev3.TypedExtensionConfig{
+								Name:        cacheFilterName,
+								TypedConfig: utils.MustMessageToAny(config),
+							},
+						}),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (p *trafficPolicyPluginGwPass) handleCache(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, cache *cacheIR) {
+	if cache == nil {
+		return
+	}
+
+	// A nil config means the policy disables caching. Disabling the filter for the route overrides
+	// caching enabled at a higher level of the config hierarchy.
+	if cache.config == nil {
+		pCtxTypedFilterConfig.AddTypedConfig(cacheFilterName, &envoyroutev3.FilterConfig{Disabled: true})
+		return
+	}
+
+	// The most specific per-route configuration takes precedence, so a cache configured for a route
+	// replaces the cache configured for its virtual host or listener instead of being chained with it.
+	pCtxTypedFilterConfig.AddTypedConfig(cacheFilterName, &envoyroutev3.FilterConfig{
+		Config: utils.MustMessageToAny(cache.perRoute),
+	})
+
+	// Add a disabled cache filter to the filter chain, it is enabled by the per-route configuration
+	if p.cacheInChain == nil {
+		p.cacheInChain = make(map[string]*envoymatchingv3.ExtensionWithMatcher)
+	}
+	if _, ok := p.cacheInChain[fcn]; !ok {
+		p.cacheInChain[fcn] = buildCacheFilter()
+	}
+}
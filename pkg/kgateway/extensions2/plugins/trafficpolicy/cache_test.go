@@ -0,0 +1,160 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	asyncfilesv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/async_files/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	cachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cache/v3"
+	filesystemcachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/cache/file_system_http_cache/v3"
+	simplecachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/cache/simple_http_cache/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConstructCache(t *testing.T) {
+	tests := []struct {
+		name     string
+		cache    *kgateway.ResponseCache
+		expected *cachev3.CacheConfig
+		disabled bool
+		wantErr  string
+	}{
+		{
+			name: "nil cache",
+		},
+		{
+			name: "disabled cache",
+			cache: &kgateway.ResponseCache{
+				Disable: &shared.PolicyDisable{},
+			},
+			disabled: true,
+		},
+		{
+			name:  "defaults to in-memory storage",
+			cache: &kgateway.ResponseCache{},
+			expected: &cachev3.CacheConfig{
+				TypedConfig: utils.MustMessageToAny(&simplecachev3.SimpleHttpCacheConfig{}),
+			},
+		},
+		{
+			name: "file system storage with vary headers and cache key",
+			cache: &kgateway.ResponseCache{
+				Storage: &kgateway.CacheStorage{
+					FileSystem: &kgateway.FileSystemCacheStorage{
+						Path:         "/var/cache/envoy",
+						MaxSize:      new(resource.MustParse("1Gi")),
+						MaxEntrySize: new(resource.MustParse("10Mi")),
+						MaxEntries:   ptr.To[int64](1000),
+						ThreadCount:  ptr.To[int32](4),
+					},
+				},
+				AllowedVaryHeaders: []shared.StringMatcher{
+					{Exact: ptr.To("accept-encoding")},
+				},
+				Key: &kgateway.CacheKey{
+					ExcludeHost:             ptr.To(true),
+					ExcludedQueryParameters: []string{"utm_source"},
+				},
+				MaxBodySize:               new(resource.MustParse("1Mi")),
+				IgnoreRequestCacheControl: ptr.To(true),
+			},
+			expected: &cachev3.CacheConfig{
+				TypedConfig: utils.MustMessageToAny(&filesystemcachev3.FileSystemHttpCacheConfig{
+					ManagerConfig: &asyncfilesv3.AsyncFileManagerConfig{
+						Id: "/var/cache/envoy",
+						ManagerType: &asyncfilesv3.AsyncFileManagerConfig_ThreadPool_{
+							ThreadPool: &asyncfilesv3.AsyncFileManagerConfig_ThreadPool{ThreadCount: 4},
+						},
+					},
+					CachePath:                        "/var/cache/envoy",
+					MaxCacheSizeBytes:                wrapperspb.UInt64(1 << 30),
+					MaxIndividualCacheEntrySizeBytes: wrapperspb.UInt64(10 << 20),
+					MaxCacheEntryCount:               wrapperspb.UInt64(1000),
+				}),
+				AllowedVaryHeaders: []*envoymatcherv3.StringMatcher{
+					{MatchPattern: &envoymatcherv3.StringMatcher_Exact{Exact: "accept-encoding"}},
+				},
+				KeyCreatorParams: &cachev3.CacheConfig_KeyCreatorParams{
+					ExcludeHost: true,
+					QueryParametersExcluded: []*envoyroutev3.QueryParameterMatcher{
+						{
+							Name:                         "utm_source",
+							QueryParameterMatchSpecifier: &envoyroutev3.QueryParameterMatcher_PresentMatch{PresentMatch: true},
+						},
+					},
+				},
+				MaxBodyBytes:                    1 << 20,
+				IgnoreRequestCacheControlHeader: true,
+			},
+		},
+		{
+			name: "invalid file system cache size",
+			cache: &kgateway.ResponseCache{
+				Storage: &kgateway.CacheStorage{
+					FileSystem: &kgateway.FileSystemCacheStorage{
+						Path:    "/var/cache/envoy",
+						MaxSize: new(resource.MustParse("0")),
+					},
+				},
+			},
+			wantErr: "cache: maxSize must be greater than 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &trafficPolicySpecIr{}
+			err := constructCache(kgateway.TrafficPolicySpec{Cache: tt.cache}, out)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			switch {
+			case tt.cache == nil:
+				assert.Nil(t, out.cache)
+			case tt.disabled:
+				require.NotNil(t, out.cache)
+				assert.Nil(t, out.cache.config)
+			default:
+				require.NotNil(t, out.cache)
+				assert.Empty(t, cmp.Diff(tt.expected, out.cache.config, protocmp.Transform()))
+				assert.NoError(t, out.cache.Validate())
+			}
+		})
+	}
+}
+
+func TestHandleCache(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	require.NoError(t, constructCache(kgateway.TrafficPolicySpec{Cache: &kgateway.ResponseCache{}}, out))
+	p := &trafficPolicyPluginGwPass{}
+
+	routeConfig := ir.TypedFilterConfigMap{}
+	p.handleCache("listener~80", &routeConfig, out.cache)
+	require.NotNil(t, p.cacheInChain["listener~80"])
+	filterConfig, ok := routeConfig.GetTypedConfig(cacheFilterName).(*envoyroutev3.FilterConfig)
+	require.True(t, ok)
+	assert.False(t, filterConfig.GetDisabled())
+	perRoute := &envoymatchingv3.ExtensionWithMatcherPerRoute{}
+	require.NoError(t, filterConfig.GetConfig().UnmarshalTo(perRoute))
+	assert.Empty(t, cmp.Diff(out.cache.perRoute, perRoute, protocmp.Transform()))
+
+	disabledConfig := ir.TypedFilterConfigMap{}
+	p.handleCache("listener~80", &disabledConfig, &cacheIR{})
+	assert.Equal(t, &envoyroutev3.FilterConfig{Disabled: true}, disabledConfig.GetTypedConfig(cacheFilterName))
+}
@@ -88,6 +88,10 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 	constructBuffer(policyCR.Spec, &outSpec)
 	// Construct timeout and retry specific IR
 	constructTimeoutRetry(policyCR.Spec, &outSpec)
+	// Construct fault injection specific IR
+	if err := constructFaultInjection(policyCR.Spec, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
 
 	// Construct rbac specific IR
 	if err := constructRBAC(policyCR, &outSpec); err != nil {
@@ -119,6 +123,36 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 	if err := constructBasicAuth(krtctx, policyCR, &outSpec, c.commoncol.Secrets); err != nil {
 		errors = append(errors, err)
 	}
+	// Construct lua specific IR
+	if err := constructLua(krtctx, policyCR, &outSpec, c.commoncol.ConfigMaps); err != nil {
+		errors = append(errors, err)
+	}
+	// Construct wasm specific IR
+	if err := constructWasm(krtctx, policyCR, c.FetchGatewayExtension, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+	// Construct cache specific IR
+	if err := constructCache(policyCR.Spec, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+	// Construct mirror specific IR
+	if err := constructMirror(krtctx, policyCR, c.commoncol.BackendIndex, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+	// Construct WAF specific IR
+	if err := constructWAF(krtctx, policyCR, c.FetchGatewayExtension, c.commoncol.ConfigMaps, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+	// Construct gRPC-JSON transcoder specific IR
+	if err := constructGRPCJSONTranscoder(krtctx, policyCR, c.commoncol.ConfigMaps, c.commoncol.Secrets, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+	// Construct gRPC-Web specific IR
+	constructGRPCWeb(policyCR.Spec, &outSpec)
+	// Construct custom response specific IR
+	if err := constructCustomResponse(policyCR.Spec, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
 
 	for _, err := range errors {
 		logger.Error("error translating traffic policy", "namespace", policyCR.GetNamespace(), "name", policyCR.GetName(), "error", err)
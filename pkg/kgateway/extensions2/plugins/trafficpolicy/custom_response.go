@@ -0,0 +1,270 @@
+package trafficpolicy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	xdscorev3 "github.com/cncf/xds/go/xds/core/v3"
+	xdsmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	customresponsev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/custom_response/v3"
+	localresponsepolicyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/custom_response/local_response_policy/v3"
+	redirectpolicyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/custom_response/redirect_policy/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	customResponseFilterName = "envoy.filters.http.custom_response"
+
+	minStatusCode = 100
+	maxStatusCode = 599
+)
+
+type customResponseIR struct {
+	// config is the custom response configuration applied to the route. It is nil when the policy
+	// disables the custom responses.
+	config *customresponsev3.CustomResponse
+}
+
+var _ PolicySubIR = &customResponseIR{}
+
+func (c *customResponseIR) Equals(other PolicySubIR) bool {
+	otherCustomResponse, ok := other.(*customResponseIR)
+	if !ok {
+		return false
+	}
+	if c == nil || otherCustomResponse == nil {
+		return c == nil && otherCustomResponse == nil
+	}
+	return proto.Equal(c.config, otherCustomResponse.config)
+}
+
+func (c *customResponseIR) Validate() error {
+	if c == nil || c.config == nil {
+		return nil
+	}
+	return c.config.ValidateAll()
+}
+
+// constructCustomResponse constructs the custom response policy IR from the policy specification.
+func constructCustomResponse(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) error {
+	if spec.CustomResponse == nil {
+		return nil
+	}
+
+	if spec.CustomResponse.Disable != nil {
+		out.customResponse = &customResponseIR{}
+		return nil
+	}
+
+	config, err := buildCustomResponse(spec.CustomResponse)
+	if err != nil {
+		return fmt.Errorf("customResponse: %w", err)
+	}
+	out.customResponse = &customResponseIR{config: config}
+	return nil
+}
+
+// buildCustomResponse returns the custom response configuration, with a matcher on the status code
+// of the response for each rule, in the order of the rules.
+func buildCustomResponse(in *kgateway.CustomResponse) (*customresponsev3.CustomResponse, error) {
+	matchers := make([]*xdsmatcherv3.Matcher_MatcherList_FieldMatcher, 0, len(in.Rules))
+	for i, rule := range in.Rules {
+		statusCodes, err := statusCodesRegex(rule.StatusCodes)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		action, err := buildCustomResponseAction(rule)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		matchers = append(matchers, &xdsmatcherv3.Matcher_MatcherList_FieldMatcher{
+			Predicate: &xdsmatcherv3.Matcher_MatcherList_Predicate{
+				MatchType: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+					SinglePredicate: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+						Input: &xdscorev3.TypedExtensionConfig{
+							Name:        "status-code",
+							TypedConfig: utils.MustMessageToAny(&envoymatcherv3.HttpResponseStatusCodeMatchInput{}),
+						},
+						Matcher: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_ValueMatch{
+							ValueMatch: &xdsmatcherv3.StringMatcher{
+								MatchPattern: &xdsmatcherv3.StringMatcher_SafeRegex{
+									SafeRegex: &xdsmatcherv3.RegexMatcher{
+										EngineType: &xdsmatcherv3.RegexMatcher_GoogleRe2{
+											GoogleRe2: &xdsmatcherv3.RegexMatcher_GoogleRE2{},
+										},
+										Regex: statusCodes,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			OnMatch: &xdsmatcherv3.Matcher_OnMatch{
+				OnMatch: &xdsmatcherv3.Matcher_OnMatch_Action{
+					Action: action,
+				},
+			},
+		})
+	}
+
+	return &customresponsev3.CustomResponse{
+		CustomResponseMatcher: &xdsmatcherv3.Matcher{
+			MatcherType: &xdsmatcherv3.Matcher_MatcherList_{
+				MatcherList: &xdsmatcherv3.Matcher_MatcherList{
+					Matchers: matchers,
+				},
+			},
+		},
+	}, nil
+}
+
+// buildCustomResponseAction returns the custom response policy of a rule, either a local response
+// or an internal redirect.
+func buildCustomResponseAction(rule kgateway.CustomResponseRule) (*xdscorev3.TypedExtensionConfig, error) {
+	switch {
+	case rule.LocalResponse != nil:
+		in := rule.LocalResponse
+		policy := &localresponsepolicyv3.LocalResponsePolicy{
+			StatusCode:           toStatusCode(in.StatusCode),
+			ResponseHeadersToAdd: toResponseHeadersToAdd(in.Headers),
+		}
+		if in.Body != nil {
+			policy.BodyFormat = &envoycorev3.SubstitutionFormatString{
+				Format: &envoycorev3.SubstitutionFormatString_TextFormatSource{
+					TextFormatSource: &envoycorev3.DataSource{
+						Specifier: &envoycorev3.DataSource_InlineString{
+							InlineString: *in.Body,
+						},
+					},
+				},
+			}
+			if in.ContentType != nil {
+				policy.BodyFormat.ContentType = *in.ContentType
+			}
+		}
+		return &xdscorev3.TypedExtensionConfig{
+			Name:        "local-response",
+			TypedConfig: utils.MustMessageToAny(policy),
+		}, nil
+
+	case rule.Redirect != nil:
+		in := rule.Redirect
+		return &xdscorev3.TypedExtensionConfig{
+			Name: "redirect",
+			TypedConfig: utils.MustMessageToAny(&redirectpolicyv3.RedirectPolicy{
+				RedirectActionSpecifier: &redirectpolicyv3.RedirectPolicy_Uri{
+					Uri: in.URI,
+				},
+				StatusCode:           toStatusCode(in.StatusCode),
+				ResponseHeadersToAdd: toResponseHeadersToAdd(in.Headers),
+			}),
+		}, nil
+
+	default:
+		// This shouldn't happen due to CEL validation
+		return nil, fmt.Errorf("either localResponse or redirect must be specified")
+	}
+}
+
+// statusCodesRegex returns a regular expression matching the status codes of the ranges.
+// Full classes and decades of status codes are matched with a wildcard, e.g., `5\d\d` for
+// 500-599 and `40\d` for 400-409, to keep the expression short.
+func statusCodesRegex(ranges []kgateway.StatusCodeRange) (string, error) {
+	var codes [maxStatusCode + 1]bool
+	for _, r := range ranges {
+		end := r.Start
+		if r.End != nil {
+			end = *r.End
+		}
+		if r.Start < minStatusCode || end > maxStatusCode || end < r.Start {
+			// This shouldn't happen due to CEL validation
+			return "", fmt.Errorf("invalid status code range %d-%d", r.Start, end)
+		}
+		for code := r.Start; code <= end; code++ {
+			codes[code] = true
+		}
+	}
+	all := func(from, to int) bool {
+		for code := from; code <= to; code++ {
+			if !codes[code] {
+				return false
+			}
+		}
+		return true
+	}
+
+	var alternatives []string
+	for class := minStatusCode; class <= maxStatusCode; class += 100 {
+		if all(class, class+99) {
+			alternatives = append(alternatives, strconv.Itoa(class/100)+`\d\d`)
+			continue
+		}
+		for decade := class; decade < class+100; decade += 10 {
+			if all(decade, decade+9) {
+				alternatives = append(alternatives, strconv.Itoa(decade/10)+`\d`)
+				continue
+			}
+			for code := decade; code < decade+10; code++ {
+				if codes[code] {
+					alternatives = append(alternatives, strconv.Itoa(code))
+				}
+			}
+		}
+	}
+	return strings.Join(alternatives, "|"), nil
+}
+
+func toStatusCode(in *int32) *wrapperspb.UInt32Value {
+	if in == nil {
+		return nil
+	}
+	return wrapperspb.UInt32(uint32(*in)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+}
+
+func toResponseHeadersToAdd(headers []gwv1.HTTPHeader) []*envoycorev3.HeaderValueOption {
+	var out []*envoycorev3.HeaderValueOption
+	for _, h := range headers {
+		out = append(out, &envoycorev3.HeaderValueOption{
+			Header: &envoycorev3.HeaderValue{
+				Key:   string(h.Name),
+				Value: h.Value,
+			},
+			AppendAction: envoycorev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+		})
+	}
+	return out
+}
+
+func (p *trafficPolicyPluginGwPass) handleCustomResponse(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, customResponse *customResponseIR) {
+	if customResponse == nil {
+		return
+	}
+
+	// A nil config means the policy disables the custom responses, so disable the filter for the route.
+	if customResponse.config == nil {
+		pCtxTypedFilterConfig.AddTypedConfig(customResponseFilterName, DisableFilterPerRoute())
+		return
+	}
+
+	// Add the custom response configuration to the typed_per_filter_config, so that the configuration
+	// of the most specific level of the config hierarchy applies, e.g., a route overriding the Gateway.
+	pCtxTypedFilterConfig.AddTypedConfig(customResponseFilterName, customResponse.config)
+
+	// Add a disabled custom response filter to the filter chain, it is enabled by the per-route configuration
+	if p.customResponseInChain == nil {
+		p.customResponseInChain = make(map[string]*customresponsev3.CustomResponse)
+	}
+	if _, ok := p.customResponseInChain[fcn]; !ok {
+		p.customResponseInChain[fcn] = &customresponsev3.CustomResponse{}
+	}
+}
@@ -0,0 +1,148 @@
+package trafficpolicy
+
+import (
+	"regexp"
+	"strconv"
+	"testing"
+
+	localresponsepolicyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/custom_response/local_response_policy/v3"
+	redirectpolicyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/custom_response/redirect_policy/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestStatusCodesRegex(t *testing.T) {
+	tests := []struct {
+		name     string
+		ranges   []kgateway.StatusCodeRange
+		expected string
+	}{
+		{
+			name:     "single status code",
+			ranges:   []kgateway.StatusCodeRange{{Start: 401}},
+			expected: "401",
+		},
+		{
+			name:     "status code class",
+			ranges:   []kgateway.StatusCodeRange{{Start: 500, End: ptr.To[int32](599)}},
+			expected: `5\d\d`,
+		},
+		{
+			name: "ranges across decades",
+			ranges: []kgateway.StatusCodeRange{
+				{Start: 429},
+				{Start: 401, End: ptr.To[int32](403)},
+				{Start: 495, End: ptr.To[int32](511)},
+			},
+			expected: `401|402|403|429|495|496|497|498|499|50\d|510|511`,
+		},
+		{
+			name: "overlapping ranges",
+			ranges: []kgateway.StatusCodeRange{
+				{Start: 400, End: ptr.To[int32](450)},
+				{Start: 420, End: ptr.To[int32](499)},
+			},
+			expected: `4\d\d`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := statusCodesRegex(tt.ranges)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, out)
+
+			// Envoy requires a full match of the regex
+			re := regexp.MustCompile("^(?:" + out + ")$")
+			for code := minStatusCode; code <= maxStatusCode; code++ {
+				inRange := false
+				for _, r := range tt.ranges {
+					inRange = inRange || (code >= int(r.Start) && code <= int(ptr.Deref(r.End, r.Start)))
+				}
+				assert.Equal(t, inRange, re.MatchString(strconv.Itoa(code)), "status code %d", code)
+			}
+		})
+	}
+
+	_, err := statusCodesRegex([]kgateway.StatusCodeRange{{Start: 500, End: ptr.To[int32](499)}})
+	assert.Error(t, err)
+}
+
+func TestConstructCustomResponse(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	err := constructCustomResponse(kgateway.TrafficPolicySpec{
+		CustomResponse: &kgateway.CustomResponse{
+			Rules: []kgateway.CustomResponseRule{
+				{
+					StatusCodes: []kgateway.StatusCodeRange{{Start: 401}, {Start: 403}},
+					LocalResponse: &kgateway.CustomLocalResponse{
+						StatusCode:  ptr.To[int32](403),
+						Body:        ptr.To(`{"error":"forbidden","requestId":"%REQ(x-request-id)%"}`),
+						ContentType: ptr.To("application/json"),
+						Headers:     []gwv1.HTTPHeader{{Name: "cache-control", Value: "no-store"}},
+					},
+				},
+				{
+					StatusCodes: []kgateway.StatusCodeRange{{Start: 500, End: ptr.To[int32](599)}},
+					Redirect: &kgateway.CustomResponseRedirect{
+						URI: "http://errors.example.com/5xx.html",
+					},
+				},
+			},
+		},
+	}, out)
+	require.NoError(t, err)
+	require.NotNil(t, out.customResponse)
+	require.NoError(t, out.customResponse.Validate())
+
+	matchers := out.customResponse.config.GetCustomResponseMatcher().GetMatcherList().GetMatchers()
+	require.Len(t, matchers, 2)
+
+	assert.Equal(t, "401|403", matchers[0].GetPredicate().GetSinglePredicate().GetValueMatch().GetSafeRegex().GetRegex())
+	localResponse := &localresponsepolicyv3.LocalResponsePolicy{}
+	require.NoError(t, matchers[0].GetOnMatch().GetAction().GetTypedConfig().UnmarshalTo(localResponse))
+	assert.Equal(t, uint32(403), localResponse.GetStatusCode().GetValue())
+	assert.Equal(t, "application/json", localResponse.GetBodyFormat().GetContentType())
+	assert.Equal(t, `{"error":"forbidden","requestId":"%REQ(x-request-id)%"}`, localResponse.GetBodyFormat().GetTextFormatSource().GetInlineString())
+	require.Len(t, localResponse.GetResponseHeadersToAdd(), 1)
+	assert.Equal(t, "cache-control", localResponse.GetResponseHeadersToAdd()[0].GetHeader().GetKey())
+
+	assert.Equal(t, `5\d\d`, matchers[1].GetPredicate().GetSinglePredicate().GetValueMatch().GetSafeRegex().GetRegex())
+	redirect := &redirectpolicyv3.RedirectPolicy{}
+	require.NoError(t, matchers[1].GetOnMatch().GetAction().GetTypedConfig().UnmarshalTo(redirect))
+	assert.Equal(t, "http://errors.example.com/5xx.html", redirect.GetUri())
+	assert.Nil(t, redirect.GetStatusCode())
+}
+
+func TestHandleCustomResponse(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	require.NoError(t, constructCustomResponse(kgateway.TrafficPolicySpec{
+		CustomResponse: &kgateway.CustomResponse{
+			Rules: []kgateway.CustomResponseRule{{
+				StatusCodes:   []kgateway.StatusCodeRange{{Start: 429}},
+				LocalResponse: &kgateway.CustomLocalResponse{Body: ptr.To("slow down")},
+			}},
+		},
+	}, out))
+	p := &trafficPolicyPluginGwPass{}
+
+	routeConfig := ir.TypedFilterConfigMap{}
+	p.handleCustomResponse("listener~80", &routeConfig, out.customResponse)
+	assert.Equal(t, out.customResponse.config, routeConfig.GetTypedConfig(customResponseFilterName))
+	assert.NotNil(t, p.customResponseInChain["listener~80"])
+
+	disabled := &trafficPolicySpecIr{}
+	require.NoError(t, constructCustomResponse(kgateway.TrafficPolicySpec{
+		CustomResponse: &kgateway.CustomResponse{Disable: &shared.PolicyDisable{}},
+	}, disabled))
+	disabledConfig := ir.TypedFilterConfigMap{}
+	p.handleCustomResponse("listener~81", &disabledConfig, disabled.customResponse)
+	assert.Equal(t, DisableFilterPerRoute(), disabledConfig.GetTypedConfig(customResponseFilterName))
+	assert.Nil(t, p.customResponseInChain["listener~81"])
+}
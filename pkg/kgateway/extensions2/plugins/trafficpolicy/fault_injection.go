@@ -0,0 +1,152 @@
+package trafficpolicy
+
+import (
+	commonfaultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	faultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
+)
+
+const faultFilterName = "envoy.filters.http.fault"
+
+type faultInjectionIR struct {
+	// perRoute is the fault configuration applied to the route. It is nil when the policy
+	// disables fault injection.
+	perRoute *faultv3.HTTPFault
+}
+
+var _ PolicySubIR = &faultInjectionIR{}
+
+func (f *faultInjectionIR) Equals(other PolicySubIR) bool {
+	otherFault, ok := other.(*faultInjectionIR)
+	if !ok {
+		return false
+	}
+	if f == nil || otherFault == nil {
+		return f == nil && otherFault == nil
+	}
+	return proto.Equal(f.perRoute, otherFault.perRoute)
+}
+
+func (f *faultInjectionIR) Validate() error {
+	if f == nil || f.perRoute == nil {
+		return nil
+	}
+	return f.perRoute.ValidateAll()
+}
+
+// constructFaultInjection constructs the fault injection policy IR from the policy specification.
+func constructFaultInjection(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) error {
+	if spec.FaultInjection == nil {
+		return nil
+	}
+
+	if spec.FaultInjection.Disable != nil {
+		out.faultInjection = &faultInjectionIR{}
+		return nil
+	}
+
+	headers, err := pluginsdkutils.ToEnvoyHeaderMatchers(spec.FaultInjection.Headers)
+	if err != nil {
+		return err
+	}
+
+	perRoute := &faultv3.HTTPFault{
+		Delay: toFaultDelay(spec.FaultInjection.Delay),
+		Abort: toFaultAbort(spec.FaultInjection.Abort),
+	}
+	if len(headers) > 0 {
+		perRoute.Headers = headers
+	}
+	if spec.FaultInjection.MaxActiveFaults != nil {
+		perRoute.MaxActiveFaults = wrapperspb.UInt32(uint32(*spec.FaultInjection.MaxActiveFaults)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+
+	out.faultInjection = &faultInjectionIR{
+		perRoute: perRoute,
+	}
+	return nil
+}
+
+func toFaultDelay(in *kgateway.FaultDelay) *commonfaultv3.FaultDelay {
+	if in == nil {
+		return nil
+	}
+	out := &commonfaultv3.FaultDelay{
+		Percentage: toFaultPercentage(in.Percentage),
+	}
+	switch {
+	case in.FixedDelay != nil:
+		out.FaultDelaySecifier = &commonfaultv3.FaultDelay_FixedDelay{
+			FixedDelay: durationpb.New(in.FixedDelay.Duration),
+		}
+	case in.FromHeader != nil:
+		out.FaultDelaySecifier = &commonfaultv3.FaultDelay_HeaderDelay_{
+			HeaderDelay: &commonfaultv3.FaultDelay_HeaderDelay{},
+		}
+	}
+	return out
+}
+
+func toFaultAbort(in *kgateway.FaultAbort) *faultv3.FaultAbort {
+	if in == nil {
+		return nil
+	}
+	out := &faultv3.FaultAbort{
+		Percentage: toFaultPercentage(in.Percentage),
+	}
+	switch {
+	case in.HTTPStatus != nil:
+		out.ErrorType = &faultv3.FaultAbort_HttpStatus{
+			HttpStatus: uint32(*in.HTTPStatus), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		}
+	case in.GRPCStatus != nil:
+		out.ErrorType = &faultv3.FaultAbort_GrpcStatus{
+			GrpcStatus: uint32(*in.GRPCStatus), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		}
+	case in.FromHeader != nil:
+		out.ErrorType = &faultv3.FaultAbort_HeaderAbort_{
+			HeaderAbort: &faultv3.FaultAbort_HeaderAbort{},
+		}
+	}
+	return out
+}
+
+// toFaultPercentage converts a percentage to a FractionalPercent, defaulting to 100% when unset.
+func toFaultPercentage(percentage *int32) *typev3.FractionalPercent {
+	return &typev3.FractionalPercent{
+		Numerator:   uint32(ptr.Deref(percentage, 100)), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		Denominator: typev3.FractionalPercent_HUNDRED,
+	}
+}
+
+func (p *trafficPolicyPluginGwPass) handleFaultInjection(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, fault *faultInjectionIR) {
+	if fault == nil {
+		return
+	}
+
+	// A nil perRoute config means the policy disables fault injection, so disable the filter for the route.
+	if fault.perRoute == nil {
+		pCtxTypedFilterConfig.AddTypedConfig(faultFilterName, DisableFilterPerRoute())
+		return
+	}
+
+	// Add fault configuration to the typed_per_filter_config for route-level override
+	pCtxTypedFilterConfig.AddTypedConfig(faultFilterName, fault.perRoute)
+
+	// Add a filter to the chain. When having a fault policy for a route we need to also have a
+	// globally disabled fault filter in the chain otherwise it will be ignored.
+	if p.faultInChain == nil {
+		p.faultInChain = make(map[string]*faultv3.HTTPFault)
+	}
+	if _, ok := p.faultInChain[fcn]; !ok {
+		p.faultInChain[fcn] = &faultv3.HTTPFault{}
+	}
+}
@@ -0,0 +1,283 @@
+package trafficpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	commonfaultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	faultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConstructFaultInjection(t *testing.T) {
+	tests := []struct {
+		name     string
+		fault    *kgateway.FaultInjection
+		expected *faultInjectionIR
+		wantErr  bool
+	}{
+		{
+			name: "nil fault injection",
+		},
+		{
+			name: "disabled fault injection",
+			fault: &kgateway.FaultInjection{
+				Disable: &shared.PolicyDisable{},
+			},
+			expected: &faultInjectionIR{},
+		},
+		{
+			name: "fixed delay with default percentage",
+			fault: &kgateway.FaultInjection{
+				Delay: &kgateway.FaultDelay{
+					FixedDelay: &metav1.Duration{Duration: 2 * time.Second},
+				},
+			},
+			expected: &faultInjectionIR{
+				perRoute: &faultv3.HTTPFault{
+					Delay: &commonfaultv3.FaultDelay{
+						FaultDelaySecifier: &commonfaultv3.FaultDelay_FixedDelay{
+							FixedDelay: durationpb.New(2 * time.Second),
+						},
+						Percentage: &typev3.FractionalPercent{
+							Numerator:   100,
+							Denominator: typev3.FractionalPercent_HUNDRED,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "header driven delay and http abort with headers",
+			fault: &kgateway.FaultInjection{
+				Delay: &kgateway.FaultDelay{
+					FromHeader: &kgateway.FaultHeaderActivation{},
+				},
+				Abort: &kgateway.FaultAbort{
+					HTTPStatus: ptr.To[int32](503),
+					Percentage: ptr.To[int32](25),
+				},
+				Headers: []gwv1.HTTPHeaderMatch{
+					{
+						Type:  ptr.To(gwv1.HeaderMatchExact),
+						Name:  "x-chaos",
+						Value: "true",
+					},
+				},
+				MaxActiveFaults: ptr.To[int32](10),
+			},
+			expected: &faultInjectionIR{
+				perRoute: &faultv3.HTTPFault{
+					Delay: &commonfaultv3.FaultDelay{
+						FaultDelaySecifier: &commonfaultv3.FaultDelay_HeaderDelay_{
+							HeaderDelay: &commonfaultv3.FaultDelay_HeaderDelay{},
+						},
+						Percentage: &typev3.FractionalPercent{
+							Numerator:   100,
+							Denominator: typev3.FractionalPercent_HUNDRED,
+						},
+					},
+					Abort: &faultv3.FaultAbort{
+						ErrorType: &faultv3.FaultAbort_HttpStatus{
+							HttpStatus: 503,
+						},
+						Percentage: &typev3.FractionalPercent{
+							Numerator:   25,
+							Denominator: typev3.FractionalPercent_HUNDRED,
+						},
+					},
+					Headers: []*envoyroutev3.HeaderMatcher{
+						{
+							Name: "x-chaos",
+							HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_StringMatch{
+								StringMatch: &envoymatcherv3.StringMatcher{
+									MatchPattern: &envoymatcherv3.StringMatcher_Exact{
+										Exact: "true",
+									},
+								},
+							},
+						},
+					},
+					MaxActiveFaults: wrapperspb.UInt32(10),
+				},
+			},
+		},
+		{
+			name: "grpc abort",
+			fault: &kgateway.FaultInjection{
+				Abort: &kgateway.FaultAbort{
+					GRPCStatus: ptr.To[int32](14),
+				},
+			},
+			expected: &faultInjectionIR{
+				perRoute: &faultv3.HTTPFault{
+					Abort: &faultv3.FaultAbort{
+						ErrorType: &faultv3.FaultAbort_GrpcStatus{
+							GrpcStatus: 14,
+						},
+						Percentage: &typev3.FractionalPercent{
+							Numerator:   100,
+							Denominator: typev3.FractionalPercent_HUNDRED,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "header driven abort",
+			fault: &kgateway.FaultInjection{
+				Abort: &kgateway.FaultAbort{
+					FromHeader: &kgateway.FaultHeaderActivation{},
+				},
+			},
+			expected: &faultInjectionIR{
+				perRoute: &faultv3.HTTPFault{
+					Abort: &faultv3.FaultAbort{
+						ErrorType: &faultv3.FaultAbort_HeaderAbort_{
+							HeaderAbort: &faultv3.FaultAbort_HeaderAbort{},
+						},
+						Percentage: &typev3.FractionalPercent{
+							Numerator:   100,
+							Denominator: typev3.FractionalPercent_HUNDRED,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "unsupported header match type",
+			fault: &kgateway.FaultInjection{
+				Abort: &kgateway.FaultAbort{
+					HTTPStatus: ptr.To[int32](500),
+				},
+				Headers: []gwv1.HTTPHeaderMatch{
+					{
+						Type:  ptr.To(gwv1.HeaderMatchType("Unknown")),
+						Name:  "x-chaos",
+						Value: "true",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &trafficPolicySpecIr{}
+			err := constructFaultInjection(kgateway.TrafficPolicySpec{
+				FaultInjection: tt.fault,
+			}, out)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equals(out.faultInjection), "expected %v, got %v", tt.expected, out.faultInjection)
+			assert.NoError(t, out.faultInjection.Validate())
+		})
+	}
+}
+
+func TestFaultInjectionIREquals(t *testing.T) {
+	createFault := func(status uint32) *faultInjectionIR {
+		return &faultInjectionIR{
+			perRoute: &faultv3.HTTPFault{
+				Abort: &faultv3.FaultAbort{
+					ErrorType: &faultv3.FaultAbort_HttpStatus{HttpStatus: status},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		fault1   *faultInjectionIR
+		fault2   *faultInjectionIR
+		expected bool
+	}{
+		{
+			name:     "both nil are equal",
+			expected: true,
+		},
+		{
+			name:     "nil vs non-nil are not equal",
+			fault2:   createFault(503),
+			expected: false,
+		},
+		{
+			name:     "same config is equal",
+			fault1:   createFault(503),
+			fault2:   createFault(503),
+			expected: true,
+		},
+		{
+			name:     "different status is not equal",
+			fault1:   createFault(503),
+			fault2:   createFault(500),
+			expected: false,
+		},
+		{
+			name:     "disabled vs enabled are not equal",
+			fault1:   &faultInjectionIR{},
+			fault2:   createFault(503),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.fault1.Equals(tt.fault2)
+			assert.Equal(t, tt.expected, result)
+
+			// Test symmetry: a.Equals(b) should equal b.Equals(a)
+			reverseResult := tt.fault2.Equals(tt.fault1)
+			assert.Equal(t, result, reverseResult, "Equals should be symmetric")
+		})
+	}
+}
+
+func TestHandleFaultInjection(t *testing.T) {
+	const fcn = "test-filter-chain"
+
+	t.Run("enabled fault adds per-route config and filter to chain", func(t *testing.T) {
+		p := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		fault := &faultInjectionIR{
+			perRoute: &faultv3.HTTPFault{
+				Abort: &faultv3.FaultAbort{
+					ErrorType: &faultv3.FaultAbort_HttpStatus{HttpStatus: 503},
+				},
+			},
+		}
+
+		p.handleFaultInjection(fcn, &typedFilterConfig, fault)
+
+		assert.True(t, proto.Equal(fault.perRoute, typedFilterConfig.GetTypedConfig(faultFilterName)))
+		assert.NotNil(t, p.faultInChain[fcn])
+	})
+
+	t.Run("disabled fault disables filter per route", func(t *testing.T) {
+		p := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+
+		p.handleFaultInjection(fcn, &typedFilterConfig, &faultInjectionIR{})
+
+		assert.True(t, proto.Equal(DisableFilterPerRoute(), typedFilterConfig.GetTypedConfig(faultFilterName)))
+		assert.Nil(t, p.faultInChain[fcn])
+	})
+}
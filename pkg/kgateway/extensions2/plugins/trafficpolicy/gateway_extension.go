@@ -41,6 +41,7 @@ type TrafficPolicyGatewayExtensionIR struct {
 	RateLimit        *ratev3.RateLimit
 	Jwt              *envoymatchingv3.ExtensionWithMatcher
 	OAuth2           *oauthPerProviderConfig
+	Wasm             *wasmProviderConfig
 	PrecedenceWeight int32
 	Err              error
 }
@@ -66,6 +67,9 @@ func (e TrafficPolicyGatewayExtensionIR) Equals(other TrafficPolicyGatewayExtens
 	if !e.OAuth2.Equals(other.OAuth2) {
 		return false
 	}
+	if !e.Wasm.Equals(other.Wasm) {
+		return false
+	}
 	if e.PrecedenceWeight != other.PrecedenceWeight {
 		return false
 	}
@@ -109,6 +113,11 @@ func (e TrafficPolicyGatewayExtensionIR) Validate() error {
 			return err
 		}
 	}
+	if e.Wasm != nil {
+		if err := e.Wasm.wasmFilter().ValidateAll(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -118,6 +127,8 @@ func TranslateGatewayExtensionBuilder(
 ) func(krtctx krt.HandlerContext, gExt ir.GatewayExtension) *TrafficPolicyGatewayExtensionIR {
 	oidcDiscoverer := newOIDCProviderConfigDiscoverer()
 	go oidcDiscoverer.refresh(ctx)
+	wasmModules := newWasmModules(ctx, commoncol, newWasmImageFetcher())
+	wasmServer := newWasmModuleServer(commoncol.Settings)
 
 	return func(krtctx krt.HandlerContext, gExt ir.GatewayExtension) *TrafficPolicyGatewayExtensionIR {
 		p := &TrafficPolicyGatewayExtensionIR{
@@ -220,6 +231,14 @@ func TranslateGatewayExtensionBuilder(
 				return p
 			}
 			p.OAuth2 = out
+
+		case gExt.Wasm != nil:
+			out, err := buildWasmProviderConfig(krtctx, &gExt, wasmModules, wasmServer)
+			if err != nil {
+				p.Err = fmt.Errorf("wasm: %w", err)
+				return p
+			}
+			p.Wasm = out
 		}
 		return p
 	}
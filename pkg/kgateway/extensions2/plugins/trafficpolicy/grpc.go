@@ -0,0 +1,278 @@
+package trafficpolicy
+
+import (
+	"fmt"
+
+	grpcjsontranscoderv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
+	grpcwebv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_web/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	grpcJSONTranscoderFilterName = "envoy.filters.http.grpc_json_transcoder"
+	grpcWebFilterName            = "envoy.filters.http.grpc_web"
+)
+
+type grpcJSONTranscoderIR struct {
+	// config is the transcoder configuration applied to the route. It is nil when the policy
+	// disables the transcoding.
+	config *grpcjsontranscoderv3.GrpcJsonTranscoder
+}
+
+var _ PolicySubIR = &grpcJSONTranscoderIR{}
+
+func (g *grpcJSONTranscoderIR) Equals(other PolicySubIR) bool {
+	otherTranscoder, ok := other.(*grpcJSONTranscoderIR)
+	if !ok {
+		return false
+	}
+	if g == nil || otherTranscoder == nil {
+		return g == nil && otherTranscoder == nil
+	}
+	return proto.Equal(g.config, otherTranscoder.config)
+}
+
+func (g *grpcJSONTranscoderIR) Validate() error {
+	if g == nil || g.config == nil {
+		return nil
+	}
+	return g.config.ValidateAll()
+}
+
+// constructGRPCJSONTranscoder constructs the gRPC-JSON transcoder policy IR from the policy specification.
+func constructGRPCJSONTranscoder(
+	krtctx krt.HandlerContext,
+	in *kgateway.TrafficPolicy,
+	configMaps *krtcollections.ConfigMapIndex,
+	secrets *krtcollections.SecretIndex,
+	out *trafficPolicySpecIr,
+) error {
+	spec := in.Spec.GRPCJSONTranscoder
+	if spec == nil {
+		return nil
+	}
+
+	if spec.Disable != nil {
+		out.grpcTranscoder = &grpcJSONTranscoderIR{}
+		return nil
+	}
+
+	if spec.DescriptorSet == nil {
+		// This shouldn't happen due to CEL validation
+		return fmt.Errorf("grpcJsonTranscoder: descriptorSet must be specified")
+	}
+	descriptorSet, err := fetchDescriptorSet(krtctx, configMaps, secrets, spec.DescriptorSet, in.Namespace)
+	if err != nil {
+		return fmt.Errorf("grpcJsonTranscoder: %w", err)
+	}
+	config, err := buildGRPCJSONTranscoder(spec, descriptorSet)
+	if err != nil {
+		return fmt.Errorf("grpcJsonTranscoder: %w", err)
+	}
+	out.grpcTranscoder = &grpcJSONTranscoderIR{config: config}
+	return nil
+}
+
+// buildGRPCJSONTranscoder returns the transcoder configuration, after validating that the services
+// and their methods are defined in the descriptor set so that errors are reported on the policy
+// instead of being rejected by Envoy.
+func buildGRPCJSONTranscoder(spec *kgateway.GRPCJSONTranscoder, descriptorSet []byte) (*grpcjsontranscoderv3.GrpcJsonTranscoder, error) {
+	if err := validateDescriptorSet(descriptorSet, spec.Services); err != nil {
+		return nil, err
+	}
+
+	out := &grpcjsontranscoderv3.GrpcJsonTranscoder{
+		DescriptorSet: &grpcjsontranscoderv3.GrpcJsonTranscoder_ProtoDescriptorBin{
+			ProtoDescriptorBin: descriptorSet,
+		},
+		Services: spec.Services,
+	}
+	if opts := spec.PrintOptions; opts != nil {
+		out.PrintOptions = &grpcjsontranscoderv3.GrpcJsonTranscoder_PrintOptions{
+			AddWhitespace:              ptr.Deref(opts.AddWhitespace, false),
+			AlwaysPrintPrimitiveFields: ptr.Deref(opts.AlwaysPrintPrimitiveFields, false),
+			AlwaysPrintEnumsAsInts:     ptr.Deref(opts.AlwaysPrintEnumsAsInts, false),
+			PreserveProtoFieldNames:    ptr.Deref(opts.PreserveProtoFieldNames, false),
+			StreamNewlineDelimited:     ptr.Deref(opts.StreamNewlineDelimited, false),
+		}
+	}
+	return out, nil
+}
+
+// validateDescriptorSet checks that the descriptor set is a valid FileDescriptorSet including
+// all its imports, and that it defines the services.
+func validateDescriptorSet(descriptorSet []byte, services []string) error {
+	fds := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(descriptorSet, fds); err != nil {
+		return fmt.Errorf("failed to parse descriptor set: %w", err)
+	}
+	// Resolving the files fails on unknown message types, e.g., for the input or output of a method,
+	// and on missing imports if the descriptor set was generated without --include_imports
+	files, err := protodesc.NewFiles(fds)
+	if err != nil {
+		return fmt.Errorf("invalid descriptor set: %w", err)
+	}
+	for _, name := range services {
+		desc, err := files.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return fmt.Errorf("service %s not found in descriptor set", name)
+		}
+		svc, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return fmt.Errorf("%s is not a service", name)
+		}
+		if svc.Methods().Len() == 0 {
+			return fmt.Errorf("service %s has no methods", name)
+		}
+	}
+	return nil
+}
+
+// fetchDescriptorSet retrieves the descriptor set from the ConfigMap or Secret referenced by the policy
+func fetchDescriptorSet(
+	krtctx krt.HandlerContext,
+	configMaps *krtcollections.ConfigMapIndex,
+	secrets *krtcollections.SecretIndex,
+	in *kgateway.ProtoDescriptorSet,
+	policyNamespace string,
+) ([]byte, error) {
+	// Use TrafficPolicy as the source for reference grants
+	from := krtcollections.From{
+		GroupKind: wellknown.TrafficPolicyGVK.GroupKind(),
+		Namespace: policyNamespace,
+	}
+
+	switch {
+	case in.ConfigMapRef != nil:
+		cm, err := configMaps.GetConfigMap(krtctx, from, gwv1.ObjectReference{
+			Kind:      "ConfigMap",
+			Name:      in.ConfigMapRef.Name,
+			Namespace: in.ConfigMapRef.Namespace,
+		})
+		if err != nil {
+			return nil, err
+		}
+		data, ok := cm.BinaryData[in.ConfigMapRef.Key]
+		if !ok {
+			data = []byte(cm.Data[in.ConfigMapRef.Key])
+		}
+		if len(data) == 0 {
+			return nil, fmt.Errorf("key %q not found or empty in configmap %s/%s", in.ConfigMapRef.Key, cm.Namespace, cm.Name)
+		}
+		return data, nil
+
+	case in.SecretRef != nil:
+		secret, err := secrets.GetSecret(krtctx, from, gwv1.SecretObjectReference{
+			Name:      in.SecretRef.Name,
+			Namespace: in.SecretRef.Namespace,
+		})
+		if err != nil {
+			return nil, err
+		}
+		data := secret.Data[in.SecretRef.Key]
+		if len(data) == 0 {
+			return nil, fmt.Errorf("key %q not found or empty in secret %s", in.SecretRef.Key, secret.ResourceName())
+		}
+		return data, nil
+
+	default:
+		// This shouldn't happen due to CEL validation
+		return nil, fmt.Errorf("either configMapRef or secretRef must be specified for the descriptor set")
+	}
+}
+
+// buildGRPCJSONTranscoderFilter returns the transcoder added to the filter chain. It does not
+// define any service, the services being defined by the per-route configuration of each route.
+func buildGRPCJSONTranscoderFilter() *grpcjsontranscoderv3.GrpcJsonTranscoder {
+	return &grpcjsontranscoderv3.GrpcJsonTranscoder{
+		DescriptorSet: &grpcjsontranscoderv3.GrpcJsonTranscoder_ProtoDescriptorBin{
+			ProtoDescriptorBin: []byte{},
+		},
+	}
+}
+
+func (p *trafficPolicyPluginGwPass) handleGRPCJSONTranscoder(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, transcoder *grpcJSONTranscoderIR) {
+	if transcoder == nil {
+		return
+	}
+
+	// A nil config means the policy disables the transcoding, so disable the filter for the route.
+	if transcoder.config == nil {
+		pCtxTypedFilterConfig.AddTypedConfig(grpcJSONTranscoderFilterName, DisableFilterPerRoute())
+		return
+	}
+
+	// Add the transcoder configuration to the typed_per_filter_config for route-level override
+	pCtxTypedFilterConfig.AddTypedConfig(grpcJSONTranscoderFilterName, transcoder.config)
+
+	// Add a disabled transcoder to the filter chain, it is enabled by the per-route configuration
+	if p.grpcJSONTranscoderInChain == nil {
+		p.grpcJSONTranscoderInChain = make(map[string]*grpcjsontranscoderv3.GrpcJsonTranscoder)
+	}
+	if _, ok := p.grpcJSONTranscoderInChain[fcn]; !ok {
+		p.grpcJSONTranscoderInChain[fcn] = buildGRPCJSONTranscoderFilter()
+	}
+}
+
+type grpcWebIR struct {
+	enabled bool
+}
+
+var _ PolicySubIR = &grpcWebIR{}
+
+func (g *grpcWebIR) Equals(other PolicySubIR) bool {
+	otherGRPCWeb, ok := other.(*grpcWebIR)
+	if !ok {
+		return false
+	}
+	if g == nil || otherGRPCWeb == nil {
+		return g == nil && otherGRPCWeb == nil
+	}
+	return g.enabled == otherGRPCWeb.enabled
+}
+
+// Validate performs validation on the gRPC-Web component. No validation is
+// needed as it's a single bool field.
+func (g *grpcWebIR) Validate() error { return nil }
+
+// constructGRPCWeb constructs the gRPC-Web policy IR from the policy specification.
+func constructGRPCWeb(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) {
+	if spec.GRPCWeb == nil {
+		return
+	}
+	out.grpcWeb = &grpcWebIR{
+		enabled: *spec.GRPCWeb,
+	}
+}
+
+func (p *trafficPolicyPluginGwPass) handleGRPCWeb(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, grpcWeb *grpcWebIR) {
+	if grpcWeb == nil {
+		return
+	}
+
+	if !grpcWeb.enabled {
+		pCtxTypedFilterConfig.AddTypedConfig(grpcWebFilterName, DisableFilterPerRoute())
+		return
+	}
+
+	pCtxTypedFilterConfig.AddTypedConfig(grpcWebFilterName, EnableFilterPerRoute())
+
+	// Add a disabled gRPC-Web filter to the filter chain, it is enabled for the routes of the policy
+	if p.grpcWebInChain == nil {
+		p.grpcWebInChain = make(map[string]*grpcwebv3.GrpcWeb)
+	}
+	if _, ok := p.grpcWebInChain[fcn]; !ok {
+		p.grpcWebInChain[fcn] = &grpcwebv3.GrpcWeb{}
+	}
+}
@@ -0,0 +1,164 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	grpcjsontranscoderv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func testDescriptorSet(t *testing.T) []byte {
+	t.Helper()
+	fds := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(healthpb.File_grpc_health_v1_health_proto),
+		},
+	}
+	out, err := proto.Marshal(fds)
+	require.NoError(t, err)
+	return out
+}
+
+func TestBuildGRPCJSONTranscoder(t *testing.T) {
+	descriptorSet := testDescriptorSet(t)
+
+	tests := []struct {
+		name          string
+		spec          *kgateway.GRPCJSONTranscoder
+		descriptorSet []byte
+		expected      *grpcjsontranscoderv3.GrpcJsonTranscoder
+		wantErr       string
+	}{
+		{
+			name: "service with print options",
+			spec: &kgateway.GRPCJSONTranscoder{
+				Services: []string{"grpc.health.v1.Health"},
+				PrintOptions: &kgateway.GRPCJSONPrintOptions{
+					AddWhitespace:           ptr.To(true),
+					PreserveProtoFieldNames: ptr.To(true),
+				},
+			},
+			descriptorSet: descriptorSet,
+			expected: &grpcjsontranscoderv3.GrpcJsonTranscoder{
+				DescriptorSet: &grpcjsontranscoderv3.GrpcJsonTranscoder_ProtoDescriptorBin{
+					ProtoDescriptorBin: descriptorSet,
+				},
+				Services: []string{"grpc.health.v1.Health"},
+				PrintOptions: &grpcjsontranscoderv3.GrpcJsonTranscoder_PrintOptions{
+					AddWhitespace:           true,
+					PreserveProtoFieldNames: true,
+				},
+			},
+		},
+		{
+			name: "unknown service",
+			spec: &kgateway.GRPCJSONTranscoder{
+				Services: []string{"grpc.health.v1.Health", "helloworld.Greeter"},
+			},
+			descriptorSet: descriptorSet,
+			wantErr:       "service helloworld.Greeter not found in descriptor set",
+		},
+		{
+			name: "message instead of service",
+			spec: &kgateway.GRPCJSONTranscoder{
+				Services: []string{"grpc.health.v1.HealthCheckRequest"},
+			},
+			descriptorSet: descriptorSet,
+			wantErr:       "grpc.health.v1.HealthCheckRequest is not a service",
+		},
+		{
+			name: "invalid descriptor set",
+			spec: &kgateway.GRPCJSONTranscoder{
+				Services: []string{"grpc.health.v1.Health"},
+			},
+			descriptorSet: []byte("not a descriptor set"),
+			wantErr:       "failed to parse descriptor set",
+		},
+		{
+			name: "descriptor set with missing import",
+			spec: &kgateway.GRPCJSONTranscoder{
+				Services: []string{"grpc.health.v1.Health"},
+			},
+			descriptorSet: func() []byte {
+				file := protodesc.ToFileDescriptorProto(healthpb.File_grpc_health_v1_health_proto)
+				file.Dependency = append(file.Dependency, "google/api/annotations.proto")
+				out, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{file}})
+				require.NoError(t, err)
+				return out
+			}(),
+			wantErr: "invalid descriptor set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := buildGRPCJSONTranscoder(tt.spec, tt.descriptorSet)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(tt.expected, out), "expected %v, got %v", tt.expected, out)
+			assert.NoError(t, out.ValidateAll())
+		})
+	}
+}
+
+func TestConstructGRPCJSONTranscoderDisabled(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	err := constructGRPCJSONTranscoder(nil, &kgateway.TrafficPolicy{
+		Spec: kgateway.TrafficPolicySpec{
+			GRPCJSONTranscoder: &kgateway.GRPCJSONTranscoder{
+				Disable: &shared.PolicyDisable{},
+			},
+		},
+	}, nil, nil, out)
+	require.NoError(t, err)
+	assert.True(t, (&grpcJSONTranscoderIR{}).Equals(out.grpcTranscoder))
+}
+
+func TestHandleGRPCJSONTranscoder(t *testing.T) {
+	config, err := buildGRPCJSONTranscoder(&kgateway.GRPCJSONTranscoder{
+		Services: []string{"grpc.health.v1.Health"},
+	}, testDescriptorSet(t))
+	require.NoError(t, err)
+	p := &trafficPolicyPluginGwPass{}
+
+	routeConfig := ir.TypedFilterConfigMap{}
+	p.handleGRPCJSONTranscoder("listener~80", &routeConfig, &grpcJSONTranscoderIR{config: config})
+	assert.Equal(t, config, routeConfig.GetTypedConfig(grpcJSONTranscoderFilterName))
+
+	// the filter of the chain is valid for Envoy without defining any service
+	chainFilter := p.grpcJSONTranscoderInChain["listener~80"]
+	require.NotNil(t, chainFilter)
+	assert.Empty(t, chainFilter.GetServices())
+	assert.NoError(t, chainFilter.ValidateAll())
+
+	disabledConfig := ir.TypedFilterConfigMap{}
+	p.handleGRPCJSONTranscoder("listener~80", &disabledConfig, &grpcJSONTranscoderIR{})
+	assert.Equal(t, DisableFilterPerRoute(), disabledConfig.GetTypedConfig(grpcJSONTranscoderFilterName))
+}
+
+func TestHandleGRPCWeb(t *testing.T) {
+	p := &trafficPolicyPluginGwPass{}
+
+	routeConfig := ir.TypedFilterConfigMap{}
+	p.handleGRPCWeb("listener~80", &routeConfig, &grpcWebIR{enabled: true})
+	assert.Equal(t, EnableFilterPerRoute(), routeConfig.GetTypedConfig(grpcWebFilterName))
+	assert.NotNil(t, p.grpcWebInChain["listener~80"])
+
+	disabledConfig := ir.TypedFilterConfigMap{}
+	p.handleGRPCWeb("listener~81", &disabledConfig, &grpcWebIR{enabled: false})
+	assert.Equal(t, DisableFilterPerRoute(), disabledConfig.GetTypedConfig(grpcWebFilterName))
+	assert.Nil(t, p.grpcWebInChain["listener~81"])
+}
@@ -0,0 +1,151 @@
+package trafficpolicy
+
+import (
+	"fmt"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	luav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	"google.golang.org/protobuf/proto"
+	"istio.io/istio/pkg/kube/krt"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const luaFilterName = "envoy.filters.http.lua"
+
+type luaIR struct {
+	// perRoute is the Lua configuration applied to the route. It is nil when the policy
+	// disables the Lua filter.
+	perRoute *luav3.LuaPerRoute
+	// script is the Lua source code, kept so that it can be compiled during validation.
+	script string
+}
+
+var _ PolicySubIR = &luaIR{}
+
+func (l *luaIR) Equals(other PolicySubIR) bool {
+	otherLua, ok := other.(*luaIR)
+	if !ok {
+		return false
+	}
+	if l == nil || otherLua == nil {
+		return l == nil && otherLua == nil
+	}
+	return proto.Equal(l.perRoute, otherLua.perRoute)
+}
+
+func (l *luaIR) Validate() error {
+	if l == nil || l.perRoute == nil {
+		return nil
+	}
+	if err := l.perRoute.ValidateAll(); err != nil {
+		return err
+	}
+	return validateLuaScript(l.script)
+}
+
+// constructLua constructs the Lua policy IR from the policy specification.
+func constructLua(
+	krtctx krt.HandlerContext,
+	in *kgateway.TrafficPolicy,
+	out *trafficPolicySpecIr,
+	configMaps *krtcollections.ConfigMapIndex,
+) error {
+	spec := in.Spec.Lua
+	if spec == nil {
+		return nil
+	}
+
+	if spec.Disable != nil {
+		out.lua = &luaIR{}
+		return nil
+	}
+
+	var script string
+	switch {
+	case spec.Inline != nil:
+		script = *spec.Inline
+	case spec.ConfigMapRef != nil:
+		var err error
+		script, err = fetchConfigMapValue(krtctx, configMaps, spec.ConfigMapRef, in.Namespace)
+		if err != nil {
+			return fmt.Errorf("lua: %w", err)
+		}
+	default:
+		// This shouldn't happen due to CEL validation
+		return fmt.Errorf("lua: either inline or configMapRef must be specified")
+	}
+
+	out.lua = &luaIR{
+		perRoute: &luav3.LuaPerRoute{
+			Override: &luav3.LuaPerRoute_SourceCode{
+				SourceCode: &envoycorev3.DataSource{
+					Specifier: &envoycorev3.DataSource_InlineString{
+						InlineString: script,
+					},
+				},
+			},
+		},
+		script: script,
+	}
+	return nil
+}
+
+// fetchConfigMapValue retrieves the value of a Kubernetes ConfigMap key, e.g., the Lua source code of a script
+func fetchConfigMapValue(
+	krtctx krt.HandlerContext,
+	configMaps *krtcollections.ConfigMapIndex,
+	configMapRef *kgateway.ConfigMapKeyReference,
+	policyNamespace string,
+) (string, error) {
+	// Use TrafficPolicy as the source for reference grants
+	from := krtcollections.From{
+		GroupKind: wellknown.TrafficPolicyGVK.GroupKind(),
+		Namespace: policyNamespace,
+	}
+	cm, err := configMaps.GetConfigMap(krtctx, from, gwv1.ObjectReference{
+		Kind:      "ConfigMap",
+		Name:      configMapRef.Name,
+		Namespace: configMapRef.Namespace,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	script, ok := cm.Data[configMapRef.Key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found in configmap %s/%s", configMapRef.Key, cm.Namespace, cm.Name)
+	}
+	if script == "" {
+		return "", fmt.Errorf("key %q in configmap %s/%s is empty", configMapRef.Key, cm.Namespace, cm.Name)
+	}
+	return script, nil
+}
+
+func (p *trafficPolicyPluginGwPass) handleLua(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, lua *luaIR) {
+	if lua == nil {
+		return
+	}
+
+	// A nil perRoute config means the policy disables the Lua filter, so disable the filter for the route.
+	if lua.perRoute == nil {
+		pCtxTypedFilterConfig.AddTypedConfig(luaFilterName, DisableFilterPerRoute())
+		return
+	}
+
+	// Add Lua configuration to the typed_per_filter_config for route-level override
+	pCtxTypedFilterConfig.AddTypedConfig(luaFilterName, lua.perRoute)
+
+	// Add a filter to the chain. When having a Lua policy for a route we need to also have a
+	// globally disabled Lua filter in the chain otherwise it will be ignored.
+	if p.luaInChain == nil {
+		p.luaInChain = make(map[string]*luav3.Lua)
+	}
+	if _, ok := p.luaInChain[fcn]; !ok {
+		p.luaInChain[fcn] = &luav3.Lua{}
+	}
+}
@@ -0,0 +1,155 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	luav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const testLuaScript = `function envoy_on_request(request_handle)
+  request_handle:headers():add("x-lua", "true")
+end`
+
+func luaPerRouteFor(script string) *luav3.LuaPerRoute {
+	return &luav3.LuaPerRoute{
+		Override: &luav3.LuaPerRoute_SourceCode{
+			SourceCode: &envoycorev3.DataSource{
+				Specifier: &envoycorev3.DataSource_InlineString{
+					InlineString: script,
+				},
+			},
+		},
+	}
+}
+
+func TestConstructLua(t *testing.T) {
+	tests := []struct {
+		name        string
+		lua         *kgateway.Lua
+		expected    *luaIR
+		validateErr string
+	}{
+		{
+			name: "nil lua",
+		},
+		{
+			name: "disabled lua",
+			lua: &kgateway.Lua{
+				Disable: &shared.PolicyDisable{},
+			},
+			expected: &luaIR{},
+		},
+		{
+			name: "inline script",
+			lua: &kgateway.Lua{
+				Inline: ptr.To(testLuaScript),
+			},
+			expected: &luaIR{
+				perRoute: luaPerRouteFor(testLuaScript),
+				script:   testLuaScript,
+			},
+		},
+		{
+			name: "inline script that does not compile",
+			lua: &kgateway.Lua{
+				Inline: ptr.To("function envoy_on_request(request_handle"),
+			},
+			expected: &luaIR{
+				perRoute: luaPerRouteFor("function envoy_on_request(request_handle"),
+				script:   "function envoy_on_request(request_handle",
+			},
+			validateErr: "lua: failed to parse script",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &trafficPolicySpecIr{}
+			err := constructLua(nil, &kgateway.TrafficPolicy{
+				Spec: kgateway.TrafficPolicySpec{
+					Lua: tt.lua,
+				},
+			}, out, nil)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equals(out.lua), "expected %v, got %v", tt.expected, out.lua)
+
+			err = out.lua.Validate()
+			if tt.validateErr != "" {
+				require.ErrorContains(t, err, tt.validateErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateLuaScript(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		wantErr bool
+	}{
+		{
+			name:   "request and response handlers",
+			script: testLuaScript + "\nfunction envoy_on_response(response_handle)\n  response_handle:headers():remove(\"server\")\nend",
+		},
+		{
+			name:    "unterminated function",
+			script:  "function envoy_on_request(request_handle)\n  request_handle:logInfo(\"hi\")",
+			wantErr: true,
+		},
+		{
+			name:    "invalid token",
+			script:  "function envoy_on_request(request_handle) @ end",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLuaScript(tt.script)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestHandleLua(t *testing.T) {
+	const fcn = "test-filter-chain"
+
+	t.Run("enabled lua adds per-route config and filter to chain", func(t *testing.T) {
+		p := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+		lua := &luaIR{
+			perRoute: luaPerRouteFor(testLuaScript),
+			script:   testLuaScript,
+		}
+
+		p.handleLua(fcn, &typedFilterConfig, lua)
+
+		assert.True(t, proto.Equal(lua.perRoute, typedFilterConfig.GetTypedConfig(luaFilterName)))
+		assert.NotNil(t, p.luaInChain[fcn])
+	})
+
+	t.Run("disabled lua disables filter per route", func(t *testing.T) {
+		p := &trafficPolicyPluginGwPass{}
+		typedFilterConfig := ir.TypedFilterConfigMap{}
+
+		p.handleLua(fcn, &typedFilterConfig, &luaIR{})
+
+		assert.True(t, proto.Equal(DisableFilterPerRoute(), typedFilterConfig.GetTypedConfig(luaFilterName)))
+		assert.Nil(t, p.luaInChain[fcn])
+	})
+}
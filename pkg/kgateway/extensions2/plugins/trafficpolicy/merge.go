@@ -63,6 +63,15 @@ func MergeTrafficPolicies(
 		mergeURLRewrite,
 		mergeAPIKeyAuth,
 		mergeOAuth,
+		mergeFaultInjection,
+		mergeLua,
+		mergeWasm,
+		mergeCache,
+		mergeMirror,
+		mergeWAF,
+		mergeGRPCJSONTranscoder,
+		mergeGRPCWeb,
+		mergeCustomResponse,
 	}
 
 	for _, mergeFunc := range mergeFuncs {
@@ -565,6 +574,141 @@ func mergeBuffer(
 	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "buffer")
 }
 
+func mergeFaultInjection(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[faultInjectionIR]{
+		Get: func(spec *trafficPolicySpecIr) *faultInjectionIR { return spec.faultInjection },
+		Set: func(spec *trafficPolicySpecIr, val *faultInjectionIR) { spec.faultInjection = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "faultInjection")
+}
+
+func mergeLua(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[luaIR]{
+		Get: func(spec *trafficPolicySpecIr) *luaIR { return spec.lua },
+		Set: func(spec *trafficPolicySpecIr, val *luaIR) { spec.lua = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "lua")
+}
+
+func mergeWasm(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[wasmIR]{
+		Get: func(spec *trafficPolicySpecIr) *wasmIR { return spec.wasm },
+		Set: func(spec *trafficPolicySpecIr, val *wasmIR) { spec.wasm = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "wasm")
+}
+
+func mergeCache(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[cacheIR]{
+		Get: func(spec *trafficPolicySpecIr) *cacheIR { return spec.cache },
+		Set: func(spec *trafficPolicySpecIr, val *cacheIR) { spec.cache = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "cache")
+}
+
+func mergeMirror(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[mirrorIR]{
+		Get: func(spec *trafficPolicySpecIr) *mirrorIR { return spec.mirror },
+		Set: func(spec *trafficPolicySpecIr, val *mirrorIR) { spec.mirror = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "mirror")
+}
+
+func mergeWAF(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[wafIR]{
+		Get: func(spec *trafficPolicySpecIr) *wafIR { return spec.waf },
+		Set: func(spec *trafficPolicySpecIr, val *wafIR) { spec.waf = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "waf")
+}
+
+func mergeGRPCJSONTranscoder(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[grpcJSONTranscoderIR]{
+		Get: func(spec *trafficPolicySpecIr) *grpcJSONTranscoderIR { return spec.grpcTranscoder },
+		Set: func(spec *trafficPolicySpecIr, val *grpcJSONTranscoderIR) { spec.grpcTranscoder = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "grpcJsonTranscoder")
+}
+
+func mergeGRPCWeb(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[grpcWebIR]{
+		Get: func(spec *trafficPolicySpecIr) *grpcWebIR { return spec.grpcWeb },
+		Set: func(spec *trafficPolicySpecIr, val *grpcWebIR) { spec.grpcWeb = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "grpcWeb")
+}
+
+func mergeCustomResponse(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[customResponseIR]{
+		Get: func(spec *trafficPolicySpecIr) *customResponseIR { return spec.customResponse },
+		Set: func(spec *trafficPolicySpecIr, val *customResponseIR) { spec.customResponse = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "customResponse")
+}
+
 func mergeAutoHostRewrite(
 	p1, p2 *TrafficPolicy,
 	p2Ref *ir.AttachedPolicyRef,
@@ -0,0 +1,140 @@
+package trafficpolicy
+
+import (
+	"fmt"
+	"slices"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/policy"
+)
+
+type mirrorIR struct {
+	// policies are the request mirror policies. They are empty when the policy disables mirroring.
+	policies []*envoyroutev3.RouteAction_RequestMirrorPolicy
+}
+
+var _ PolicySubIR = &mirrorIR{}
+
+func (m *mirrorIR) Equals(other PolicySubIR) bool {
+	otherMirror, ok := other.(*mirrorIR)
+	if !ok {
+		return false
+	}
+	if m == nil || otherMirror == nil {
+		return m == nil && otherMirror == nil
+	}
+	return slices.EqualFunc(m.policies, otherMirror.policies, func(a, b *envoyroutev3.RouteAction_RequestMirrorPolicy) bool {
+		return proto.Equal(a, b)
+	})
+}
+
+func (m *mirrorIR) Validate() error {
+	if m == nil {
+		return nil
+	}
+	for _, p := range m.policies {
+		if err := p.ValidateAll(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// constructMirror constructs the request mirror policy IR from the policy specification.
+func constructMirror(
+	krtctx krt.HandlerContext,
+	policyCR *kgateway.TrafficPolicy,
+	backends *krtcollections.BackendIndex,
+	out *trafficPolicySpecIr,
+) error {
+	spec := policyCR.Spec.Mirror
+	if spec == nil {
+		return nil
+	}
+	if spec.Disable != nil {
+		out.mirror = &mirrorIR{}
+		return nil
+	}
+
+	policySrc := ir.ObjectSource{
+		Group:     wellknown.TrafficPolicyGVK.Group,
+		Kind:      wellknown.TrafficPolicyGVK.Kind,
+		Namespace: policyCR.Namespace,
+		Name:      policyCR.Name,
+	}
+	mirror := &mirrorIR{}
+	for _, target := range spec.Targets {
+		backend, err := backends.GetBackendFromRef(krtctx, policySrc, target.BackendRef)
+		if err != nil {
+			return fmt.Errorf("mirror: failed to resolve backend %s: %w", target.BackendRef.Name, err)
+		}
+
+		runtimeFraction := policy.BuildMirrorRuntimeFraction(target.Percent, target.Fraction)
+		if target.RuntimeKey != nil {
+			if runtimeFraction == nil {
+				runtimeFraction = policy.BuildMirrorRuntimeFraction(new(int32(100)), nil)
+			}
+			runtimeFraction.RuntimeKey = *target.RuntimeKey
+		}
+		mirrorPolicy := &envoyroutev3.RouteAction_RequestMirrorPolicy{
+			Cluster:         backend.ClusterName(),
+			RuntimeFraction: runtimeFraction,
+		}
+		if target.TraceSampled != nil {
+			mirrorPolicy.TraceSampled = wrapperspb.Bool(*target.TraceSampled)
+		}
+		mirror.policies = append(mirror.policies, mirrorPolicy)
+	}
+	out.mirror = mirror
+	return nil
+}
+
+// applyRouteMirror applies the mirror policies of a policy attached to a route.
+// Mirrors configured by HTTPRoute RequestMirror filters are kept, as mirrors are cumulative.
+func (p *trafficPolicyPluginGwPass) applyRouteMirror(mirror *mirrorIR, out *envoyroutev3.Route) {
+	if mirror == nil {
+		return
+	}
+	if len(mirror.policies) == 0 {
+		p.markMirrorDisabled(out.GetName())
+		return
+	}
+	action := out.GetRoute()
+	action.RequestMirrorPolicies = append(action.GetRequestMirrorPolicies(), mirror.policies...)
+}
+
+// applyMirrorToRoutes applies the mirror policies of a policy attached to a virtual host or route
+// configuration to their routes. Routes are translated before the policies attached at higher levels
+// of the config hierarchy are applied, so routes that already mirror requests, or that disable
+// mirroring, are skipped for the most specific configuration to take precedence.
+func (p *trafficPolicyPluginGwPass) applyMirrorToRoutes(mirror *mirrorIR, routes []*envoyroutev3.Route) {
+	if mirror == nil {
+		return
+	}
+	for _, route := range routes {
+		action := route.GetRoute()
+		if action == nil || len(action.GetRequestMirrorPolicies()) > 0 || p.mirrorDisabled[route.GetName()] {
+			continue
+		}
+		if len(mirror.policies) == 0 {
+			p.markMirrorDisabled(route.GetName())
+			continue
+		}
+		action.RequestMirrorPolicies = slices.Clone(mirror.policies)
+	}
+}
+
+func (p *trafficPolicyPluginGwPass) markMirrorDisabled(routeName string) {
+	if p.mirrorDisabled == nil {
+		p.mirrorDisabled = make(map[string]bool)
+	}
+	p.mirrorDisabled[routeName] = true
+}
@@ -0,0 +1,81 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestMirrorIREquals(t *testing.T) {
+	newMirror := func(clusters ...string) *mirrorIR {
+		m := &mirrorIR{}
+		for _, c := range clusters {
+			m.policies = append(m.policies, &envoyroutev3.RouteAction_RequestMirrorPolicy{Cluster: c})
+		}
+		return m
+	}
+
+	assert.True(t, newMirror("a", "b").Equals(newMirror("a", "b")))
+	assert.False(t, newMirror("a", "b").Equals(newMirror("b", "a")))
+	assert.False(t, newMirror("a").Equals(newMirror()))
+	assert.True(t, (*mirrorIR)(nil).Equals((*mirrorIR)(nil)))
+	assert.False(t, newMirror().Equals((*mirrorIR)(nil)))
+
+	traceSampled := newMirror("a")
+	traceSampled.policies[0].TraceSampled = wrapperspb.Bool(false)
+	assert.False(t, newMirror("a").Equals(traceSampled))
+}
+
+func TestApplyMirror(t *testing.T) {
+	newRoute := func(name string, mirrors ...string) *envoyroutev3.Route {
+		action := &envoyroutev3.RouteAction{}
+		for _, c := range mirrors {
+			action.RequestMirrorPolicies = append(action.RequestMirrorPolicies, &envoyroutev3.RouteAction_RequestMirrorPolicy{Cluster: c})
+		}
+		return &envoyroutev3.Route{
+			Name:   name,
+			Action: &envoyroutev3.Route_Route{Route: action},
+		}
+	}
+	mirrorClusters := func(route *envoyroutev3.Route) []string {
+		var clusters []string
+		for _, m := range route.GetRoute().GetRequestMirrorPolicies() {
+			clusters = append(clusters, m.GetCluster())
+		}
+		return clusters
+	}
+	mirrorTo := func(cluster string) *mirrorIR {
+		return &mirrorIR{policies: []*envoyroutev3.RouteAction_RequestMirrorPolicy{{Cluster: cluster}}}
+	}
+
+	p := &trafficPolicyPluginGwPass{}
+
+	// route level policies are applied first
+	routeMirror := newRoute("route-mirror", "filter")
+	p.applyRouteMirror(mirrorTo("route"), routeMirror)
+	routeDisable := newRoute("route-disable")
+	p.applyRouteMirror(&mirrorIR{}, routeDisable)
+	assert.Equal(t, []string{"filter", "route"}, mirrorClusters(routeMirror))
+	assert.Empty(t, mirrorClusters(routeDisable))
+
+	// followed by virtual host level policies
+	vhostDisable := newRoute("vhost-disable")
+	vhostMirror := newRoute("vhost-mirror")
+	p.applyMirrorToRoutes(&mirrorIR{}, []*envoyroutev3.Route{vhostDisable})
+	p.applyMirrorToRoutes(mirrorTo("vhost"), []*envoyroutev3.Route{routeMirror, routeDisable, vhostMirror})
+	assert.Equal(t, []string{"vhost"}, mirrorClusters(vhostMirror))
+
+	// and finally route configuration level policies
+	unset := newRoute("unset")
+	redirect := &envoyroutev3.Route{Name: "redirect", Action: &envoyroutev3.Route_Redirect{}}
+	p.applyMirrorToRoutes(mirrorTo("gateway"), []*envoyroutev3.Route{routeMirror, routeDisable, vhostDisable, vhostMirror, unset, redirect})
+
+	assert.Equal(t, []string{"filter", "route"}, mirrorClusters(routeMirror))
+	assert.Empty(t, mirrorClusters(routeDisable))
+	assert.Empty(t, mirrorClusters(vhostDisable))
+	assert.Equal(t, []string{"vhost"}, mirrorClusters(vhostMirror))
+	assert.Equal(t, []string{"gateway"}, mirrorClusters(unset))
+	assert.Nil(t, redirect.GetRoute())
+}
@@ -16,6 +16,7 @@ var (
 
 type retryIR struct {
 	policy *envoyroutev3.RetryPolicy
+	hedge  *envoyroutev3.HedgePolicy
 }
 
 func (a *retryIR) Equals(other PolicySubIR) bool {
@@ -26,14 +27,23 @@ func (a *retryIR) Equals(other PolicySubIR) bool {
 	if a == nil || b == nil {
 		return a == nil && b == nil
 	}
-	return proto.Equal(a.policy, b.policy)
+	return proto.Equal(a.policy, b.policy) &&
+		proto.Equal(a.hedge, b.hedge)
 }
 
 func (a *retryIR) Validate() error {
-	if a == nil || a.policy == nil {
+	if a == nil {
 		return nil
 	}
-	return a.policy.Validate()
+	if a.policy != nil {
+		if err := a.policy.Validate(); err != nil {
+			return err
+		}
+	}
+	if a.hedge != nil {
+		return a.hedge.Validate()
+	}
+	return nil
 }
 
 type timeoutsIR struct {
@@ -74,6 +84,7 @@ func constructTimeoutRetry(
 	if spec.Retry != nil {
 		out.retry = &retryIR{
 			policy: policy.BuildRetryPolicy(spec.Retry),
+			hedge:  policy.BuildHedgePolicy(spec.Retry),
 		}
 	}
 }
@@ -2,9 +2,13 @@ package trafficpolicy
 
 import (
 	"context"
+	"maps"
+	"slices"
 	"time"
 
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
 	exteniondynamicmodulev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/dynamic_modules/v3"
 	envoy_api_key_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/api_key_auth/v3"
 	envoy_basic_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/basic_auth/v3"
@@ -12,10 +16,15 @@ import (
 	compressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
 	corsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
 	envoy_csrf_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/csrf/v3"
+	customresponsev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/custom_response/v3"
 	decompressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/decompressor/v3"
 	dynamicmodulesv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/dynamic_modules/v3"
+	faultv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	grpcjsontranscoderv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
+	grpcwebv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_web/v3"
 	header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
 	localratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	luav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
 	envoyrbacv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
@@ -105,6 +114,15 @@ type trafficPolicySpecIr struct {
 	urlRewrite      *urlRewriteIR
 	apiKeyAuth      *apiKeyAuthIR
 	oauth2          *oauthIR
+	faultInjection  *faultInjectionIR
+	lua             *luaIR
+	wasm            *wasmIR
+	cache           *cacheIR
+	mirror          *mirrorIR
+	waf             *wafIR
+	grpcTranscoder  *grpcJSONTranscoderIR
+	grpcWeb         *grpcWebIR
+	customResponse  *customResponseIR
 }
 
 func (d *TrafficPolicy) CreationTime() time.Time {
@@ -183,6 +201,33 @@ func (d *TrafficPolicy) Equals(in any) bool {
 	if !d.spec.oauth2.Equals(d2.spec.oauth2) {
 		return false
 	}
+	if !d.spec.faultInjection.Equals(d2.spec.faultInjection) {
+		return false
+	}
+	if !d.spec.lua.Equals(d2.spec.lua) {
+		return false
+	}
+	if !d.spec.wasm.Equals(d2.spec.wasm) {
+		return false
+	}
+	if !d.spec.cache.Equals(d2.spec.cache) {
+		return false
+	}
+	if !d.spec.mirror.Equals(d2.spec.mirror) {
+		return false
+	}
+	if !d.spec.waf.Equals(d2.spec.waf) {
+		return false
+	}
+	if !d.spec.grpcTranscoder.Equals(d2.spec.grpcTranscoder) {
+		return false
+	}
+	if !d.spec.grpcWeb.Equals(d2.spec.grpcWeb) {
+		return false
+	}
+	if !d.spec.customResponse.Equals(d2.spec.customResponse) {
+		return false
+	}
 	return true
 }
 
@@ -210,6 +255,15 @@ func (p *TrafficPolicy) Validate() error {
 	validators = append(validators, p.spec.urlRewrite.Validate)
 	validators = append(validators, p.spec.apiKeyAuth.Validate)
 	validators = append(validators, p.spec.oauth2.Validate)
+	validators = append(validators, p.spec.faultInjection.Validate)
+	validators = append(validators, p.spec.lua.Validate)
+	validators = append(validators, p.spec.wasm.Validate)
+	validators = append(validators, p.spec.cache.Validate)
+	validators = append(validators, p.spec.mirror.Validate)
+	validators = append(validators, p.spec.waf.Validate)
+	validators = append(validators, p.spec.grpcTranscoder.Validate)
+	validators = append(validators, p.spec.grpcWeb.Validate)
+	validators = append(validators, p.spec.customResponse.Validate)
 	for _, validator := range validators {
 		if err := validator(); err != nil {
 			return err
@@ -239,8 +293,24 @@ type trafficPolicyPluginGwPass struct {
 	decompressorInChain      map[string]*decompressorv3.Decompressor
 	basicAuthInChain         map[string]*envoy_basic_auth_v3.BasicAuth
 	apiKeyAuthInChain        map[string]*envoy_api_key_auth_v3.ApiKeyAuth
+	faultInChain             map[string]*faultv3.HTTPFault
+	luaInChain               map[string]*luav3.Lua
+	// maps filter chain name -> GatewayExtension name -> Wasm provider enabled on routes of the filter chain
+	wasmInChain  map[string]map[string]*TrafficPolicyGatewayExtensionIR
+	cacheInChain map[string]*envoymatchingv3.ExtensionWithMatcher
+	wafInChain   map[string]*envoymatchingv3.ExtensionWithMatcher
+	// gRPC filters are enabled on routes using typed_per_filter_config
+	grpcJSONTranscoderInChain map[string]*grpcjsontranscoderv3.GrpcJsonTranscoder
+	grpcWebInChain            map[string]*grpcwebv3.GrpcWeb
+	customResponseInChain     map[string]*customresponsev3.CustomResponse
+	// names of the routes that disable request mirroring, so that mirrors attached at higher levels
+	// of the config hierarchy are not applied to them
+	mirrorDisabled map[string]bool
 	// maps secret name to secret in case the same secret is referenced in multiple attachment points (e.g., vhost and route)
 	secrets map[string]*envoytlsv3.Secret
+	// wasmModulesCluster is the cluster the Wasm modules served by the controller are fetched from,
+	// set when a Wasm filter of the gateway uses one of these modules
+	wasmModulesCluster *envoyclusterv3.Cluster
 }
 
 var _ ir.ProxyTranslationPass = &trafficPolicyPluginGwPass{}
@@ -361,6 +431,9 @@ func (p *trafficPolicyPluginGwPass) ApplyRouteConfigPlugin(
 		return
 	}
 
+	for _, vhost := range out.GetVirtualHosts() {
+		p.applyMirrorToRoutes(policy.spec.mirror, vhost.GetRoutes())
+	}
 	p.handlePolicies(pCtx.FilterChainName, &pCtx.TypedFilterConfig, policy.spec)
 }
 
@@ -541,6 +614,81 @@ func (p *trafficPolicyPluginGwPass) HttpFilters(_ ir.HttpFiltersContext, fcc ir.
 		stagedFilters = append(stagedFilters, stagedJwtFilter)
 	}
 
+	// Add fault filter to enable fault injection for the listener.
+	// Requires the fault policy to be set as typed_per_filter_config.
+	if f := p.faultInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(faultFilterName, f, filters.DuringStage(filters.FaultStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add Lua filter to enable Lua scripts for the listener.
+	// Requires the Lua policy to be set as typed_per_filter_config.
+	if f := p.luaInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(luaFilterName, f, filters.DuringStage(filters.AcceptedStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add Wasm filters for the providers enabled on routes of the listener.
+	// Requires the filters to be enabled using typed_per_filter_config.
+	if len(p.wasmInChain[fcc.FilterChainName]) > 0 {
+		stagedFilters = AddDisableFilterIfNeeded(stagedFilters, wasmGlobalDisableFilterName, wasmGlobalDisableFilterMetadataNamespace)
+	}
+	for _, name := range slices.Sorted(maps.Keys(p.wasmInChain[fcc.FilterChainName])) {
+		provider := p.wasmInChain[fcc.FilterChainName][name]
+		filter := filters.MustNewStagedFilterWithWeight(
+			wasmFilterName(name),
+			provider.Wasm.filter(),
+			provider.Wasm.stage,
+			provider.PrecedenceWeight,
+		)
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add the custom response filter, it is enabled with the custom response configuration of each route
+	// using typed_per_filter_config. The filter replaces the local responses of the filters after it in
+	// the filter chain, e.g., the responses of the WAF, ext_auth and rate limit filters. It is added after
+	// the CORS filter so that CORS headers are added to the custom responses.
+	if f := p.customResponseInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(customResponseFilterName, f, filters.BeforeStage(filters.WafStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add the WAF filter, it is enabled with the WAF configuration of each route
+	// using typed_per_filter_config.
+	if f := p.wafInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(wafFilterName, f, filters.DuringStage(filters.WafStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add the gRPC-Web filter, it is enabled on the routes of the policies enabling gRPC-Web
+	// using typed_per_filter_config.
+	if f := p.grpcWebInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(grpcWebFilterName, f, filters.BeforeStage(filters.AuthNStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add the gRPC-JSON transcoder filter, it is enabled with the transcoder configuration of each route
+	// using typed_per_filter_config.
+	if f := p.grpcJSONTranscoderInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(grpcJSONTranscoderFilterName, f, filters.BeforeStage(filters.OutAuthStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add the cache filter, it is enabled with the cache configuration of each route
+	// using typed_per_filter_config.
+	if f := p.cacheInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(cacheFilterName, f, filters.DuringStage(filters.CacheStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
 	if f := p.localRateLimitInChain[fcc.FilterChainName]; f != nil {
 		filter := filters.MustNewStagedFilter(localRateLimitFilterNamePrefix, f, filters.DuringStage(filters.RateLimitStage))
 		filter.Filter.Disabled = true
@@ -627,6 +775,9 @@ func (p *trafficPolicyPluginGwPass) ResourcesToAdd() ir.Resources {
 	for _, secret := range p.secrets {
 		resources.Secrets = append(resources.Secrets, secret)
 	}
+	if p.wasmModulesCluster != nil {
+		resources.Clusters = append(resources.Clusters, p.wasmModulesCluster)
+	}
 	return resources
 }
 
@@ -661,6 +812,14 @@ func (p *trafficPolicyPluginGwPass) handlePolicies(
 	p.handleBasicAuth(fcn, typedFilterConfig, spec.basicAuth)
 	p.handleAPIKeyAuth(fcn, typedFilterConfig, spec.apiKeyAuth)
 	p.handleOauth2(fcn, typedFilterConfig, spec.oauth2)
+	p.handleFaultInjection(fcn, typedFilterConfig, spec.faultInjection)
+	p.handleLua(fcn, typedFilterConfig, spec.lua)
+	p.handleWasm(fcn, typedFilterConfig, spec.wasm)
+	p.handleCache(fcn, typedFilterConfig, spec.cache)
+	p.handleWAF(fcn, typedFilterConfig, spec.waf)
+	p.handleGRPCJSONTranscoder(fcn, typedFilterConfig, spec.grpcTranscoder)
+	p.handleGRPCWeb(fcn, typedFilterConfig, spec.grpcWeb)
+	p.handleCustomResponse(fcn, typedFilterConfig, spec.customResponse)
 }
 
 // handlePerRoutePolicies handles policies that are meant to be processed at the route level
@@ -695,13 +854,20 @@ func (p *trafficPolicyPluginGwPass) handlePerRoutePolicies(
 	}
 
 	// Only set the retry policy if it is not already set, which implies that it was
-	// set by the builtin HTTPRouteRetry policy
+	// set by the builtin HTTPRouteRetry policy. The hedge policy relies on the per-try
+	// timeout of the TrafficPolicy retry, so it only applies along with that retry policy.
 	if action.GetRetryPolicy() == nil && spec.retry != nil {
 		action.RetryPolicy = spec.retry.policy
+		if action.GetHedgePolicy() == nil {
+			action.HedgePolicy = spec.retry.hedge
+		}
 	}
 
 	// Apply URL rewrite configuration
 	applyURLRewrite(spec.urlRewrite, out)
+
+	// Apply request mirror configuration
+	p.applyRouteMirror(spec.mirror, out)
 }
 
 // handlePerVHostPolicies handles policies that are meant to be processed at the vhost level
@@ -711,7 +877,10 @@ func (p *trafficPolicyPluginGwPass) handlePerVHostPolicies(
 ) {
 	if spec.retry != nil {
 		out.RetryPolicy = spec.retry.policy
+		out.HedgePolicy = spec.retry.hedge
 	}
+
+	p.applyMirrorToRoutes(spec.mirror, out.GetRoutes())
 }
 
 func (p *trafficPolicyPluginGwPass) SupportsPolicyMerge() bool {
@@ -3,7 +3,10 @@ package trafficpolicy
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	lua "github.com/yuin/gopher-lua"
+	luaparse "github.com/yuin/gopher-lua/parse"
 	"google.golang.org/protobuf/proto"
 
 	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
@@ -75,3 +78,19 @@ func validateXDS(ctx context.Context, p *TrafficPolicy, v validator.Validator) e
 	// shell out to envoy to validate the partial bootstrap config.
 	return v.Validate(ctx, bootstrapCfg)
 }
+
+// luaChunkName is the name used to identify the script in Lua compile errors.
+const luaChunkName = "lua"
+
+// validateLuaScript compiles the given Lua script so that syntax errors are reported on the policy
+// status instead of being rejected by Envoy. It does not execute the script.
+func validateLuaScript(script string) error {
+	chunk, err := luaparse.Parse(strings.NewReader(script), luaChunkName)
+	if err != nil {
+		return fmt.Errorf("lua: failed to parse script: %w", err)
+	}
+	if _, err := lua.Compile(chunk, luaChunkName); err != nil {
+		return fmt.Errorf("lua: failed to compile script: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package trafficpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	xdscorev3 "github.com/cncf/xds/go/xds/core/v3"
+	xdsmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	envoycompositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	wasmfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	wafFilterName = "kgateway.waf"
+	// wafCompositeName is the name of the composite filter that executes the WAF filter
+	// configured for a route
+	wafCompositeName = "composite_waf"
+	// wafVMIDPrefix prefixes the ID of the Wasm VM running the WAF module of a provider. The WAF
+	// filters of all the routes use the same VM, instead of a VM per route, and are only passed the
+	// directives of their route.
+	wafVMIDPrefix = "kgateway.waf/"
+	// wafDirectivesName is the name of the directives of the WAF module configuration
+	wafDirectivesName = "default"
+)
+
+// wafPluginConfig is the configuration of the Coraza-based Wasm module, in the format of coraza-proxy-wasm.
+type wafPluginConfig struct {
+	DirectivesMap     map[string][]string `json:"directives_map"`
+	DefaultDirectives string              `json:"default_directives"`
+}
+
+type wafIR struct {
+	// provider is the GatewayExtension of type Wasm providing the WAF module.
+	// It is nil when the policy disables the WAF.
+	provider *TrafficPolicyGatewayExtensionIR
+	// directives are the SecLang directives configuring the WAF module
+	directives []string
+}
+
+var _ PolicySubIR = &wafIR{}
+
+func (w *wafIR) Equals(other PolicySubIR) bool {
+	otherWAF, ok := other.(*wafIR)
+	if !ok {
+		return false
+	}
+	if w == nil || otherWAF == nil {
+		return w == nil && otherWAF == nil
+	}
+	if w.provider == nil || otherWAF.provider == nil {
+		if w.provider != nil || otherWAF.provider != nil {
+			return false
+		}
+	} else if w.provider.Name != otherWAF.provider.Name || !w.provider.Equals(*otherWAF.provider) {
+		return false
+	}
+	return slices.Equal(w.directives, otherWAF.directives)
+}
+
+func (w *wafIR) Validate() error {
+	if w == nil || w.provider == nil {
+		return nil
+	}
+	if err := w.provider.Validate(); err != nil {
+		return err
+	}
+	return w.filter().ValidateAll()
+}
+
+// filter returns the Wasm filter running the WAF module with the directives of the policy.
+// The module runs in the VM shared by the WAF filters of the provider, so that it is loaded once
+// rather than for every route.
+func (w *wafIR) filter() *wasmfilterv3.Wasm {
+	cfg, _ := json.Marshal(wafPluginConfig{
+		DirectivesMap:     map[string][]string{wafDirectivesName: w.directives},
+		DefaultDirectives: wafDirectivesName,
+	})
+	filter := w.provider.Wasm.wasmFilter()
+	filter.GetConfig().GetVmConfig().VmId = wafVMIDPrefix + w.provider.Name
+	filter.GetConfig().Configuration = utils.MustMessageToAny(&wrapperspb.StringValue{Value: string(cfg)})
+	return filter
+}
+
+// perRoute returns the matcher override that executes the WAF filter for all the requests of a route.
+func (w *wafIR) perRoute() *envoymatchingv3.ExtensionWithMatcherPerRoute {
+	return &envoymatchingv3.ExtensionWithMatcherPerRoute{
+		XdsMatcher: &xdsmatcherv3.Matcher{
+			OnNoMatch: &xdsmatcherv3.Matcher_OnMatch{
+				OnMatch: &xdsmatcherv3.Matcher_OnMatch_Action{
+					Action: &xdscorev3.TypedExtensionConfig{
+						Name: "composite-action",
+						TypedConfig: utils.MustMessageToAny(&envoycompositev3.ExecuteFilterAction{
+							TypedConfig: &envoycorev3.TypedExtensionConfig{
+								Name:        wasmFilterNamePrefix,
+								TypedConfig: utils.MustMessageToAny(w.filter()),
+							},
+						}),
+					},
+				},
+			},
+		},
+	}
+}
+
+// constructWAF constructs the WAF policy IR from the policy specification.
+func constructWAF(
+	krtctx krt.HandlerContext,
+	in *kgateway.TrafficPolicy,
+	fetchGatewayExtension FetchGatewayExtensionFunc,
+	configMaps *krtcollections.ConfigMapIndex,
+	out *trafficPolicySpecIr,
+) error {
+	spec := in.Spec.WAF
+	if spec == nil {
+		return nil
+	}
+
+	if spec.Disable != nil {
+		out.waf = &wafIR{}
+		return nil
+	}
+
+	if spec.ExtensionRef == nil {
+		// This shouldn't happen due to CEL validation
+		return fmt.Errorf("waf: extensionRef must be specified")
+	}
+	provider, err := fetchGatewayExtension(krtctx, *spec.ExtensionRef, in.GetNamespace())
+	if err != nil {
+		return fmt.Errorf("waf: %w", err)
+	}
+	if provider.Wasm == nil {
+		return pluginutils.ErrInvalidExtensionType(kgateway.GatewayExtensionTypeWasm)
+	}
+
+	var directives []string
+	for _, ruleSet := range spec.RuleSets {
+		switch {
+		case ruleSet.Inline != nil:
+			directives = append(directives, *ruleSet.Inline)
+		case ruleSet.ConfigMapRef != nil:
+			rules, err := fetchConfigMapValue(krtctx, configMaps, ruleSet.ConfigMapRef, in.Namespace)
+			if err != nil {
+				return fmt.Errorf("waf: %w", err)
+			}
+			directives = append(directives, rules)
+		default:
+			// This shouldn't happen due to CEL validation
+			return fmt.Errorf("waf: either inline or configMapRef must be specified for a rule set")
+		}
+	}
+	directives = append(directives, wafDirectives(spec)...)
+
+	out.waf = &wafIR{
+		provider:   provider,
+		directives: directives,
+	}
+	return nil
+}
+
+// wafDirectives returns the directives setting the mode and excluding the rules of the policy.
+// They follow the rule sets, so that they override the mode set by the rule sets and
+// remove rules once defined.
+func wafDirectives(spec *kgateway.WAF) []string {
+	out := []string{"SecRuleEngine On"}
+	if ptr.Deref(spec.Mode, kgateway.WAFModeBlock) == kgateway.WAFModeDetectionOnly {
+		out = []string{"SecRuleEngine DetectionOnly"}
+	}
+	if spec.RuleExclusions == nil {
+		return out
+	}
+	if len(spec.RuleExclusions.IDs) > 0 {
+		out = append(out, "SecRuleRemoveById "+strings.Join(spec.RuleExclusions.IDs, " "))
+	}
+	for _, tag := range spec.RuleExclusions.Tags {
+		out = append(out, fmt.Sprintf("SecRuleRemoveByTag %q", tag))
+	}
+	return out
+}
+
+// buildWAFFilter returns the WAF filter added to the filter chain. The filter does nothing until
+// a route overrides its matcher with the WAF configuration of the route.
+func buildWAFFilter() *envoymatchingv3.ExtensionWithMatcher {
+	return &envoymatchingv3.ExtensionWithMatcher{
+		ExtensionConfig: &envoycorev3.TypedExtensionConfig{
+			Name:        wafCompositeName,
+			TypedConfig: utils.MustMessageToAny(&envoycompositev3.Composite{}),
+		},
+		XdsMatcher: &xdsmatcherv3.Matcher{},
+	}
+}
+
+func (p *trafficPolicyPluginGwPass) handleWAF(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, waf *wafIR) {
+	if waf == nil {
+		return
+	}
+
+	// A nil provider means the policy disables the WAF. Disabling the filter for the route overrides
+	// the WAF enabled at a higher level of the config hierarchy.
+	if waf.provider == nil {
+		pCtxTypedFilterConfig.AddTypedConfig(wafFilterName, &envoyroutev3.FilterConfig{Disabled: true})
+		return
+	}
+
+	// The most specific per-route configuration takes precedence, so the WAF configured for a route
+	// replaces the WAF configured for its virtual host or listener instead of being chained with it.
+	pCtxTypedFilterConfig.AddTypedConfig(wafFilterName, &envoyroutev3.FilterConfig{
+		Config: utils.MustMessageToAny(waf.perRoute()),
+	})
+	p.addWasmModulesCluster(waf.provider.Wasm)
+
+	// Add a disabled WAF filter to the filter chain, it is enabled by the per-route configuration
+	if p.wafInChain == nil {
+		p.wafInChain = make(map[string]*envoymatchingv3.ExtensionWithMatcher)
+	}
+	if _, ok := p.wafInChain[fcn]; !ok {
+		p.wafInChain[fcn] = buildWAFFilter()
+	}
+}
@@ -0,0 +1,196 @@
+package trafficpolicy
+
+import (
+	"encoding/json"
+	"testing"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	envoycompositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	wasmfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	wasmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wasm"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/filters"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const testWAFRules = `SecRule ARGS "@contains attack" "id:1001,phase:2,deny,status:403"`
+
+func testWAFExtension() *TrafficPolicyGatewayExtensionIR {
+	return &TrafficPolicyGatewayExtensionIR{
+		Name: "default/coraza",
+		Wasm: &wasmProviderConfig{
+			plugin: &wasmv3.PluginConfig{
+				Name: "default/coraza",
+				Vm: &wasmv3.PluginConfig_VmConfig{
+					VmConfig: &wasmv3.VmConfig{Runtime: "envoy.wasm.runtime.v8"},
+				},
+			},
+			code:         testWasmModuleServer.dataSource(wasm.SHA256(testWasmModule)),
+			moduleSHA256: wasm.SHA256(testWasmModule),
+			cluster:      testWasmModuleServer.cluster(),
+			stage:        filters.DuringStage(filters.AcceptedStage),
+		},
+	}
+}
+
+func TestConstructWAF(t *testing.T) {
+	wafExt := testWAFExtension()
+	extAuthExt := &TrafficPolicyGatewayExtensionIR{Name: "default/extauth"}
+	fetch := func(_ krt.HandlerContext, ref shared.NamespacedObjectReference, _ string) (*TrafficPolicyGatewayExtensionIR, error) {
+		if ref.Name == "coraza" {
+			return wafExt, nil
+		}
+		return extAuthExt, nil
+	}
+
+	tests := []struct {
+		name     string
+		waf      *kgateway.WAF
+		expected *wafIR
+		wantErr  error
+	}{
+		{
+			name: "nil waf",
+		},
+		{
+			name: "disabled waf",
+			waf: &kgateway.WAF{
+				Disable: &shared.PolicyDisable{},
+			},
+			expected: &wafIR{},
+		},
+		{
+			name: "blocking rule set",
+			waf: &kgateway.WAF{
+				ExtensionRef: &shared.NamespacedObjectReference{Name: "coraza"},
+				RuleSets:     []kgateway.WAFRuleSet{{Inline: ptr.To(testWAFRules)}},
+			},
+			expected: &wafIR{
+				provider:   wafExt,
+				directives: []string{testWAFRules, "SecRuleEngine On"},
+			},
+		},
+		{
+			name: "detection only with rule exclusions",
+			waf: &kgateway.WAF{
+				ExtensionRef: &shared.NamespacedObjectReference{Name: "coraza"},
+				RuleSets: []kgateway.WAFRuleSet{
+					{Inline: ptr.To("Include @crs-setup-conf")},
+					{Inline: ptr.To(testWAFRules)},
+				},
+				Mode: ptr.To(kgateway.WAFModeDetectionOnly),
+				RuleExclusions: &kgateway.WAFRuleExclusions{
+					IDs:  []string{"942100", "920000-920999"},
+					Tags: []string{"attack-sqli", "paranoia-level/2"},
+				},
+			},
+			expected: &wafIR{
+				provider: wafExt,
+				directives: []string{
+					"Include @crs-setup-conf",
+					testWAFRules,
+					"SecRuleEngine DetectionOnly",
+					"SecRuleRemoveById 942100 920000-920999",
+					`SecRuleRemoveByTag "attack-sqli"`,
+					`SecRuleRemoveByTag "paranoia-level/2"`,
+				},
+			},
+		},
+		{
+			name: "extension of another type",
+			waf: &kgateway.WAF{
+				ExtensionRef: &shared.NamespacedObjectReference{Name: "extauth"},
+				RuleSets:     []kgateway.WAFRuleSet{{Inline: ptr.To(testWAFRules)}},
+			},
+			wantErr: pluginutils.ErrInvalidExtensionType(kgateway.GatewayExtensionTypeWasm),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &trafficPolicySpecIr{}
+			err := constructWAF(nil, &kgateway.TrafficPolicy{
+				Spec: kgateway.TrafficPolicySpec{
+					WAF: tt.waf,
+				},
+			}, fetch, nil, out)
+			if tt.wantErr != nil {
+				require.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equals(out.waf), "expected %v, got %v", tt.expected, out.waf)
+			assert.NoError(t, out.waf.Validate())
+		})
+	}
+}
+
+func TestWAFFilter(t *testing.T) {
+	waf := &wafIR{
+		provider:   testWAFExtension(),
+		directives: []string{testWAFRules, "SecRuleEngine On"},
+	}
+
+	filter := waf.filter()
+	assert.Equal(t, wasm.SHA256(testWasmModule), filter.GetConfig().GetVmConfig().GetCode().GetRemote().GetSha256())
+	cfg := &wrapperspb.StringValue{}
+	require.NoError(t, filter.GetConfig().GetConfiguration().UnmarshalTo(cfg))
+	var pluginConfig wafPluginConfig
+	require.NoError(t, json.Unmarshal([]byte(cfg.GetValue()), &pluginConfig))
+	assert.Equal(t, wafPluginConfig{
+		DirectivesMap:     map[string][]string{"default": {testWAFRules, "SecRuleEngine On"}},
+		DefaultDirectives: "default",
+	}, pluginConfig)
+
+	// the WAF filters of the provider share a VM, whatever their directives
+	other := &wafIR{
+		provider:   waf.provider,
+		directives: []string{"SecRuleEngine DetectionOnly"},
+	}
+	assert.Equal(t, "kgateway.waf/default/coraza", filter.GetConfig().GetVmConfig().GetVmId())
+	assert.True(t, proto.Equal(filter.GetConfig().GetVmConfig(), other.filter().GetConfig().GetVmConfig()))
+
+	// the configuration of the GatewayExtension is not modified
+	assert.Nil(t, waf.provider.Wasm.plugin.GetConfiguration())
+	assert.Empty(t, waf.provider.Wasm.plugin.GetVmConfig().GetVmId())
+}
+
+func TestHandleWAF(t *testing.T) {
+	waf := &wafIR{
+		provider:   testWAFExtension(),
+		directives: []string{testWAFRules, "SecRuleEngine On"},
+	}
+	p := &trafficPolicyPluginGwPass{}
+
+	routeConfig := ir.TypedFilterConfigMap{}
+	p.handleWAF("listener~80", &routeConfig, waf)
+	require.NotNil(t, p.wafInChain["listener~80"])
+	filterConfig, ok := routeConfig.GetTypedConfig(wafFilterName).(*envoyroutev3.FilterConfig)
+	require.True(t, ok)
+	assert.False(t, filterConfig.GetDisabled())
+
+	// the per-route configuration executes the Wasm filter running the WAF module
+	perRoute := &envoymatchingv3.ExtensionWithMatcherPerRoute{}
+	require.NoError(t, filterConfig.GetConfig().UnmarshalTo(perRoute))
+	action := &envoycompositev3.ExecuteFilterAction{}
+	require.NoError(t, perRoute.GetXdsMatcher().GetOnNoMatch().GetAction().GetTypedConfig().UnmarshalTo(action))
+	assert.Equal(t, wasmFilterNamePrefix, action.GetTypedConfig().GetName())
+	wasmFilter := &wasmfilterv3.Wasm{}
+	require.NoError(t, action.GetTypedConfig().GetTypedConfig().UnmarshalTo(wasmFilter))
+	assert.Equal(t, "default/coraza", wasmFilter.GetConfig().GetName())
+
+	disabledConfig := ir.TypedFilterConfigMap{}
+	p.handleWAF("listener~80", &disabledConfig, &wafIR{})
+	assert.Equal(t, &envoyroutev3.FilterConfig{Disabled: true}, disabledConfig.GetTypedConfig(wafFilterName))
+}
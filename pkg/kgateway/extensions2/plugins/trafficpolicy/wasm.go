@@ -0,0 +1,514 @@
+package trafficpolicy
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"time"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	mutation_rulesv3 "github.com/envoyproxy/go-control-plane/envoy/config/common/mutation_rules/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	credential_injectorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/credential_injector/v3"
+	header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	upstream_codecv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/upstream_codec/v3"
+	wasmfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	genericv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/injected_credentials/generic/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	wasmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	kgwv1a1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wasm"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/filters"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/namespaces"
+)
+
+const (
+	wasmFilterNamePrefix = "envoy.filters.http.wasm"
+
+	// wasmGlobalDisableFilterName is the name of the filter that disables all Wasm filters
+	wasmGlobalDisableFilterName = "global_disable/wasm"
+	// wasmGlobalDisableFilterMetadataNamespace is the metadata namespace for the global disable Wasm filter
+	wasmGlobalDisableFilterMetadataNamespace = "dev.kgateway.disable_wasm"
+
+	// wasmModulesClusterName is the name of the cluster of the controller serving Wasm modules to the proxies
+	wasmModulesClusterName = "kgateway_wasm_modules"
+	wasmModuleFetchTimeout = 30 * time.Second
+	wasmModuleFetchRetries = 5
+
+	// The proxies authenticate to the Wasm module server with their xDS token, and validate its certificate
+	// with the xDS CA, both provided as secrets by the proxy bootstrap.
+	xdsTokenSecretName             = "xds-jwt-token"
+	xdsTokenSecretPath             = "/etc/envoy/xds_service_account_token.json"
+	xdsValidationContextSecretName = "validation_context_sds"
+)
+
+var wasmRuntimes = map[kgwv1a1.WasmRuntime]string{
+	kgwv1a1.WasmRuntimeV8:       "envoy.wasm.runtime.v8",
+	kgwv1a1.WasmRuntimeWamr:     "envoy.wasm.runtime.wamr",
+	kgwv1a1.WasmRuntimeWasmtime: "envoy.wasm.runtime.wasmtime",
+}
+
+var wasmFilterStages = map[kgwv1a1.FilterStageName]filters.WellKnownFilterStage{
+	kgwv1a1.FilterStageFault:     filters.FaultStage,
+	kgwv1a1.FilterStageCors:      filters.CorsStage,
+	kgwv1a1.FilterStageWAF:       filters.WafStage,
+	kgwv1a1.FilterStageAuthN:     filters.AuthNStage,
+	kgwv1a1.FilterStageAuthZ:     filters.AuthZStage,
+	kgwv1a1.FilterStageRateLimit: filters.RateLimitStage,
+	kgwv1a1.FilterStageCache:     filters.CacheStage,
+	kgwv1a1.FilterStageAccepted:  filters.AcceptedStage,
+	kgwv1a1.FilterStageOutAuth:   filters.OutAuthStage,
+	kgwv1a1.FilterStageRoute:     filters.RouteStage,
+}
+
+func wasmFilterName(name string) string {
+	return wasmFilterNamePrefix + "/" + name
+}
+
+// wasmModuleServer is the controller endpoint serving the Wasm modules of ConfigMaps and images to the proxies.
+type wasmModuleServer struct {
+	host string
+	port uint32
+	// enabled is true if the controller serves the modules
+	enabled bool
+	// auth is true if the proxies must present their xDS token to fetch the modules
+	auth bool
+	// tls is true if the modules are served over TLS with the certificate of the xDS server
+	tls bool
+}
+
+// newWasmModuleServer returns the controller endpoint serving the Wasm modules. The modules are served
+// by the Service serving xDS config, on a dedicated port, with the same authentication and TLS settings.
+func newWasmModuleServer(settings apisettings.Settings) wasmModuleServer {
+	host := settings.XdsServiceHost
+	if host == "" {
+		host = kubeutils.ServiceFQDN(metav1.ObjectMeta{
+			Name:      settings.XdsServiceName,
+			Namespace: namespaces.GetPodNamespace(),
+		})
+	}
+	return wasmModuleServer{
+		host:    host,
+		port:    settings.WasmServicePort,
+		enabled: settings.EnableWasmModuleServer,
+		auth:    settings.XdsAuth,
+		tls:     settings.XdsTLS,
+	}
+}
+
+// dataSource returns the source of a module fetched by the proxy from the controller.
+// The proxy verifies the module against its SHA-256 and caches it.
+func (s wasmModuleServer) dataSource(sha256 string) *envoycorev3.AsyncDataSource {
+	scheme := "http"
+	if s.tls {
+		scheme = "https"
+	}
+	return &envoycorev3.AsyncDataSource{
+		Specifier: &envoycorev3.AsyncDataSource_Remote{
+			Remote: &envoycorev3.RemoteDataSource{
+				HttpUri: &envoycorev3.HttpUri{
+					Uri: fmt.Sprintf("%s://%s%s%s", scheme, net.JoinHostPort(s.host, strconv.FormatUint(uint64(s.port), 10)), wasm.ModulesPathPrefix, sha256),
+					HttpUpstreamType: &envoycorev3.HttpUri_Cluster{
+						Cluster: wasmModulesClusterName,
+					},
+					Timeout: durationpb.New(wasmModuleFetchTimeout),
+				},
+				Sha256: sha256,
+				RetryPolicy: &envoycorev3.RetryPolicy{
+					NumRetries: wrapperspb.UInt32(wasmModuleFetchRetries),
+				},
+			},
+		},
+	}
+}
+
+// cluster returns the cluster the proxies fetch the modules from.
+func (s wasmModuleServer) cluster() *envoyclusterv3.Cluster {
+	cluster := &envoyclusterv3.Cluster{
+		Name:                 wasmModulesClusterName,
+		ConnectTimeout:       durationpb.New(5 * time.Second),
+		ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_STRICT_DNS},
+		LoadAssignment: &envoyendpointv3.ClusterLoadAssignment{
+			ClusterName: wasmModulesClusterName,
+			Endpoints: []*envoyendpointv3.LocalityLbEndpoints{{
+				LbEndpoints: []*envoyendpointv3.LbEndpoint{{
+					HostIdentifier: &envoyendpointv3.LbEndpoint_Endpoint{
+						Endpoint: &envoyendpointv3.Endpoint{
+							Address: &envoycorev3.Address{
+								Address: &envoycorev3.Address_SocketAddress{
+									SocketAddress: &envoycorev3.SocketAddress{
+										Address: s.host,
+										PortSpecifier: &envoycorev3.SocketAddress_PortValue{
+											PortValue: s.port,
+										},
+									},
+								},
+							},
+						},
+					},
+				}},
+			}},
+		},
+	}
+	if s.tls {
+		cluster.TransportSocket = &envoycorev3.TransportSocket{
+			Name: envoywellknown.TransportSocketTls,
+			ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+				TypedConfig: utils.MustMessageToAny(&envoytlsv3.UpstreamTlsContext{
+					CommonTlsContext: &envoytlsv3.CommonTlsContext{
+						ValidationContextType: &envoytlsv3.CommonTlsContext_ValidationContextSdsSecretConfig{
+							ValidationContextSdsSecretConfig: &envoytlsv3.SdsSecretConfig{
+								Name: xdsValidationContextSecretName,
+							},
+						},
+					},
+				}),
+			},
+		}
+	}
+	if s.auth {
+		cluster.TypedExtensionProtocolOptions = map[string]*anypb.Any{
+			"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": utils.MustMessageToAny(s.tokenProtocolOptions()),
+		}
+	}
+	return cluster
+}
+
+// tokenProtocolOptions returns the protocol options of the cluster injecting the xDS token of the proxy
+// as a bearer token in the requests for modules.
+func (s wasmModuleServer) tokenProtocolOptions() *envoy_upstreams_v3.HttpProtocolOptions {
+	credentialInjector := &credential_injectorv3.CredentialInjector{
+		Overwrite: true,
+		Credential: &envoycorev3.TypedExtensionConfig{
+			Name: "envoy.http.injected_credentials.generic",
+			TypedConfig: utils.MustMessageToAny(&genericv3.Generic{
+				Credential: &envoytlsv3.SdsSecretConfig{
+					Name: xdsTokenSecretName,
+					SdsConfig: &envoycorev3.ConfigSource{
+						ConfigSourceSpecifier: &envoycorev3.ConfigSource_PathConfigSource{
+							PathConfigSource: &envoycorev3.PathConfigSource{Path: xdsTokenSecretPath},
+						},
+						ResourceApiVersion: envoycorev3.ApiVersion_V3,
+					},
+				},
+			}),
+		},
+	}
+	// the injected credential is the raw token
+	bearer := &header_mutationv3.HeaderMutation{
+		Mutations: &header_mutationv3.Mutations{
+			RequestMutations: []*mutation_rulesv3.HeaderMutation{{
+				Action: &mutation_rulesv3.HeaderMutation_Append{
+					Append: &envoycorev3.HeaderValueOption{
+						Header: &envoycorev3.HeaderValue{
+							Key:   "Authorization",
+							Value: "Bearer %REQ(Authorization)%",
+						},
+						AppendAction: envoycorev3.HeaderValueOption_OVERWRITE_IF_EXISTS,
+					},
+				},
+			}},
+		},
+	}
+	return &envoy_upstreams_v3.HttpProtocolOptions{
+		UpstreamProtocolOptions: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+			ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+				ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{
+					HttpProtocolOptions: &envoycorev3.Http1ProtocolOptions{},
+				},
+			},
+		},
+		HttpFilters: []*envoy_hcm.HttpFilter{
+			{
+				Name:       "envoy.filters.http.credential_injector",
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{TypedConfig: utils.MustMessageToAny(credentialInjector)},
+			},
+			{
+				Name:       "envoy.filters.http.header_mutation",
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{TypedConfig: utils.MustMessageToAny(bearer)},
+			},
+			{
+				Name:       "envoy.filters.http.upstream_codec",
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{TypedConfig: utils.MustMessageToAny(&upstream_codecv3.UpstreamCodec{})},
+			},
+		},
+	}
+}
+
+// wasmProviderConfig is the Wasm filter configured by a GatewayExtension.
+type wasmProviderConfig struct {
+	// plugin is the plugin configuration of the filter without the module code
+	plugin *wasmv3.PluginConfig
+	// code is the source of the module, set as the code of the plugin VM
+	code *envoycorev3.AsyncDataSource
+	// moduleSHA256 is the hex-encoded SHA-256 of the module served by the controller.
+	// It is empty for modules on the proxy filesystem.
+	moduleSHA256 string
+	// cluster is the cluster the module is fetched from, nil for modules on the proxy filesystem
+	cluster *envoyclusterv3.Cluster
+	stage   filters.HTTPFilterStage
+}
+
+func (w *wasmProviderConfig) Equals(other *wasmProviderConfig) bool {
+	if w == nil || other == nil {
+		return w == nil && other == nil
+	}
+	if w.moduleSHA256 != other.moduleSHA256 || w.stage != other.stage {
+		return false
+	}
+	return proto.Equal(w.code, other.code) &&
+		proto.Equal(w.cluster, other.cluster) &&
+		proto.Equal(w.plugin, other.plugin)
+}
+
+// wasmFilter returns the Wasm filter configuration, including the module code.
+func (w *wasmProviderConfig) wasmFilter() *wasmfilterv3.Wasm {
+	plugin := proto.Clone(w.plugin).(*wasmv3.PluginConfig)
+	plugin.GetVmConfig().Code = w.code
+	return &wasmfilterv3.Wasm{Config: plugin}
+}
+
+// filter returns the Wasm filter wrapped in a composite filter, so that the filter
+// can be conditionally disabled when the global_disable/wasm filter is enabled.
+func (w *wasmProviderConfig) filter() *envoymatchingv3.ExtensionWithMatcher {
+	return buildCompositeFilter(
+		"composite_wasm",
+		wasmGlobalDisableFilterMetadataNamespace,
+		&envoycorev3.TypedExtensionConfig{
+			Name:        wasmFilterNamePrefix,
+			TypedConfig: utils.MustMessageToAny(w.wasmFilter()),
+		},
+	)
+}
+
+func buildWasmProviderConfig(
+	krtctx krt.HandlerContext,
+	ext *ir.GatewayExtension,
+	modules *wasmModules,
+	server wasmModuleServer,
+) (*wasmProviderConfig, error) {
+	in := ext.Wasm
+	out := &wasmProviderConfig{
+		stage: filters.DuringStage(filters.AcceptedStage),
+	}
+
+	switch {
+	case in.Module.ConfigMapRef != nil, in.Module.Image != nil:
+		if !server.enabled {
+			return nil, fmt.Errorf("the Wasm module server is disabled, only Wasm modules with a localFile are supported")
+		}
+		sha256, err := modules.moduleSHA256(krtctx, ext)
+		if err != nil {
+			return nil, err
+		}
+		out.code = server.dataSource(sha256)
+		out.moduleSHA256 = sha256
+		out.cluster = server.cluster()
+
+	case in.Module.LocalFile != nil:
+		out.code = &envoycorev3.AsyncDataSource{
+			Specifier: &envoycorev3.AsyncDataSource_Local{
+				Local: &envoycorev3.DataSource{
+					Specifier: &envoycorev3.DataSource_Filename{
+						Filename: *in.Module.LocalFile,
+					},
+				},
+			},
+		}
+
+	default:
+		// This shouldn't happen due to CEL validation
+		return nil, fmt.Errorf("one of configMapRef, localFile or image must be specified for the Wasm module")
+	}
+
+	vmConfig := &wasmv3.VmConfig{
+		Runtime: wasmRuntimes[ptr.Deref(in.Runtime, kgwv1a1.WasmRuntimeV8)],
+	}
+	out.plugin = &wasmv3.PluginConfig{
+		Name:   ext.ResourceName(),
+		RootId: ptr.Deref(in.RootID, ""),
+		Vm: &wasmv3.PluginConfig_VmConfig{
+			VmConfig: vmConfig,
+		},
+		FailurePolicy: wasmv3.FailurePolicy_FAIL_CLOSED,
+	}
+	if ptr.Deref(in.FailOpen, false) {
+		out.plugin.FailurePolicy = wasmv3.FailurePolicy_FAIL_OPEN
+	}
+	if in.Config != nil && len(in.Config.Raw) > 0 {
+		// StringValue configuration is passed to the plugin as is
+		cfg, err := utils.MessageToAny(&wrapperspb.StringValue{Value: string(in.Config.Raw)})
+		if err != nil {
+			return nil, err
+		}
+		out.plugin.Configuration = cfg
+	}
+
+	if in.Stage != nil {
+		stage, ok := wasmFilterStages[in.Stage.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown filter stage %q", in.Stage.Name)
+		}
+		switch ptr.Deref(in.Stage.Predicate, kgwv1a1.FilterStagePredicateDuring) {
+		case kgwv1a1.FilterStagePredicateBefore:
+			out.stage = filters.BeforeStage(stage)
+		case kgwv1a1.FilterStagePredicateAfter:
+			out.stage = filters.AfterStage(stage)
+		default:
+			out.stage = filters.DuringStage(stage)
+		}
+	}
+
+	return out, nil
+}
+
+// fetchWasmModuleFromConfigMap retrieves a Wasm module from a Kubernetes ConfigMap
+func fetchWasmModuleFromConfigMap(
+	krtctx krt.HandlerContext,
+	configMaps *krtcollections.ConfigMapIndex,
+	configMapRef *kgwv1a1.ConfigMapKeyReference,
+	extNamespace string,
+) ([]byte, error) {
+	from := krtcollections.From{
+		GroupKind: wellknown.GatewayExtensionGVK.GroupKind(),
+		Namespace: extNamespace,
+	}
+	cm, err := configMaps.GetConfigMap(krtctx, from, gwv1.ObjectReference{
+		Kind:      "ConfigMap",
+		Name:      configMapRef.Name,
+		Namespace: configMapRef.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data, ok := cm.BinaryData[configMapRef.Key]
+	if !ok {
+		data = []byte(cm.Data[configMapRef.Key])
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("key %q not found or empty in configmap %s/%s", configMapRef.Key, cm.Namespace, cm.Name)
+	}
+	return data, nil
+}
+
+type wasmIR struct {
+	// providers are the GatewayExtensions whose Wasm filters are enabled by the policy.
+	// It is empty when the policy disables the Wasm filters.
+	providers []*TrafficPolicyGatewayExtensionIR
+}
+
+var _ PolicySubIR = &wasmIR{}
+
+func (w *wasmIR) Equals(other PolicySubIR) bool {
+	otherWasm, ok := other.(*wasmIR)
+	if !ok {
+		return false
+	}
+	if w == nil || otherWasm == nil {
+		return w == nil && otherWasm == nil
+	}
+	return slices.EqualFunc(w.providers, otherWasm.providers, func(a, b *TrafficPolicyGatewayExtensionIR) bool {
+		return a.Name == b.Name && a.Equals(*b)
+	})
+}
+
+func (w *wasmIR) Validate() error {
+	if w == nil {
+		return nil
+	}
+	for _, provider := range w.providers {
+		if err := provider.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// constructWasm constructs the Wasm policy IR from the policy specification.
+func constructWasm(
+	krtctx krt.HandlerContext,
+	in *kgwv1a1.TrafficPolicy,
+	fetchGatewayExtension FetchGatewayExtensionFunc,
+	out *trafficPolicySpecIr,
+) error {
+	spec := in.Spec.Wasm
+	if spec == nil {
+		return nil
+	}
+
+	if spec.Disable != nil {
+		out.wasm = &wasmIR{}
+		return nil
+	}
+
+	providers := make([]*TrafficPolicyGatewayExtensionIR, 0, len(spec.ExtensionRefs))
+	for _, ref := range spec.ExtensionRefs {
+		provider, err := fetchGatewayExtension(krtctx, ref, in.GetNamespace())
+		if err != nil {
+			return fmt.Errorf("wasm: %w", err)
+		}
+		if provider.Wasm == nil {
+			return pluginutils.ErrInvalidExtensionType(kgwv1a1.GatewayExtensionTypeWasm)
+		}
+		providers = append(providers, provider)
+	}
+	out.wasm = &wasmIR{providers: providers}
+	return nil
+}
+
+func (p *trafficPolicyPluginGwPass) handleWasm(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, wasm *wasmIR) {
+	if wasm == nil {
+		return
+	}
+
+	// A policy without providers disables all the Wasm filters, including the ones
+	// enabled at a higher level of the config hierarchy.
+	if len(wasm.providers) == 0 {
+		pCtxTypedFilterConfig.AddTypedConfig(wasmGlobalDisableFilterName, EnableFilterPerRoute())
+		return
+	}
+
+	if p.wasmInChain == nil {
+		p.wasmInChain = make(map[string]map[string]*TrafficPolicyGatewayExtensionIR)
+	}
+	if p.wasmInChain[fcn] == nil {
+		p.wasmInChain[fcn] = make(map[string]*TrafficPolicyGatewayExtensionIR)
+	}
+	for _, provider := range wasm.providers {
+		// Add a filter per provider to the chain. The filters are disabled in the chain and
+		// enabled using typed_per_filter_config for the routes the policy applies to.
+		p.wasmInChain[fcn][provider.Name] = provider
+		pCtxTypedFilterConfig.AddTypedConfig(wasmFilterName(provider.Name), EnableFilterPerRoute())
+		p.addWasmModulesCluster(provider.Wasm)
+	}
+}
+
+// addWasmModulesCluster adds the cluster the module of a Wasm provider is fetched from, if any.
+func (p *trafficPolicyPluginGwPass) addWasmModulesCluster(provider *wasmProviderConfig) {
+	if provider.cluster != nil {
+		p.wasmModulesCluster = provider.cluster
+	}
+}
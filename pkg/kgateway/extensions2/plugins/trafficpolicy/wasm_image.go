@@ -0,0 +1,269 @@
+package trafficpolicy
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/retry"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wasm"
+)
+
+const (
+	// wasmLayerMediaType is the media type of the layer of Wasm OCI artifacts.
+	// See https://tag-runtime.cncf.io/wgs/wasm/deliverables/wasm-oci-artifact/
+	wasmLayerMediaType = "application/vnd.module.wasm.content.layer.v1+wasm"
+	// wasmCompatLayerFile is the file containing the module in images with a single tar layer.
+	wasmCompatLayerFile = "plugin.wasm"
+
+	dockerManifestMediaType       = "application/vnd.docker.distribution.manifest.v2+json"
+	dockerLayerMediaType          = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+	dockerConfigJSONKey           = ".dockerconfigjson"
+	wasmImageFetchTimeout         = 2 * time.Minute
+	wasmImageMaxModuleSize  int64 = 32 << 20
+)
+
+// wasmModule is a Wasm module pulled from an OCI image.
+type wasmModule struct {
+	data []byte
+	// sha256 is the hex-encoded SHA-256 of data
+	sha256 string
+}
+
+// wasmImageCacheKey identifies a cached module by the image and the credential used to pull it, so that
+// a module pulled with the credential of one pull secret is never returned for another credential.
+type wasmImageCacheKey struct {
+	image string
+	cred  auth.Credential
+}
+
+type wasmImageCacheEntry struct {
+	module    *wasmModule
+	fetchedAt time.Time
+}
+
+type wasmImageFetcher struct {
+	// caches wasmImageCacheEntry per wasmImageCacheKey
+	cache sync.Map
+	// cacheRefreshInterval is the interval after which image tags are resolved again.
+	// Images referenced by digest are immutable, so they are never pulled again.
+	cacheRefreshInterval time.Duration
+	// newTarget returns the target to pull the image from; overridden in tests
+	newTarget func(ref registry.Reference, cred auth.Credential) (oras.ReadOnlyTarget, error)
+	// now returns the current time; overridden in tests
+	now func() time.Time
+}
+
+// newWasmImageFetcher returns a wasmImageFetcher instance that pulls Wasm modules from OCI registries
+// and caches them per image reference and credential.
+func newWasmImageFetcher() *wasmImageFetcher {
+	return &wasmImageFetcher{
+		cacheRefreshInterval: 5 * time.Minute,
+		newTarget:            newRemoteRepository,
+		now:                  time.Now,
+	}
+}
+
+func (f *wasmImageFetcher) get(ctx context.Context, image string, cred auth.Credential) (*wasmModule, error) {
+	key := wasmImageCacheKey{image: image, cred: cred}
+	if v, ok := f.cache.Load(key); ok {
+		entry := v.(wasmImageCacheEntry)
+		if isWasmImageDigestReference(image) || f.now().Sub(entry.fetchedAt) < f.cacheRefreshInterval {
+			return entry.module, nil
+		}
+	}
+
+	module, err := f.fetch(ctx, image, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	f.cache.Store(key, wasmImageCacheEntry{module: module, fetchedAt: f.now()})
+	return module, nil
+}
+
+// isWasmImageDigestReference returns true if the image is referenced by digest, i.e. it is immutable.
+func isWasmImageDigestReference(image string) bool {
+	ref, err := registry.ParseReference(image)
+	return err == nil && ref.ValidateReferenceAsDigest() == nil
+}
+
+func (f *wasmImageFetcher) fetch(ctx context.Context, image string, cred auth.Credential) (*wasmModule, error) {
+	ctx, cancel := context.WithTimeout(ctx, wasmImageFetchTimeout)
+	defer cancel()
+
+	ref, err := registry.ParseReference(image)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image reference %q: %w", image, err)
+	}
+	if ref.Reference == "" {
+		ref.Reference = "latest"
+	}
+	target, err := f.newTarget(ref, cred)
+	if err != nil {
+		return nil, err
+	}
+
+	manifestDesc, err := oras.Resolve(ctx, target, ref.Reference, oras.DefaultResolveOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve image %q: %w", image, err)
+	}
+	if manifestDesc.MediaType != ocispec.MediaTypeImageManifest && manifestDesc.MediaType != dockerManifestMediaType {
+		return nil, fmt.Errorf("image %q has unsupported manifest media type %q", image, manifestDesc.MediaType)
+	}
+	manifestData, err := content.FetchAll(ctx, target, manifestDesc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manifest of image %q: %w", image, err)
+	}
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest of image %q: %w", image, err)
+	}
+
+	layer, err := wasmLayer(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("image %q: %w", image, err)
+	}
+	if layer.Size > wasmImageMaxModuleSize {
+		return nil, fmt.Errorf("image %q: layer of %d bytes exceeds the maximum size of %d bytes", image, layer.Size, wasmImageMaxModuleSize)
+	}
+	// FetchAll verifies the layer against its digest
+	layerData, err := content.FetchAll(ctx, target, layer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch layer of image %q: %w", image, err)
+	}
+
+	if layer.MediaType == wasmLayerMediaType {
+		return &wasmModule{data: layerData, sha256: layer.Digest.Encoded()}, nil
+	}
+	data, err := extractCompatWasmModule(layerData)
+	if err != nil {
+		return nil, fmt.Errorf("image %q: %w", image, err)
+	}
+	return &wasmModule{data: data, sha256: wasm.SHA256(data)}, nil
+}
+
+// wasmLayer returns the layer containing the Wasm module: either the layer of a Wasm OCI artifact,
+// or the single compressed tar layer of an image containing a plugin.wasm file.
+func wasmLayer(manifest ocispec.Manifest) (ocispec.Descriptor, error) {
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == wasmLayerMediaType {
+			return layer, nil
+		}
+	}
+	if len(manifest.Layers) == 1 {
+		switch manifest.Layers[0].MediaType {
+		case ocispec.MediaTypeImageLayerGzip, dockerLayerMediaType:
+			return manifest.Layers[0], nil
+		}
+	}
+	return ocispec.Descriptor{}, errors.New("no Wasm module layer found")
+}
+
+// extractCompatWasmModule extracts the plugin.wasm file from a gzip compressed tar layer.
+func extractCompatWasmModule(layer []byte) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(layer))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress layer: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%s not found in layer", wasmCompatLayerFile)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read layer: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg || path.Base(hdr.Name) != wasmCompatLayerFile {
+			continue
+		}
+		return io.ReadAll(io.LimitReader(tr, wasmImageMaxModuleSize))
+	}
+}
+
+func newRemoteRepository(ref registry.Reference, cred auth.Credential) (oras.ReadOnlyTarget, error) {
+	repo, err := remote.NewRepository(ref.Registry + "/" + ref.Repository)
+	if err != nil {
+		return nil, err
+	}
+	client := &auth.Client{
+		Client: retry.DefaultClient,
+		Cache:  auth.NewCache(),
+		Header: auth.DefaultClient.Header.Clone(),
+	}
+	client.SetUserAgent("kgateway/wasm-image-fetcher")
+	if cred != auth.EmptyCredential {
+		client.Credential = auth.StaticCredential(ref.Registry, cred)
+	}
+	repo.Client = client
+	return repo, nil
+}
+
+// dockerConfigCredential returns the credential for the registry of the image from the
+// .dockerconfigjson data of an image pull secret.
+func dockerConfigCredential(dockerConfigJSON []byte, image string) (auth.Credential, error) {
+	ref, err := registry.ParseReference(image)
+	if err != nil {
+		return auth.EmptyCredential, fmt.Errorf("invalid image reference %q: %w", image, err)
+	}
+
+	var cfg struct {
+		Auths map[string]struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+			Auth     string `json:"auth"`
+		} `json:"auths"`
+	}
+	if err := json.Unmarshal(dockerConfigJSON, &cfg); err != nil {
+		return auth.EmptyCredential, fmt.Errorf("failed to decode %s: %w", dockerConfigJSONKey, err)
+	}
+	for server, entry := range cfg.Auths {
+		if dockerConfigServerHost(server) != ref.Registry {
+			continue
+		}
+		if entry.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+			if err != nil {
+				return auth.EmptyCredential, fmt.Errorf("invalid auth for registry %s: %w", server, err)
+			}
+			username, password, ok := strings.Cut(string(decoded), ":")
+			if !ok {
+				return auth.EmptyCredential, fmt.Errorf("invalid auth for registry %s", server)
+			}
+			return auth.Credential{Username: username, Password: password}, nil
+		}
+		return auth.Credential{Username: entry.Username, Password: entry.Password}, nil
+	}
+	return auth.EmptyCredential, fmt.Errorf("no credentials found for registry %s", ref.Registry)
+}
+
+// dockerConfigServerHost returns the host of a server key in a docker config, which may be a URL.
+func dockerConfigServerHost(server string) string {
+	server = strings.TrimPrefix(server, "https://")
+	server = strings.TrimPrefix(server, "http://")
+	host, _, _ := strings.Cut(server, "/")
+	if host == "index.docker.io" {
+		return "docker.io"
+	}
+	return host
+}
@@ -0,0 +1,298 @@
+package trafficpolicy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/krt"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wasm"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	wasmImagePullInitialBackoff = 5 * time.Second
+	wasmImagePullMaxBackoff     = 5 * time.Minute
+)
+
+// wasmModuleSource is the source of the module of a Wasm GatewayExtension served by the controller:
+// either the content of a ConfigMap or an image to pull.
+type wasmModuleSource struct {
+	// name is the resource name of the GatewayExtension
+	name string
+	// data is the module stored in a ConfigMap
+	data []byte
+	// dataSHA256 is the hex-encoded SHA-256 of data
+	dataSHA256 string
+	// image is the reference of the image to pull the module from
+	image string
+	// cred is the credential to pull the image with
+	cred auth.Credential
+	// err is the error resolving the source of the module
+	err error
+}
+
+func (s wasmModuleSource) ResourceName() string {
+	return s.name
+}
+
+func (s wasmModuleSource) Equals(other wasmModuleSource) bool {
+	return s.name == other.name &&
+		s.dataSHA256 == other.dataSHA256 &&
+		s.image == other.image &&
+		s.cred == other.cred &&
+		errorString(s.err) == errorString(other.err)
+}
+
+// wasmImageModule is the module pulled for the image of a Wasm GatewayExtension.
+type wasmImageModule struct {
+	// name is the resource name of the GatewayExtension
+	name string
+	// sha256 is the hex-encoded SHA-256 of the module, empty if the image could not be pulled
+	sha256 string
+	// err is the error pulling the image
+	err error
+}
+
+func (m wasmImageModule) ResourceName() string {
+	return m.name
+}
+
+func (m wasmImageModule) Equals(other wasmImageModule) bool {
+	return m.name == other.name && m.sha256 == other.sha256 && errorString(m.err) == errorString(other.err)
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// wasmModules resolves the modules of Wasm GatewayExtensions that are served by the controller. The modules
+// of images are pulled in the background, so that translation never waits for an image to be pulled:
+// the module of an image is available in imageModules once it has been pulled.
+type wasmModules struct {
+	sources      krt.Collection[wasmModuleSource]
+	imageModules krt.StaticCollection[wasmImageModule]
+	store        *wasm.ModuleStore
+	fetcher      *wasmImageFetcher
+
+	mu sync.Mutex
+	// pulls maps GatewayExtension resource name -> in-flight pull of its image
+	pulls map[string]*wasmImagePull
+}
+
+type wasmImagePull struct {
+	key    wasmImageCacheKey
+	cancel context.CancelFunc
+}
+
+func newWasmModules(ctx context.Context, commoncol *collections.CommonCollections, fetcher *wasmImageFetcher) *wasmModules {
+	m := &wasmModules{
+		imageModules: krt.NewStaticCollection[wasmImageModule](nil, nil, commoncol.KrtOpts.ToOptions("WasmImageModules")...),
+		store:        commoncol.WasmModules,
+		fetcher:      fetcher,
+		pulls:        make(map[string]*wasmImagePull),
+	}
+	m.sources = krt.NewCollection(commoncol.GatewayExtensions, func(krtctx krt.HandlerContext, ext ir.GatewayExtension) *wasmModuleSource {
+		return buildWasmModuleSource(krtctx, &ext, commoncol.ConfigMaps, commoncol.Secrets)
+	}, commoncol.KrtOpts.ToOptions("WasmModuleSources")...)
+	m.sources.Register(func(e krt.Event[wasmModuleSource]) {
+		if e.Event == controllers.EventDelete {
+			m.remove(e.Old.name)
+			return
+		}
+		m.sync(ctx, *e.New)
+	})
+	return m
+}
+
+// buildWasmModuleSource returns the source of the module of a Wasm GatewayExtension,
+// or nil if the module is not served by the controller.
+func buildWasmModuleSource(
+	krtctx krt.HandlerContext,
+	ext *ir.GatewayExtension,
+	configMaps *krtcollections.ConfigMapIndex,
+	secrets *krtcollections.SecretIndex,
+) *wasmModuleSource {
+	if ext.Wasm == nil {
+		return nil
+	}
+	out := &wasmModuleSource{name: ext.ResourceName()}
+	module := ext.Wasm.Module
+	switch {
+	case module.ConfigMapRef != nil:
+		out.data, out.err = fetchWasmModuleFromConfigMap(krtctx, configMaps, module.ConfigMapRef, ext.Namespace)
+		if out.err == nil {
+			out.dataSHA256 = wasm.SHA256(out.data)
+		}
+	case module.Image != nil:
+		out.image = module.Image.Reference
+		out.cred, out.err = wasmImageCredential(krtctx, ext, secrets)
+	default:
+		return nil
+	}
+	return out
+}
+
+// wasmImageCredential returns the credential of the image pull secret of a Wasm GatewayExtension.
+func wasmImageCredential(krtctx krt.HandlerContext, ext *ir.GatewayExtension, secrets *krtcollections.SecretIndex) (auth.Credential, error) {
+	image := ext.Wasm.Module.Image
+	if image.PullSecretRef == nil {
+		return auth.EmptyCredential, nil
+	}
+	pullSecret, err := secrets.GetSecret(krtctx,
+		krtcollections.From{GroupKind: wellknown.GatewayExtensionGVK.GroupKind(), Namespace: ext.Namespace},
+		gwv1.SecretObjectReference{
+			Name: gwv1.ObjectName(image.PullSecretRef.Name), Namespace: new(gwv1.Namespace(ext.Namespace)),
+		},
+	)
+	if err != nil {
+		return auth.EmptyCredential, err
+	}
+	dockerConfigJSON, ok := pullSecret.Data[dockerConfigJSONKey]
+	if !ok || len(dockerConfigJSON) == 0 {
+		return auth.EmptyCredential, fmt.Errorf("%s not found or empty in secret %s referenced by GatewayExtension %s",
+			dockerConfigJSONKey, pullSecret.ResourceName(), ext.ResourceName())
+	}
+	return dockerConfigCredential(dockerConfigJSON, image.Reference)
+}
+
+// sync stores the module of a ConfigMap, or starts pulling the module of an image.
+func (m *wasmModules) sync(ctx context.Context, src wasmModuleSource) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := wasmImageCacheKey{image: src.image, cred: src.cred}
+	if pull, ok := m.pulls[src.name]; ok && src.err == nil && src.image != "" && pull.key == key {
+		// the image is already being pulled
+		return
+	}
+	m.stopPullLocked(src.name)
+
+	switch {
+	case src.err != nil:
+		m.store.Delete(src.name)
+		m.imageModules.DeleteObject(src.name)
+	case src.image == "":
+		m.store.Put(src.name, src.data)
+		m.imageModules.DeleteObject(src.name)
+	default:
+		// the module of the previous image, if any, is used until the new image is pulled
+		pullCtx, cancel := context.WithCancel(ctx)
+		pull := &wasmImagePull{key: key, cancel: cancel}
+		m.pulls[src.name] = pull
+		go m.pullImage(pullCtx, src.name, pull)
+	}
+}
+
+// remove stops serving the module of a deleted GatewayExtension.
+func (m *wasmModules) remove(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopPullLocked(name)
+	m.store.Delete(name)
+	m.imageModules.DeleteObject(name)
+}
+
+// stopPullLocked stops the pull of the image of a GatewayExtension, and evicts the module
+// from the fetcher cache when no other GatewayExtension uses the same image and credential.
+func (m *wasmModules) stopPullLocked(name string) {
+	pull, ok := m.pulls[name]
+	if !ok {
+		return
+	}
+	pull.cancel()
+	delete(m.pulls, name)
+	for _, other := range m.pulls {
+		if other.key == pull.key {
+			return
+		}
+	}
+	m.fetcher.cache.Delete(pull.key)
+}
+
+// pullImage pulls the module of an image until it succeeds, retrying with backoff, then pulls it again
+// periodically if the image is referenced by tag. A failure after a successful pull keeps the module
+// previously pulled.
+func (m *wasmModules) pullImage(ctx context.Context, name string, pull *wasmImagePull) {
+	image := pull.key.image
+	backoff := wasmImagePullInitialBackoff
+	pulled := false
+	for {
+		module, err := m.fetcher.get(ctx, image, pull.key.cred)
+		if ctx.Err() != nil {
+			return
+		}
+
+		var wait time.Duration
+		if err != nil {
+			logger.Warn("failed to pull wasm module", "gateway_extension", name, "image", image, "error", err)
+			if !pulled {
+				m.update(name, pull, wasmImageModule{name: name, err: fmt.Errorf("failed to pull image %q: %w", image, err)}, nil)
+			}
+			wait = backoff
+			backoff = min(backoff*2, wasmImagePullMaxBackoff)
+		} else {
+			m.update(name, pull, wasmImageModule{name: name, sha256: module.sha256}, module.data)
+			pulled = true
+			backoff = wasmImagePullInitialBackoff
+			if isWasmImageDigestReference(image) {
+				return
+			}
+			wait = m.fetcher.cacheRefreshInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// update stores the pulled module and updates the module of the GatewayExtension, unless the pull was stopped.
+// The module is stored before the GatewayExtension is updated, so that it is served before proxies request it.
+func (m *wasmModules) update(name string, pull *wasmImagePull, out wasmImageModule, data []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.pulls[name] != pull {
+		return
+	}
+	if data != nil {
+		m.store.Put(name, data)
+	}
+	m.imageModules.ConditionalUpdateObject(out)
+}
+
+// moduleSHA256 returns the SHA-256 of the module of a Wasm GatewayExtension served by the controller.
+// It returns an error until the module of an image has been pulled.
+func (m *wasmModules) moduleSHA256(krtctx krt.HandlerContext, ext *ir.GatewayExtension) (string, error) {
+	src := krt.FetchOne(krtctx, m.sources, krt.FilterKey(ext.ResourceName()))
+	if src == nil {
+		return "", fmt.Errorf("module of GatewayExtension %s not found", ext.ResourceName())
+	}
+	if src.err != nil {
+		return "", src.err
+	}
+	if src.image == "" {
+		return src.dataSHA256, nil
+	}
+	module := krt.FetchOne(krtctx, m.imageModules, krt.FilterKey(ext.ResourceName()))
+	if module == nil {
+		return "", fmt.Errorf("image %q has not been pulled yet", src.image)
+	}
+	if module.err != nil {
+		return "", module.err
+	}
+	return module.sha256, nil
+}
@@ -0,0 +1,399 @@
+package trafficpolicy
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"sync"
+	"testing"
+	"time"
+
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	wasmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/memory"
+	"oras.land/oras-go/v2/registry"
+	"oras.land/oras-go/v2/registry/remote/auth"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/pluginutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wasm"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/filters"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+// testWasmModule is the smallest valid Wasm module: the magic number and version
+var testWasmModule = []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+var testWasmModuleServer = wasmModuleServer{host: "kgateway.kgateway-system.svc.cluster.local", port: 9978, enabled: true}
+
+func pushWasmImage(t *testing.T, store *memory.Store, tag, artifactType, layerMediaType string, layer []byte) {
+	t.Helper()
+	ctx := context.Background()
+
+	layerDesc, err := oras.PushBytes(ctx, store, layerMediaType, layer)
+	require.NoError(t, err)
+	manifestDesc, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_0, artifactType, oras.PackManifestOptions{
+		Layers: []ocispec.Descriptor{layerDesc},
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.Tag(ctx, manifestDesc, tag))
+}
+
+func compatWasmLayer(t *testing.T, module []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "README.md", Mode: 0o644, Size: 2, Typeflag: tar.TypeReg}))
+	_, err := tw.Write([]byte("hi"))
+	require.NoError(t, err)
+	if module != nil {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "plugin.wasm", Mode: 0o644, Size: int64(len(module)), Typeflag: tar.TypeReg}))
+		_, err = tw.Write(module)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestWasmImageFetcher(t *testing.T) {
+	store := memory.New()
+	pushWasmImage(t, store, "artifact", "application/vnd.wasm.config.v0+json", wasmLayerMediaType, testWasmModule)
+	pushWasmImage(t, store, "compat", ocispec.MediaTypeImageConfig, ocispec.MediaTypeImageLayerGzip, compatWasmLayer(t, testWasmModule))
+	pushWasmImage(t, store, "no-module", ocispec.MediaTypeImageConfig, ocispec.MediaTypeImageLayerGzip, compatWasmLayer(t, nil))
+
+	newTargetCalls := 0
+	fetcher := newWasmImageFetcher()
+	fetcher.newTarget = func(ref registry.Reference, cred auth.Credential) (oras.ReadOnlyTarget, error) {
+		newTargetCalls++
+		assert.Equal(t, "registry.example.com", ref.Registry)
+		assert.Equal(t, "plugins/wasm", ref.Repository)
+		return store, nil
+	}
+
+	tests := []struct {
+		name    string
+		image   string
+		wantErr string
+	}{
+		{
+			name:  "wasm artifact",
+			image: "registry.example.com/plugins/wasm:artifact",
+		},
+		{
+			name:  "image with plugin.wasm layer",
+			image: "registry.example.com/plugins/wasm:compat",
+		},
+		{
+			name:    "unknown tag",
+			image:   "registry.example.com/plugins/wasm:unknown",
+			wantErr: "failed to resolve image",
+		},
+		{
+			name:    "image without plugin.wasm",
+			image:   "registry.example.com/plugins/wasm:no-module",
+			wantErr: "plugin.wasm not found in layer",
+		},
+		{
+			name:    "invalid reference",
+			image:   "registry.example.com/Plugins",
+			wantErr: "invalid image reference",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			module, err := fetcher.get(context.Background(), tt.image, auth.EmptyCredential)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testWasmModule, module.data)
+			assert.Equal(t, wasm.SHA256(testWasmModule), module.sha256)
+		})
+	}
+
+	// cached modules are not fetched again
+	calls := newTargetCalls
+	_, err := fetcher.get(context.Background(), "registry.example.com/plugins/wasm:artifact", auth.EmptyCredential)
+	require.NoError(t, err)
+	assert.Equal(t, calls, newTargetCalls)
+
+	// modules are cached per credential, so the access of another credential is checked
+	_, err = fetcher.get(context.Background(), "registry.example.com/plugins/wasm:artifact", auth.Credential{Username: "user", Password: "pass"})
+	require.NoError(t, err)
+	assert.Equal(t, calls+1, newTargetCalls)
+
+	// image tags are resolved again after the refresh interval
+	now := time.Now()
+	fetcher.now = func() time.Time { return now.Add(fetcher.cacheRefreshInterval) }
+	_, err = fetcher.get(context.Background(), "registry.example.com/plugins/wasm:artifact", auth.EmptyCredential)
+	require.NoError(t, err)
+	assert.Equal(t, calls+2, newTargetCalls)
+}
+
+func TestWasmModulesPullImage(t *testing.T) {
+	store := memory.New()
+	pushWasmImage(t, store, "v1", "application/vnd.wasm.config.v0+json", wasmLayerMediaType, testWasmModule)
+
+	fetcher := newWasmImageFetcher()
+	var creds []auth.Credential
+	var mu sync.Mutex
+	fetcher.newTarget = func(_ registry.Reference, cred auth.Credential) (oras.ReadOnlyTarget, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		creds = append(creds, cred)
+		return store, nil
+	}
+	m := &wasmModules{
+		imageModules: krt.NewStaticCollection[wasmImageModule](nil, nil),
+		store:        wasm.NewModuleStore(),
+		fetcher:      fetcher,
+		pulls:        make(map[string]*wasmImagePull),
+	}
+	ctx := t.Context()
+	sha := wasm.SHA256(testWasmModule)
+
+	// the module is served once the image is pulled
+	m.sync(ctx, wasmModuleSource{name: "default/wasm", image: "registry.example.com/plugins/wasm:v1"})
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		module := m.imageModules.GetKey("default/wasm")
+		require.NotNil(c, module)
+		assert.Equal(c, sha, module.sha256)
+	}, 5*time.Second, 10*time.Millisecond)
+	data, ok := m.store.Get(sha)
+	require.True(t, ok)
+	assert.Equal(t, testWasmModule, data)
+
+	// an image that cannot be pulled reports the error
+	m.sync(ctx, wasmModuleSource{name: "default/other", image: "registry.example.com/plugins/wasm:unknown"})
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		module := m.imageModules.GetKey("default/other")
+		require.NotNil(c, module)
+		assert.ErrorContains(c, module.err, "failed to resolve image")
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the module of a ConfigMap is served as is
+	m.sync(ctx, wasmModuleSource{name: "default/other", data: []byte("module"), dataSHA256: wasm.SHA256([]byte("module"))})
+	assert.Nil(t, m.imageModules.GetKey("default/other"))
+	_, ok = m.store.Get(wasm.SHA256([]byte("module")))
+	assert.True(t, ok)
+
+	// the module of a deleted GatewayExtension is no longer served
+	m.remove("default/wasm")
+	assert.Nil(t, m.imageModules.GetKey("default/wasm"))
+	_, ok = m.store.Get(sha)
+	assert.False(t, ok)
+}
+
+func TestDockerConfigCredential(t *testing.T) {
+	encodedAuth := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+	dockerConfig := []byte(`{"auths":{
+		"https://index.docker.io/v1/":{"auth":"` + encodedAuth + `"},
+		"ghcr.io":{"username":"ghcr-user","password":"ghcr-pass"}
+	}}`)
+
+	tests := []struct {
+		name    string
+		config  []byte
+		image   string
+		want    auth.Credential
+		wantErr string
+	}{
+		{
+			name:   "docker hub credentials from auth",
+			config: dockerConfig,
+			image:  "docker.io/org/plugin:v1",
+			want:   auth.Credential{Username: "user", Password: "pass"},
+		},
+		{
+			name:   "username and password",
+			config: dockerConfig,
+			image:  "ghcr.io/org/plugin:v1",
+			want:   auth.Credential{Username: "ghcr-user", Password: "ghcr-pass"},
+		},
+		{
+			name:    "no credentials for registry",
+			config:  dockerConfig,
+			image:   "quay.io/org/plugin:v1",
+			wantErr: "no credentials found for registry quay.io",
+		},
+		{
+			name:    "invalid docker config",
+			config:  []byte("{"),
+			image:   "ghcr.io/org/plugin:v1",
+			wantErr: "failed to decode .dockerconfigjson",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cred, err := dockerConfigCredential(tt.config, tt.image)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, cred)
+		})
+	}
+}
+
+func TestWasmModuleServer(t *testing.T) {
+	sum := wasm.SHA256(testWasmModule)
+
+	server := testWasmModuleServer
+	assert.Equal(t, "http://kgateway.kgateway-system.svc.cluster.local:9978/modules/"+sum,
+		server.dataSource(sum).GetRemote().GetHttpUri().GetUri())
+	assert.Nil(t, server.cluster().GetTransportSocket())
+	assert.Empty(t, server.cluster().GetTypedExtensionProtocolOptions())
+
+	// the modules are served with the authentication and TLS settings of the xDS server
+	server.auth, server.tls = true, true
+	assert.Equal(t, "https://kgateway.kgateway-system.svc.cluster.local:9978/modules/"+sum,
+		server.dataSource(sum).GetRemote().GetHttpUri().GetUri())
+	cluster := server.cluster()
+	tlsContext := &envoytlsv3.UpstreamTlsContext{}
+	require.NoError(t, cluster.GetTransportSocket().GetTypedConfig().UnmarshalTo(tlsContext))
+	assert.Equal(t, xdsValidationContextSecretName, tlsContext.GetCommonTlsContext().GetValidationContextSdsSecretConfig().GetName())
+	opts := &envoy_upstreams_v3.HttpProtocolOptions{}
+	require.NoError(t, cluster.GetTypedExtensionProtocolOptions()["envoy.extensions.upstreams.http.v3.HttpProtocolOptions"].UnmarshalTo(opts))
+	var filterNames []string
+	for _, f := range opts.GetHttpFilters() {
+		filterNames = append(filterNames, f.GetName())
+	}
+	assert.Equal(t, []string{
+		"envoy.filters.http.credential_injector",
+		"envoy.filters.http.header_mutation",
+		"envoy.filters.http.upstream_codec",
+	}, filterNames)
+
+	// only the modules on the proxy filesystem are supported when the server is disabled
+	server.enabled = false
+	ext := &ir.GatewayExtension{Wasm: &kgateway.WasmProvider{Module: kgateway.WasmModule{
+		ConfigMapRef: &kgateway.ConfigMapKeyReference{Name: "wasm", Key: "plugin.wasm"},
+	}}}
+	_, err := buildWasmProviderConfig(nil, ext, nil, server)
+	require.ErrorContains(t, err, "the Wasm module server is disabled")
+	ext.Wasm.Module = kgateway.WasmModule{LocalFile: new("/etc/envoy/plugin.wasm")}
+	_, err = buildWasmProviderConfig(nil, ext, nil, server)
+	require.NoError(t, err)
+}
+
+func TestWasmProviderConfigEquals(t *testing.T) {
+	newConfig := func(module []byte) *wasmProviderConfig {
+		return &wasmProviderConfig{
+			plugin: &wasmv3.PluginConfig{
+				Name: "default/wasm",
+				Vm: &wasmv3.PluginConfig_VmConfig{
+					VmConfig: &wasmv3.VmConfig{Runtime: "envoy.wasm.runtime.v8"},
+				},
+			},
+			code:         testWasmModuleServer.dataSource(wasm.SHA256(module)),
+			moduleSHA256: wasm.SHA256(module),
+			cluster:      testWasmModuleServer.cluster(),
+			stage:        filters.DuringStage(filters.AcceptedStage),
+		}
+	}
+
+	a := newConfig(testWasmModule)
+	assert.True(t, a.Equals(newConfig(bytes.Clone(testWasmModule))))
+	assert.False(t, a.Equals(newConfig(append(bytes.Clone(testWasmModule), 0x00))))
+
+	b := newConfig(testWasmModule)
+	b.stage = filters.BeforeStage(filters.AuthNStage)
+	assert.False(t, a.Equals(b))
+
+	// the module is fetched by the proxy from the controller and verified against its SHA-256
+	remote := a.wasmFilter().GetConfig().GetVmConfig().GetCode().GetRemote()
+	assert.Equal(t, wasm.SHA256(testWasmModule), remote.GetSha256())
+	assert.Equal(t, "http://kgateway.kgateway-system.svc.cluster.local:9978/modules/"+wasm.SHA256(testWasmModule), remote.GetHttpUri().GetUri())
+	assert.Equal(t, wasmModulesClusterName, remote.GetHttpUri().GetCluster())
+	assert.Nil(t, a.plugin.GetVmConfig().GetCode())
+}
+
+func TestConstructWasm(t *testing.T) {
+	wasmExt := &TrafficPolicyGatewayExtensionIR{Name: "default/wasm", Wasm: &wasmProviderConfig{}}
+	extAuthExt := &TrafficPolicyGatewayExtensionIR{Name: "default/extauth"}
+	fetch := func(_ krt.HandlerContext, ref shared.NamespacedObjectReference, _ string) (*TrafficPolicyGatewayExtensionIR, error) {
+		if ref.Name == "wasm" {
+			return wasmExt, nil
+		}
+		return extAuthExt, nil
+	}
+
+	tests := []struct {
+		name     string
+		wasm     *kgateway.WasmPolicy
+		expected *wasmIR
+		wantErr  error
+	}{
+		{
+			name: "nil wasm",
+		},
+		{
+			name: "disabled wasm",
+			wasm: &kgateway.WasmPolicy{
+				Disable: &shared.PolicyDisable{},
+			},
+			expected: &wasmIR{},
+		},
+		{
+			name: "wasm extension",
+			wasm: &kgateway.WasmPolicy{
+				ExtensionRefs: []shared.NamespacedObjectReference{{Name: "wasm"}},
+			},
+			expected: &wasmIR{providers: []*TrafficPolicyGatewayExtensionIR{wasmExt}},
+		},
+		{
+			name: "extension of another type",
+			wasm: &kgateway.WasmPolicy{
+				ExtensionRefs: []shared.NamespacedObjectReference{{Name: "wasm"}, {Name: "extauth"}},
+			},
+			wantErr: pluginutils.ErrInvalidExtensionType(kgateway.GatewayExtensionTypeWasm),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &trafficPolicySpecIr{}
+			err := constructWasm(nil, &kgateway.TrafficPolicy{
+				Spec: kgateway.TrafficPolicySpec{
+					Wasm: tt.wasm,
+				},
+			}, fetch, out)
+			if tt.wantErr != nil {
+				require.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equals(out.wasm))
+		})
+	}
+}
+
+func TestHandleWasm(t *testing.T) {
+	wasmExt := &TrafficPolicyGatewayExtensionIR{Name: "default/wasm", Wasm: &wasmProviderConfig{}}
+	p := &trafficPolicyPluginGwPass{}
+
+	vhostConfig := ir.TypedFilterConfigMap{}
+	p.handleWasm("listener~80", &vhostConfig, &wasmIR{providers: []*TrafficPolicyGatewayExtensionIR{wasmExt}})
+	assert.Equal(t, EnableFilterPerRoute(), vhostConfig.GetTypedConfig(wasmFilterName("default/wasm")))
+	assert.Contains(t, p.wasmInChain["listener~80"], "default/wasm")
+
+	routeConfig := ir.TypedFilterConfigMap{}
+	p.handleWasm("listener~80", &routeConfig, &wasmIR{})
+	assert.Equal(t, EnableFilterPerRoute(), routeConfig.GetTypedConfig(wasmGlobalDisableFilterName))
+	assert.Nil(t, routeConfig.GetTypedConfig(wasmFilterName("default/wasm")))
+}
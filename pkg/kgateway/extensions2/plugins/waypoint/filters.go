@@ -64,7 +64,7 @@ func customFiltersHelper(
 ) *ir.CustomEnvoyFilter {
 	return &ir.CustomEnvoyFilter{
 		FilterStage: filters.HTTPOrNetworkFilterStage{
-			RelativeTo:     filters.WellKnownFilterStage(int(stage)),
+			RelativeTo:     filters.ConvertFilterStage(&filters.FilterStageSpec{Stage: stage}).RelativeTo,
 			RelativeWeight: int(predicate),
 		},
 		Name:   name,
@@ -0,0 +1,144 @@
+package xbackendtrafficpolicy
+
+import (
+	"context"
+	"time"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	stateful_sessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/stateful_session/v3"
+	"google.golang.org/protobuf/proto"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
+	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/policy"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	pluginutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+var logger = logging.New("plugin/xbackendtrafficpolicy")
+
+// backendTrafficPolicy is the IR of an XBackendTrafficPolicy. XBackendTrafficPolicy supersedes the
+// BackendLBPolicy of earlier Gateway API releases to configure session persistence per backend.
+type backendTrafficPolicy struct {
+	// +noKrtEquals
+	ct                 time.Time
+	sessionPersistence *stateful_sessionv3.StatefulSessionPerRoute
+}
+
+var (
+	_ ir.PolicyIR                   = &backendTrafficPolicy{}
+	_ ir.SessionPersistencePolicyIR = &backendTrafficPolicy{}
+)
+
+func (d *backendTrafficPolicy) CreationTime() time.Time {
+	return d.ct
+}
+
+func (d *backendTrafficPolicy) Equals(in any) bool {
+	d2, ok := in.(*backendTrafficPolicy)
+	if !ok {
+		return false
+	}
+	return proto.Equal(d.sessionPersistence, d2.sessionPersistence)
+}
+
+// GetSessionPersistence returns the session persistence the policy configures for routes to its targets.
+func (d *backendTrafficPolicy) GetSessionPersistence() *stateful_sessionv3.StatefulSessionPerRoute {
+	return d.sessionPersistence
+}
+
+func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sdk.Plugin {
+	// ON_EXPERIMENTAL_PROMOTION : Remove this block
+	if !commoncol.Settings.EnableExperimentalGatewayAPIFeatures {
+		return sdk.Plugin{}
+	}
+
+	cli := kclient.NewFilteredDelayed[*gwxv1a1.XBackendTrafficPolicy](
+		commoncol.Client,
+		wellknown.XBackendTrafficPolicyGVR,
+		kclient.Filter{ObjectFilter: commoncol.Client.ObjectFilter()},
+	)
+	col := krt.WrapClient(cli, commoncol.KrtOpts.ToOptions("XBackendTrafficPolicy")...)
+	gk := wellknown.XBackendTrafficPolicyGVK.GroupKind()
+
+	policyStatusMarker, policyCol := krt.NewStatusCollection(col, func(krtctx krt.HandlerContext, i *gwxv1a1.XBackendTrafficPolicy) (*krtcollections.StatusMarker, *ir.PolicyWrapper) {
+		// Create status marker if existing status has kgateway controller
+		var statusMarker *krtcollections.StatusMarker
+		for _, ancestor := range i.Status.Ancestors {
+			if string(ancestor.ControllerName) == commoncol.ControllerName {
+				statusMarker = &krtcollections.StatusMarker{}
+				break
+			}
+		}
+
+		policyIR := &backendTrafficPolicy{
+			ct: i.CreationTimestamp.Time,
+		}
+		pol := &ir.PolicyWrapper{
+			ObjectSource: ir.ObjectSource{
+				Group:     gk.Group,
+				Kind:      gk.Kind,
+				Namespace: i.Namespace,
+				Name:      i.Name,
+			},
+			Policy:     i,
+			PolicyIR:   policyIR,
+			TargetRefs: pluginutils.TargetRefsToPolicyRefsV1(i.Spec.TargetRefs),
+		}
+
+		sessionPersistence, err := policy.BuildSessionPersistence(i.Spec.SessionPersistence)
+		if err != nil {
+			logger.Error("failed to translate session persistence", "policy", i.Name, "namespace", i.Namespace, "error", err)
+			pol.Errors = []error{err}
+		}
+		policyIR.sessionPersistence = sessionPersistence
+		return statusMarker, pol
+	})
+
+	// processMarkers for policies that have existing status but no current report
+	processMarkers := func(kctx krt.HandlerContext, reportMap *reports.ReportMap) {
+		objStatus := krt.Fetch(kctx, policyStatusMarker)
+		for _, status := range objStatus {
+			policyKey := reporter.PolicyKey{
+				Group:     gk.Group,
+				Kind:      gk.Kind,
+				Namespace: status.Obj.GetNamespace(),
+				Name:      status.Obj.GetName(),
+			}
+
+			// Add empty status to clear stale status for policies with no valid targets
+			if reportMap.Policies[policyKey] == nil {
+				rp := reports.NewReporter(reportMap)
+				// create empty policy report entry with no ancestor refs
+				rp.Policy(policyKey, 0)
+			}
+		}
+	}
+
+	return sdk.Plugin{
+		ContributesPolicies: map[schema.GroupKind]sdk.PolicyPlugin{
+			gk: {
+				Name:                            "XBackendTrafficPolicy",
+				Policies:                        policyCol,
+				ProcessPolicyStaleStatusMarkers: processMarkers,
+				ProcessBackend:                  processBackend,
+				GetPolicyStatus:                 getPolicyStatusFn(cli),
+				PatchPolicyStatus:               patchPolicyStatusFn(cli),
+			},
+		},
+	}
+}
+
+// processBackend is a no-op: session persistence is applied on the routes to the backend by the
+// built-in plugin, not on the cluster. It is required for the policy to be attached to backends.
+func processBackend(ctx context.Context, polir ir.PolicyIR, in ir.BackendObjectIR, out *envoyclusterv3.Cluster) {
+}
@@ -14,12 +14,14 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/plugins/destrule"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/plugins/directresponse"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/plugins/httplistenerpolicy"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/plugins/inferencepool"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/plugins/istio"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/plugins/kubernetes"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/plugins/listenerpolicy"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/plugins/sandwich"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/plugins/serviceentry"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/plugins/trafficpolicy"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/plugins/xbackendtrafficpolicy"
 	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
 	pluginsdkcol "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
@@ -92,5 +94,7 @@ func Plugins(
 		serviceentry.NewPlugin(ctx, commoncol),
 		sandwich.NewPlugin(),
 		backendconfigpolicy.NewPlugin(ctx, commoncol, validator),
+		inferencepool.NewPlugin(ctx, commoncol),
+		xbackendtrafficpolicy.NewPlugin(ctx, commoncol),
 	}
 }
@@ -6,6 +6,7 @@ import (
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	"github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/endpoints"
@@ -146,3 +147,119 @@ func TestTranslatesDestrulesFailover(t *testing.T) {
 	g.Expect(localLocality.Priority).To(gomega.Equal(uint32(0)))
 	g.Expect(remoteLocality.Priority).To(gomega.Equal(uint32(1)))
 }
+
+func TestZoneAwarePriorities(t *testing.T) {
+	newInputs := func(priorityInfo *endpoints.PriorityInfo) endpoints.EndpointsInputs {
+		efu := ir.NewEndpointsForBackend(ir.BackendObjectIR{
+			ObjectSource: ir.ObjectSource{
+				Namespace: "ns",
+				Name:      "name",
+			},
+		})
+		addZoneEndpoint(efu, ir.PodLocality{Region: "R1", Zone: "Z1"}, "a")
+		addZoneEndpoint(efu, ir.PodLocality{Region: "R1", Zone: "Z2"}, "b")
+		addZoneEndpoint(efu, ir.PodLocality{Region: "R2", Zone: "Z3"}, "c")
+		return endpoints.EndpointsInputs{
+			EndpointsForBackend: *efu,
+			PriorityInfo:        priorityInfo,
+		}
+	}
+	ucc := ir.UniqlyConnectedClient{
+		Namespace: "ns",
+		Locality:  ir.PodLocality{Region: "R1", Zone: "Z1"},
+		Labels: map[string]string{
+			corev1.LabelTopologyRegion: "R1",
+			corev1.LabelTopologyZone:   "Z1",
+		},
+	}
+	zoneAware := func() *endpoints.PriorityInfo {
+		return &endpoints.PriorityInfo{
+			FailoverPriority: endpoints.NewPriorities([]string{
+				corev1.LabelTopologyRegion,
+				corev1.LabelTopologyZone,
+			}),
+			OverprovisioningFactor: 125,
+		}
+	}
+
+	t.Run("prioritizes the zone then the region of the proxy", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		cla := endpoints.PrioritizeEndpoints(nil, ucc, newInputs(zoneAware()))
+		g.Expect(priorityByZone(cla)).To(gomega.Equal(map[string]uint32{"Z1": 0, "Z2": 1, "Z3": 2}))
+		g.Expect(cla.GetPolicy().GetOverprovisioningFactor().GetValue()).To(gomega.Equal(uint32(125)))
+	})
+
+	t.Run("keeps all endpoints at the same priority below the minimum number of endpoints", func(t *testing.T) {
+		g := gomega.NewWithT(t)
+		priorityInfo := zoneAware()
+		priorityInfo.MinEndpoints = 4
+		cla := endpoints.PrioritizeEndpoints(nil, ucc, newInputs(priorityInfo))
+		g.Expect(priorityByZone(cla)).To(gomega.Equal(map[string]uint32{"Z1": 0, "Z2": 0, "Z3": 0}))
+	})
+}
+
+func TestLocalityWeights(t *testing.T) {
+	g := gomega.NewWithT(t)
+	efu := ir.NewEndpointsForBackend(ir.BackendObjectIR{
+		ObjectSource: ir.ObjectSource{
+			Namespace: "ns",
+			Name:      "name",
+		},
+	})
+	addZoneEndpoint(efu, ir.PodLocality{Region: "R1", Zone: "Z1"}, "a")
+	addZoneEndpoint(efu, ir.PodLocality{Region: "R1", Zone: "Z2"}, "b")
+	addZoneEndpoint(efu, ir.PodLocality{Region: "R2", Zone: "Z3"}, "c")
+	addZoneEndpoint(efu, ir.PodLocality{Region: "R3", Zone: "Z4"}, "d")
+	ucc := ir.UniqlyConnectedClient{
+		Namespace: "ns",
+		Locality:  ir.PodLocality{Region: "R1", Zone: "Z1"},
+	}
+
+	cla := endpoints.PrioritizeEndpoints(nil, ucc, endpoints.EndpointsInputs{
+		EndpointsForBackend: *efu,
+		PriorityInfo: &endpoints.PriorityInfo{
+			LocalityWeights: []endpoints.LocalityWeight{
+				{Locality: ir.PodLocality{Region: "R1", Zone: "Z1"}, Weight: 80},
+				{Locality: ir.PodLocality{Region: "R1"}, Weight: 15},
+				{Locality: ir.PodLocality{Region: "R2"}, Weight: 5},
+			},
+		},
+	})
+
+	weights := map[string]uint32{}
+	for _, localityEndpoints := range cla.GetEndpoints() {
+		// the locality failover is not applied with locality weights
+		g.Expect(localityEndpoints.GetPriority()).To(gomega.Equal(uint32(0)))
+		weights[localityEndpoints.GetLocality().GetZone()] = localityEndpoints.GetLoadBalancingWeight().GetValue()
+	}
+	g.Expect(weights).To(gomega.Equal(map[string]uint32{"Z1": 80, "Z2": 15, "Z3": 5, "Z4": 0}))
+}
+
+func addZoneEndpoint(efu *ir.EndpointsForBackend, locality ir.PodLocality, path string) {
+	efu.Add(locality, ir.EndpointWithMd{
+		LbEndpoint: &envoyendpointv3.LbEndpoint{
+			HostIdentifier: &envoyendpointv3.LbEndpoint_Endpoint{
+				Endpoint: &envoyendpointv3.Endpoint{
+					Address: &envoycorev3.Address{
+						Address: &envoycorev3.Address_Pipe{Pipe: &envoycorev3.Pipe{Path: path}},
+					},
+				},
+			},
+			LoadBalancingWeight: wrapperspb.UInt32(1),
+		},
+		EndpointMd: ir.EndpointMetadata{
+			Labels: map[string]string{
+				corev1.LabelTopologyRegion: locality.Region,
+				corev1.LabelTopologyZone:   locality.Zone,
+			},
+		},
+	})
+}
+
+func priorityByZone(cla *envoyendpointv3.ClusterLoadAssignment) map[string]uint32 {
+	priorities := map[string]uint32{}
+	for _, localityEndpoints := range cla.GetEndpoints() {
+		priorities[localityEndpoints.GetLocality().GetZone()] = localityEndpoints.GetPriority()
+	}
+	return priorities
+}
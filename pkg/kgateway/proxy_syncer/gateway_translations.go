@@ -0,0 +1,104 @@
+package proxy_syncer
+
+import (
+	"cmp"
+	"slices"
+	"sync/atomic"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
+	plug "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+// GatewayTranslations gives access to the latest translation of each Gateway, i.e., its IR and the
+// resulting route configurations. It is used by the admin server to explain how requests are routed
+// and how the attached policies are merged.
+//
+// It is created before the ProxySyncer, which starts tracking its translations when initialized.
+type GatewayTranslations struct {
+	snapshots atomic.Pointer[krt.Collection[GatewayXdsResources]]
+	// mergePolicies holds the MergePolicies function of the policy plugins supporting merging
+	mergePolicies map[schema.GroupKind]MergePoliciesFunc
+}
+
+// MergePoliciesFunc merges policies ordered from high to low priority into a single policy.
+type MergePoliciesFunc func(pols []ir.PolicyAtt) ir.PolicyAtt
+
+// GatewayTranslation is the latest translation of a Gateway.
+type GatewayTranslation struct {
+	types.NamespacedName
+	Gateway *ir.GatewayIR
+	// Routes are the route configurations translated from the Gateway, keyed by name
+	Routes map[string]*envoyroutev3.RouteConfiguration
+	// MergePolicies holds the functions merging the policies of each GroupKind, for the policies supporting merging
+	MergePolicies map[schema.GroupKind]MergePoliciesFunc
+}
+
+func NewGatewayTranslations() *GatewayTranslations {
+	return &GatewayTranslations{}
+}
+
+func (t *GatewayTranslations) track(snapshots krt.Collection[GatewayXdsResources], policies plug.ContributesPolicies) {
+	t.mergePolicies = make(map[schema.GroupKind]MergePoliciesFunc)
+	for gk, p := range policies {
+		if p.MergePolicies != nil {
+			t.mergePolicies[gk] = p.MergePolicies
+		}
+	}
+	t.snapshots.Store(&snapshots)
+}
+
+// Get returns the latest translation of the Gateway, or nil if it was not translated (yet).
+func (t *GatewayTranslations) Get(gw types.NamespacedName) *GatewayTranslation {
+	snapshots := t.snapshots.Load()
+	if snapshots == nil {
+		return nil
+	}
+	res := (*snapshots).GetKey(xds.OwnerNamespaceNameID(wellknown.GatewayApiProxyValue, gw.Namespace, gw.Name))
+	if res == nil {
+		return nil
+	}
+	return t.toTranslation(*res)
+}
+
+// List returns the latest translation of all the Gateways, sorted by namespace and name.
+func (t *GatewayTranslations) List() []*GatewayTranslation {
+	snapshots := t.snapshots.Load()
+	if snapshots == nil {
+		return nil
+	}
+	var out []*GatewayTranslation
+	for _, res := range (*snapshots).List() {
+		if translation := t.toTranslation(res); translation != nil {
+			out = append(out, translation)
+		}
+	}
+	slices.SortFunc(out, func(a, b *GatewayTranslation) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+	return out
+}
+
+func (t *GatewayTranslations) toTranslation(res GatewayXdsResources) *GatewayTranslation {
+	if res.gatewayIR == nil {
+		return nil
+	}
+	out := &GatewayTranslation{
+		NamespacedName: res.NamespacedName,
+		Gateway:        res.gatewayIR,
+		Routes:         make(map[string]*envoyroutev3.RouteConfiguration, len(res.Routes.Items)),
+		MergePolicies:  t.mergePolicies,
+	}
+	for name, r := range res.Routes.Items {
+		if rc, ok := r.Resource.(*envoyroutev3.RouteConfiguration); ok {
+			out.Routes[name] = rc
+		}
+	}
+	return out
+}
@@ -26,5 +26,11 @@ func (s *ProxyTranslator) syncXds(
 	// TODO: this is also may not be needed now that envoy has
 	// a default initial fetch timeout
 	// snap.MakeConsistent()
+	if s.snapshots != nil {
+		// a resync of the pinned resources must not set an older snapshot after this one
+		unlock := s.snapshots.lockProxy(proxyKey)
+		defer unlock()
+		snap = s.snapshots.apply(proxyKey, snap)
+	}
 	s.xdsCache.SetSnapshot(ctx, proxyKey, snap)
 }
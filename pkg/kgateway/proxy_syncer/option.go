@@ -3,11 +3,15 @@ package proxy_syncer
 import (
 	"context"
 
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
 	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
 )
 
 type statusSyncerConfig struct {
 	CustomStatusSync func(ctx context.Context, rm reports.ReportMap)
+	XdsRejections    *xds.RejectionTracker
+	// GatewayTranslations are used to trace the xDS rejections back to the routes
+	GatewayTranslations *GatewayTranslations
 }
 
 type StatusSyncerOption func(*statusSyncerConfig)
@@ -27,3 +31,17 @@ func WithCustomStatusSync(customSync func(ctx context.Context, rm reports.Report
 		}
 	}
 }
+
+// WithXdsRejections reports the xDS responses rejected by the proxies on the status of the Gateways
+// and of the resources contributing to the rejected configuration, traced back from the route names
+// using the translations of the Gateways. Only the proxies connected to this replica are tracked.
+func WithXdsRejections(rejections *xds.RejectionTracker, translations *GatewayTranslations) StatusSyncerOption {
+	return func(cfg *statusSyncerConfig) {
+		if rejections != nil {
+			cfg.XdsRejections = rejections
+		}
+		if translations != nil {
+			cfg.GatewayTranslations = translations
+		}
+	}
+}
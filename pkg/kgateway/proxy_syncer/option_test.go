@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
 	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
 )
@@ -17,3 +18,13 @@ func TestWithCustomStatusSync(t *testing.T) {
 
 	assert.NotNil(t, statusSyncer.customStatusSync)
 }
+
+func TestWithXdsRejections(t *testing.T) {
+	rejections := xds.NewRejectionTracker()
+	translations := NewGatewayTranslations()
+	statusSyncer := NewStatusSyncer(nil, pluginsdk.Plugin{}, "controller-name", nil, nil, nil, nil, nil,
+		WithXdsRejections(rejections, translations))
+
+	assert.Equal(t, rejections, statusSyncer.xdsRejections)
+	assert.Equal(t, translations, statusSyncer.gatewayTranslations)
+}
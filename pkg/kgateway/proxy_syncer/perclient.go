@@ -24,11 +24,15 @@ type clustersWithErrors struct {
 	erroredClusters     []string
 	erroredClustersHash uint64
 	clustersHash        uint64
-	resourceName        string
+	// +noKrtEquals
+	versions     resourceVersions
+	resourceName string
 }
 
 type endpointsWithUccName struct {
-	endpoints    envoycache.Resources
+	endpoints envoycache.Resources
+	// +noKrtEquals
+	versions     resourceVersions
 	resourceName string
 }
 
@@ -68,6 +72,7 @@ func snapshotPerClient(
 		logger.Debug("found perclient clusters", "client", ucc.ResourceName(), "clusters", len(clustersForUcc))
 
 		clustersProto := make([]envoycachetypes.ResourceWithTTL, 0, len(clustersForUcc))
+		versions := make(resourceVersions, len(clustersForUcc))
 		var (
 			clustersHash        uint64
 			erroredClustersHash uint64
@@ -84,6 +89,7 @@ func snapshotPerClient(
 				continue
 			}
 			clustersProto = append(clustersProto, envoycachetypes.ResourceWithTTL{Resource: c.Cluster})
+			versions[c.Name] = formatVersion(c.ClusterVersion)
 			clustersHash ^= c.ClusterVersion
 		}
 		clustersVersion := fmt.Sprintf("%d", clustersHash)
@@ -95,6 +101,7 @@ func snapshotPerClient(
 			erroredClusters:     erroredClusters,
 			clustersHash:        clustersHash,
 			erroredClustersHash: erroredClustersHash,
+			versions:            versions,
 			resourceName:        ucc.ResourceName(),
 		}
 	}, krtopts.ToOptions("ClusterResources")...)
@@ -102,15 +109,18 @@ func snapshotPerClient(
 	endpointResources := krt.NewCollection(uccCol, func(kctx krt.HandlerContext, ucc ir.UniqlyConnectedClient) *endpointsWithUccName {
 		endpointsForUcc := endpoints.FetchEndpointsForClient(kctx, ucc)
 		endpointsProto := make([]envoycachetypes.ResourceWithTTL, 0, len(endpointsForUcc))
+		versions := make(resourceVersions, len(endpointsForUcc))
 		var endpointsHash uint64
 		for _, ep := range endpointsForUcc {
 			endpointsProto = append(endpointsProto, envoycachetypes.ResourceWithTTL{Resource: ep.Endpoints})
+			versions[ep.Endpoints.GetClusterName()] = formatVersion(ep.EndpointsHash)
 			endpointsHash ^= ep.EndpointsHash
 		}
 
 		endpointResources := envoycache.NewResourcesWithTTL(fmt.Sprintf("%d", endpointsHash), endpointsProto)
 		return &endpointsWithUccName{
 			endpoints:    endpointResources,
+			versions:     versions,
 			resourceName: ucc.ResourceName(),
 		}
 	}, krtopts.ToOptions("EndpointResources")...)
@@ -145,13 +155,17 @@ func snapshotPerClient(
 
 		logger.Debug("found perclient clusters", "client", ucc.ResourceName(), "clusters", len(clustersForUcc.clusters.Items))
 		clusterResources := clustersForUcc.clusters
+		clusterVersions := clustersForUcc.versions
 
 		snap := XdsSnapWrapper{}
 		if len(listenerRouteSnapshot.Clusters) > 0 {
 			clustersProto := make(map[string]envoycachetypes.ResourceWithTTL, len(listenerRouteSnapshot.Clusters)+len(clustersForUcc.clusters.Items))
 			maps.Copy(clustersProto, clustersForUcc.clusters.Items)
+			clusterVersions = maps.Clone(clustersForUcc.versions)
 			for _, item := range listenerRouteSnapshot.Clusters {
-				clustersProto[envoycache.GetResourceName(item.Resource)] = item
+				name := envoycache.GetResourceName(item.Resource)
+				clustersProto[name] = item
+				clusterVersions[name] = listenerRouteSnapshot.versions[envoycachetypes.Cluster][name]
 			}
 			clusterResources.Version = fmt.Sprintf("%d", clustersForUcc.clustersHash^listenerRouteSnapshot.ClustersHash)
 			clusterResources.Items = clustersProto
@@ -167,6 +181,11 @@ func snapshotPerClient(
 		snapshot.Resources[envoycachetypes.Route] = listenerRouteSnapshot.Routes
 		snapshot.Resources[envoycachetypes.Listener] = listenerRouteSnapshot.Listeners
 		snapshot.Resources[envoycachetypes.Secret] = listenerRouteSnapshot.Secrets
+		// version each resource independently, so that only the changed resources are sent over delta xDS
+		versions := listenerRouteSnapshot.versions
+		versions[envoycachetypes.Cluster] = clusterVersions
+		versions[envoycachetypes.Endpoint] = clientEndpointResources.versions
+		setVersionMap(snapshot, versions)
 		// envoycache.NewResources(version, resource)
 		snap.snap = snapshot
 		logger.Debug("snapshots", "proxy_key", snap.proxyKey,
@@ -53,6 +53,7 @@ type ProxySyncer struct {
 	proxyTranslator ProxyTranslator
 
 	uniqueClients krt.Collection[ir.UniqlyConnectedClient]
+	translations  *GatewayTranslations
 
 	statusReport            krt.Singleton[report]
 	backendPolicyReport     krt.Singleton[report]
@@ -83,6 +84,15 @@ type GatewayXdsResources struct {
 
 	// Secrets are items in the SDS response payload.
 	Secrets envoycache.Resources
+
+	// versions holds the version of each cluster, route, listener and secret, indexed by type.
+	// The versions are derived from the same hashes as the versions of the resources above.
+	// +noKrtEquals
+	versions [envoycachetypes.UnknownType]resourceVersions
+
+	// gatewayIR is the IR the resources were translated from. It is kept to explain how requests are routed.
+	// +noKrtEquals
+	gatewayIR *ir.GatewayIR
 }
 
 func (r GatewayXdsResources) ResourceName() string {
@@ -98,38 +108,39 @@ func (r GatewayXdsResources) Equals(in GatewayXdsResources) bool {
 		r.Secrets.Version == in.Secrets.Version
 }
 
-func sliceToResourcesHash[T proto.Message](slice []T) ([]envoycachetypes.ResourceWithTTL, uint64) {
+func sliceToResourcesHash[T proto.Message](slice []T) ([]envoycachetypes.ResourceWithTTL, resourceVersions, uint64) {
 	var slicePb []envoycachetypes.ResourceWithTTL
+	versions := make(resourceVersions, len(slice))
 	var resourcesHash uint64
 	for _, r := range slice {
 		var m proto.Message = r
 		hash := utils.HashProto(r)
 		slicePb = append(slicePb, envoycachetypes.ResourceWithTTL{Resource: m})
+		versions[envoycache.GetResourceName(m)] = formatVersion(hash)
 		resourcesHash ^= hash
 	}
 
-	return slicePb, resourcesHash
+	return slicePb, versions, resourcesHash
 }
 
-func sliceToResources[T proto.Message](slice []T) envoycache.Resources {
-	r, h := sliceToResourcesHash(slice)
-	return envoycache.NewResourcesWithTTL(fmt.Sprintf("%d", h), r)
+func sliceToResources[T proto.Message](slice []T) (envoycache.Resources, resourceVersions) {
+	r, v, h := sliceToResourcesHash(slice)
+	return envoycache.NewResourcesWithTTL(fmt.Sprintf("%d", h), r), v
 }
 
 func toResources(gw ir.Gateway, xdsSnap irtranslator.TranslationResult, r reports.ReportMap) *GatewayXdsResources {
-	c, ch := sliceToResourcesHash(xdsSnap.ExtraClusters)
-	return &GatewayXdsResources{
+	res := &GatewayXdsResources{
 		NamespacedName: types.NamespacedName{
 			Namespace: gw.Obj.GetNamespace(),
 			Name:      gw.Obj.GetName(),
 		},
-		reports:      r,
-		ClustersHash: ch,
-		Clusters:     c,
-		Routes:       sliceToResources(xdsSnap.Routes),
-		Listeners:    sliceToResources(xdsSnap.Listeners),
-		Secrets:      sliceToResources(xdsSnap.Secrets),
+		reports: r,
 	}
+	res.Clusters, res.versions[envoycachetypes.Cluster], res.ClustersHash = sliceToResourcesHash(xdsSnap.ExtraClusters)
+	res.Routes, res.versions[envoycachetypes.Route] = sliceToResources(xdsSnap.Routes)
+	res.Listeners, res.versions[envoycachetypes.Listener] = sliceToResources(xdsSnap.Listeners)
+	res.Secrets, res.versions[envoycachetypes.Secret] = sliceToResources(xdsSnap.Secrets)
+	return res
 }
 
 // NewProxySyncer returns a ProxySyncer runnable
@@ -143,6 +154,8 @@ func NewProxySyncer(
 	mergedPlugins plug.Plugin,
 	commonCols *collections.CommonCollections,
 	xdsCache envoycache.SnapshotCache,
+	snapshots *SnapshotTracker,
+	translations *GatewayTranslations,
 	validator validator.Validator,
 ) *ProxySyncer {
 	return &ProxySyncer{
@@ -150,8 +163,9 @@ func NewProxySyncer(
 		commonCols:               commonCols,
 		mgr:                      mgr,
 		apiClient:                client,
-		proxyTranslator:          NewProxyTranslator(xdsCache),
+		proxyTranslator:          NewProxyTranslator(xdsCache, snapshots),
 		uniqueClients:            uniqueClients,
+		translations:             translations,
 		translator:               translator.NewCombinedTranslator(ctx, mergedPlugins, commonCols, validator),
 		plugins:                  mergedPlugins,
 		reportQueue:              utils.NewAsyncQueue[reports.ReportMap](),
@@ -161,11 +175,14 @@ func NewProxySyncer(
 
 type ProxyTranslator struct {
 	xdsCache envoycache.SnapshotCache
+	// snapshots tracks the resources ACKed by the clients, and pins the NACKed resources in rollback mode
+	snapshots *SnapshotTracker
 }
 
-func NewProxyTranslator(xdsCache envoycache.SnapshotCache) ProxyTranslator {
+func NewProxyTranslator(xdsCache envoycache.SnapshotCache, snapshots *SnapshotTracker) ProxyTranslator {
 	return ProxyTranslator{
-		xdsCache: xdsCache,
+		xdsCache:  xdsCache,
+		snapshots: snapshots,
 	}
 }
 
@@ -198,6 +215,9 @@ func (r report) Equals(in report) bool {
 	if !maps.Equal(r.reportMap.TLSRoutes, in.reportMap.TLSRoutes) {
 		return false
 	}
+	if !maps.Equal(r.reportMap.UDPRoutes, in.reportMap.UDPRoutes) {
+		return false
+	}
 	if !maps.Equal(r.reportMap.Policies, in.reportMap.Policies) {
 		return false
 	}
@@ -225,13 +245,18 @@ func (s *ProxySyncer) Init(ctx context.Context, krtopts krtutil.KrtOptions) {
 		// in GatewaysForEnvoyTransformationFunc in pkg/krtcollections/policy.go
 		logger.Debug("building proxy for kube gw", "name", client.ObjectKeyFromObject(gw.Obj), "version", gw.Obj.GetResourceVersion())
 
-		xdsSnap, rm := s.translator.TranslateGateway(kctx, ctx, gw)
+		xdsSnap, gwir, rm := s.translator.TranslateGatewayWithIR(kctx, ctx, gw)
 		if xdsSnap == nil {
 			return nil
 		}
 
-		return toResources(gw, *xdsSnap, rm)
+		res := toResources(gw, *xdsSnap, rm)
+		res.gatewayIR = gwir
+		return res
 	}, krtopts.ToOptions("MostXdsSnapshots")...)
+	if s.translations != nil {
+		s.translations.track(s.mostXdsSnapshots, s.plugins.ContributesPolicies)
+	}
 
 	epPerClient := NewPerClientEnvoyEndpoints(
 		krtopts,
@@ -347,6 +372,18 @@ func mergeProxyReports(
 			maps.Copy(merged.TLSRoutes[rnn].Parents, rr.Parents)
 		}
 
+		for rnn, rr := range p.reports.UDPRoutes {
+			// if we haven't encountered this route, just copy it over completely
+			old := merged.UDPRoutes[rnn]
+			if old == nil {
+				merged.UDPRoutes[rnn] = rr
+				continue
+			}
+			// else, this route has already been seen for a proxy, merge this proxy's parents
+			// into the merged report
+			maps.Copy(merged.UDPRoutes[rnn].Parents, rr.Parents)
+		}
+
 		for rnn, rr := range p.reports.GRPCRoutes {
 			// if we haven't encountered this route, just copy it over completely
 			old := merged.GRPCRoutes[rnn]
@@ -423,6 +460,9 @@ func (s *ProxySyncer) Start(ctx context.Context) error {
 				// if _, err := s.proxyTranslator.xdsCache.GetSnapshot(key); err == nil {
 				// 	s.proxyTranslator.xdsCache.ClearSnapshot(e.Latest().proxyKey)
 				// }
+				if s.proxyTranslator.snapshots != nil {
+					s.proxyTranslator.snapshots.forget(e.Latest().proxyKey)
+				}
 			}
 
 			kmetrics.EndResourceXDSSync(kmetrics.ResourceSyncDetails{
@@ -433,6 +473,13 @@ func (s *ProxySyncer) Start(ctx context.Context) error {
 		}
 	}, true)
 
+	if s.proxyTranslator.snapshots != nil {
+		// set the snapshots again when resources NACKed by the clients are pinned to their last ACKed version
+		go s.proxyTranslator.snapshots.resyncs(ctx, func(proxyKey string, snap *envoycache.Snapshot) {
+			s.proxyTranslator.xdsCache.SetSnapshot(ctx, proxyKey, snap)
+		})
+	}
+
 	s.ready.Store(true)
 	<-ctx.Done()
 	return nil
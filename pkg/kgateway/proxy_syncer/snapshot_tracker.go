@@ -0,0 +1,387 @@
+package proxy_syncer
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	xdsserver "github.com/envoyproxy/go-control-plane/pkg/server/v3"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
+)
+
+// SnapshotTracker tracks the xDS resources last ACKed by each uniquely connected client.
+//
+// When rollback is enabled, resources NACKed by a client are pinned to their last ACKed version,
+// while the other resources of the snapshot keep being updated. A resource stays pinned until its
+// translation changes, at which point the new version is sent to the client again.
+type SnapshotTracker struct {
+	xdsserver.CallbackFuncs
+	rollback bool
+
+	lock sync.Mutex
+	// clients is keyed by the resource name of the uniquely connected client, i.e., the snapshot cache key
+	clients map[string]*clientSnapshots
+	streams map[int64]*trackedStream
+	// resync holds the clients whose snapshot must be set again after new resources were pinned
+	resync       sets.Set[string]
+	resyncSignal chan struct{}
+	// proxyLocks serializes computing and setting the snapshot of each client, so that a snapshot is never
+	// set in the cache after a newer one. It is keyed like clients, and keeps an entry per client ever seen.
+	proxyLocks map[string]*sync.Mutex
+}
+
+type clientSnapshots struct {
+	// desired is the latest snapshot translated for the client
+	desired *envoycache.Snapshot
+	// sent is the snapshot set in the cache for the client, i.e., desired with the pinned resources
+	sent *envoycache.Snapshot
+	// acked holds the last ACKed resources of each type
+	acked [envoycachetypes.UnknownType]envoycache.Resources
+	// pinned holds the resources of each type pinned to their last ACKed version, keyed by name
+	pinned [envoycachetypes.UnknownType]map[string]pinnedResource
+	// pinGeneration is incremented whenever resources are pinned, to version the sent snapshots
+	pinGeneration int
+}
+
+type pinnedResource struct {
+	// rejected is the translated resource rejected by the client
+	rejected envoycachetypes.Resource
+	// err is the error reported by the client when rejecting the resource
+	err string
+}
+
+type trackedStream struct {
+	// proxyKey is the resource name of the uniquely connected client of the stream
+	proxyKey string
+	// responses holds the nonce and version of the last response sent on the stream for each type URL
+	responses map[string]sentResponse
+}
+
+type sentResponse struct {
+	nonce   string
+	version string
+}
+
+// PinnedResource is an xDS resource NACKed by a client and pinned to its last ACKed version.
+type PinnedResource struct {
+	TypeUrl string `json:"typeUrl"`
+	Name    string `json:"name"`
+	// AckedVersion is the version of the last ACKed resources of the type.
+	// The resource is removed from the snapshot if it is not part of them.
+	AckedVersion string `json:"ackedVersion"`
+	// Error is the error reported by the client when rejecting the resource.
+	Error string `json:"error"`
+}
+
+var _ xdsserver.Callbacks = &SnapshotTracker{}
+
+func NewSnapshotTracker(rollback bool) *SnapshotTracker {
+	return &SnapshotTracker{
+		rollback:     rollback,
+		clients:      make(map[string]*clientSnapshots),
+		streams:      make(map[int64]*trackedStream),
+		resync:       sets.New[string](),
+		resyncSignal: make(chan struct{}, 1),
+		proxyLocks:   make(map[string]*sync.Mutex),
+	}
+}
+
+// OnStreamRequest records the resources ACKed by the client of the stream, and pins the NACKed resources
+// when rollback is enabled.
+// The node of the request is expected to be augmented with the unique client name by the
+// uniquely connected clients callbacks beforehand.
+func (t *SnapshotTracker) OnStreamRequest(streamID int64, req *discoveryv3.DiscoveryRequest) error {
+	t.onRequest(streamID, req.GetNode(), req.GetTypeUrl(), req.GetResponseNonce(), req.GetErrorDetail())
+	return nil
+}
+
+// OnStreamDeltaRequest is the incremental xDS counterpart of OnStreamRequest. A delta response only holds
+// the resources that changed, so ACKing it ACKs all the resources of the type sent to the client.
+func (t *SnapshotTracker) OnStreamDeltaRequest(streamID int64, req *discoveryv3.DeltaDiscoveryRequest) error {
+	t.onRequest(streamID, req.GetNode(), req.GetTypeUrl(), req.GetResponseNonce(), req.GetErrorDetail())
+	return nil
+}
+
+func (t *SnapshotTracker) onRequest(streamID int64, node *envoycorev3.Node, typeUrl, nonce string, errorDetail *status.Status) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	stream := t.streams[streamID]
+	proxyKey := node.GetMetadata().GetFields()[xds.RoleKey].GetStringValue()
+	if node == nil && stream != nil {
+		// the node may only be set on the first request of the stream
+		proxyKey = stream.proxyKey
+	}
+	if !xds.IsKubeGatewayCacheKey(proxyKey) {
+		return
+	}
+
+	if stream == nil {
+		stream = &trackedStream{responses: make(map[string]sentResponse)}
+		t.streams[streamID] = stream
+	}
+	stream.proxyKey = proxyKey
+
+	// the initial request of a type has no nonce and neither ACKs nor NACKs a response
+	sent, ok := stream.responses[typeUrl]
+	if !ok || nonce != sent.nonce {
+		return
+	}
+	client := t.clients[proxyKey]
+	typ := envoycache.GetResponseType(typeUrl)
+	if client == nil || client.sent == nil || typ == envoycachetypes.UnknownType {
+		return
+	}
+	// ignore responses superseded by a newer snapshot
+	if client.sent.Resources[typ].Version != sent.version {
+		return
+	}
+
+	if errorDetail == nil {
+		client.acked[typ] = client.sent.Resources[typ]
+		return
+	}
+	if t.rollback && client.pinRejected(typ, errorDetail.GetMessage()) {
+		client.sent = client.withPins()
+		t.resync.Insert(proxyKey)
+		select {
+		case t.resyncSignal <- struct{}{}:
+		default: // a resync is already pending
+		}
+	}
+}
+
+// OnStreamResponse records the version of the resources sent to the client of the stream.
+func (t *SnapshotTracker) OnStreamResponse(_ context.Context, streamID int64, _ *discoveryv3.DiscoveryRequest, resp *discoveryv3.DiscoveryResponse) {
+	t.onResponse(streamID, resp.GetTypeUrl(), resp.GetNonce(), resp.GetVersionInfo())
+}
+
+// OnStreamDeltaResponse records the version of the resources sent to the client of the stream.
+// The system version of a delta response is the version of the resources of the type in the snapshot.
+func (t *SnapshotTracker) OnStreamDeltaResponse(streamID int64, _ *discoveryv3.DeltaDiscoveryRequest, resp *discoveryv3.DeltaDiscoveryResponse) {
+	t.onResponse(streamID, resp.GetTypeUrl(), resp.GetNonce(), resp.GetSystemVersionInfo())
+}
+
+func (t *SnapshotTracker) onResponse(streamID int64, typeUrl, nonce, version string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if stream := t.streams[streamID]; stream != nil {
+		stream.responses[typeUrl] = sentResponse{nonce: nonce, version: version}
+	}
+}
+
+func (t *SnapshotTracker) OnStreamClosed(streamID int64, _ *envoycorev3.Node) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.streams, streamID)
+}
+
+func (t *SnapshotTracker) OnDeltaStreamClosed(streamID int64, node *envoycorev3.Node) {
+	t.OnStreamClosed(streamID, node)
+}
+
+// lockProxy locks the snapshot of a client until the returned function is called. It must be held
+// from computing the snapshot of the client to setting it in the cache. The cache is not set while
+// holding t.lock, as setting the cache may wait for the stream callbacks, which take t.lock.
+func (t *SnapshotTracker) lockProxy(proxyKey string) (unlock func()) {
+	t.lock.Lock()
+	l := t.proxyLocks[proxyKey]
+	if l == nil {
+		l = &sync.Mutex{}
+		t.proxyLocks[proxyKey] = l
+	}
+	t.lock.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+// apply records the snapshot translated for the client and returns the snapshot to set in the cache,
+// i.e., the snapshot with the NACKed resources pinned to their last ACKed version.
+func (t *SnapshotTracker) apply(proxyKey string, snap *envoycache.Snapshot) *envoycache.Snapshot {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	client := t.clients[proxyKey]
+	if client == nil {
+		client = &clientSnapshots{}
+		t.clients[proxyKey] = client
+	}
+	client.desired = snap
+	client.sent = client.withPins()
+	return client.sent
+}
+
+// forget stops tracking the snapshots of a client that is no longer connected.
+func (t *SnapshotTracker) forget(proxyKey string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.clients, proxyKey)
+	t.resync.Delete(proxyKey)
+}
+
+// resyncs calls setSnapshot with the snapshot of each client whose resources were pinned,
+// until the context is done. The snapshot is read under the lock of the client, so that it is
+// not set after a newer snapshot applied concurrently.
+func (t *SnapshotTracker) resyncs(ctx context.Context, setSnapshot func(proxyKey string, snap *envoycache.Snapshot)) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.resyncSignal:
+		}
+
+		t.lock.Lock()
+		proxyKeys := t.resync.UnsortedList()
+		t.resync.Clear()
+		t.lock.Unlock()
+
+		for _, proxyKey := range proxyKeys {
+			t.resyncProxy(proxyKey, setSnapshot)
+		}
+	}
+}
+
+func (t *SnapshotTracker) resyncProxy(proxyKey string, setSnapshot func(proxyKey string, snap *envoycache.Snapshot)) {
+	unlock := t.lockProxy(proxyKey)
+	defer unlock()
+
+	t.lock.Lock()
+	var snap *envoycache.Snapshot
+	if client := t.clients[proxyKey]; client != nil {
+		snap = client.sent
+	}
+	t.lock.Unlock()
+
+	if snap != nil {
+		setSnapshot(proxyKey, snap)
+	}
+}
+
+// PinnedResources returns the resources currently pinned to their last ACKed version for each client.
+func (t *SnapshotTracker) PinnedResources() map[string][]PinnedResource {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	out := make(map[string][]PinnedResource)
+	for proxyKey, client := range t.clients {
+		for typ, pinned := range client.pinned {
+			typeUrl, _ := envoycache.GetResponseTypeURL(envoycachetypes.ResponseType(typ))
+			for _, name := range slices.Sorted(maps.Keys(pinned)) {
+				out[proxyKey] = append(out[proxyKey], PinnedResource{
+					TypeUrl:      typeUrl,
+					Name:         name,
+					AckedVersion: client.acked[typ].Version,
+					Error:        pinned[name].err,
+				})
+			}
+		}
+	}
+	return out
+}
+
+// pinRejected pins the resources of the sent snapshot rejected by the client to their last ACKed version.
+// The rejected resources are the resources that changed since the last ACK. If the error message names
+// some of them, only those are pinned. Returns true if resources were pinned.
+func (c *clientSnapshots) pinRejected(typ envoycachetypes.ResponseType, errMsg string) bool {
+	// without an ACKed version, there is no known good version to pin the resources to
+	if c.acked[typ].Version == "" {
+		return false
+	}
+
+	var changed []string
+	for name, res := range c.sent.Resources[typ].Items {
+		acked, ok := c.acked[typ].Items[name]
+		if !ok || !proto.Equal(acked.Resource, res.Resource) {
+			changed = append(changed, name)
+		}
+	}
+	named := slices.DeleteFunc(slices.Clone(changed), func(name string) bool {
+		return !strings.Contains(errMsg, name)
+	})
+	if len(named) > 0 {
+		changed = named
+	}
+	if len(changed) == 0 {
+		return false
+	}
+
+	if c.pinned[typ] == nil {
+		c.pinned[typ] = make(map[string]pinnedResource, len(changed))
+	}
+	for _, name := range changed {
+		c.pinned[typ][name] = pinnedResource{
+			rejected: c.sent.Resources[typ].Items[name].Resource,
+			err:      errMsg,
+		}
+	}
+	c.pinGeneration++
+	return true
+}
+
+// withPins returns the desired snapshot with the pinned resources replaced by their last ACKed version.
+// Pinned resources whose translation changed since they were rejected are unpinned, so that their new
+// version is sent to the client.
+func (c *clientSnapshots) withPins() *envoycache.Snapshot {
+	var out *envoycache.Snapshot
+	for typ, pinned := range c.pinned {
+		for name, p := range pinned {
+			desired, ok := c.desired.Resources[typ].Items[name]
+			if !ok || !proto.Equal(desired.Resource, p.rejected) {
+				delete(pinned, name)
+			}
+		}
+		if len(pinned) == 0 {
+			c.pinned[typ] = nil
+			continue
+		}
+
+		if out == nil {
+			// Resources is an array, so this copies the resources of each type of the desired snapshot
+			out = &envoycache.Snapshot{Resources: c.desired.Resources}
+			if c.desired.VersionMap != nil {
+				out.VersionMap = maps.Clone(c.desired.VersionMap)
+			}
+		}
+		items := maps.Clone(c.desired.Resources[typ].Items)
+		typeUrl, _ := envoycache.GetResponseTypeURL(envoycachetypes.ResponseType(typ))
+		versionMap := maps.Clone(out.VersionMap[typeUrl])
+		for name := range pinned {
+			if acked, ok := c.acked[typ].Items[name]; ok {
+				items[name] = acked
+				if versionMap != nil {
+					versionMap[name] = formatVersion(utils.HashProto(acked.Resource))
+				}
+			} else {
+				delete(items, name)
+				delete(versionMap, name)
+			}
+		}
+		if versionMap != nil {
+			out.VersionMap[typeUrl] = versionMap
+		}
+		out.Resources[typ] = envoycache.Resources{
+			Version: fmt.Sprintf("%s-pinned-%d", c.desired.Resources[typ].Version, c.pinGeneration),
+			Items:   items,
+		}
+	}
+	if out == nil {
+		return c.desired
+	}
+	return out
+}
@@ -0,0 +1,219 @@
+package proxy_syncer
+
+import (
+	"context"
+	"testing"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
+)
+
+const testProxyKey = "kgateway-kube-gateway-api~default~gw~1234~default"
+
+func testListener(name string, bufferLimit uint32) *envoylistenerv3.Listener {
+	return &envoylistenerv3.Listener{
+		Name:                          name,
+		PerConnectionBufferLimitBytes: wrapperspb.UInt32(bufferLimit),
+	}
+}
+
+func testSnapshot(version string, listeners ...*envoylistenerv3.Listener) *envoycache.Snapshot {
+	items := make([]envoycachetypes.ResourceWithTTL, 0, len(listeners))
+	for _, l := range listeners {
+		items = append(items, envoycachetypes.ResourceWithTTL{Resource: l})
+	}
+	snap := &envoycache.Snapshot{}
+	snap.Resources[envoycachetypes.Listener] = envoycache.NewResourcesWithTTL(version, items)
+	snap.Resources[envoycachetypes.Cluster] = envoycache.NewResources(version, nil)
+	return snap
+}
+
+// sendSnapshot simulates sending the listeners of the snapshot to the client, and the client ACKing or NACKing them
+func sendSnapshot(t *testing.T, tracker *SnapshotTracker, snap *envoycache.Snapshot, nonce string, nack *status.Status) {
+	t.Helper()
+	version := snap.Resources[envoycachetypes.Listener].Version
+	tracker.OnStreamResponse(context.Background(), 1, nil, &discoveryv3.DiscoveryResponse{
+		TypeUrl:     resource.ListenerType,
+		VersionInfo: version,
+		Nonce:       nonce,
+	})
+	req := &discoveryv3.DiscoveryRequest{
+		Node: &envoycorev3.Node{Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+			xds.RoleKey: structpb.NewStringValue(testProxyKey),
+		}}},
+		TypeUrl:       resource.ListenerType,
+		VersionInfo:   version,
+		ResponseNonce: nonce,
+		ErrorDetail:   nack,
+	}
+	require.NoError(t, tracker.OnStreamRequest(1, req))
+}
+
+func openStream(t *testing.T, tracker *SnapshotTracker) {
+	t.Helper()
+	require.NoError(t, tracker.OnStreamRequest(1, &discoveryv3.DiscoveryRequest{
+		Node: &envoycorev3.Node{Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+			xds.RoleKey: structpb.NewStringValue(testProxyKey),
+		}}},
+		TypeUrl: resource.ListenerType,
+	}))
+}
+
+func listeners(snap *envoycache.Snapshot) map[string]uint32 {
+	out := map[string]uint32{}
+	for name, item := range snap.Resources[envoycachetypes.Listener].Items {
+		out[name] = item.Resource.(*envoylistenerv3.Listener).GetPerConnectionBufferLimitBytes().GetValue()
+	}
+	return out
+}
+
+func TestSnapshotTrackerRollback(t *testing.T) {
+	tracker := NewSnapshotTracker(true)
+	openStream(t, tracker)
+
+	good := testSnapshot("1", testListener("listener~80", 1), testListener("listener~443", 1))
+	sent := tracker.apply(testProxyKey, good)
+	assert.Same(t, good, sent)
+	sendSnapshot(t, tracker, sent, "a", nil)
+
+	// the client rejects the change of listener~443, it is pinned to its last ACKed version
+	bad := testSnapshot("2", testListener("listener~80", 2), testListener("listener~443", 2))
+	sent = tracker.apply(testProxyKey, bad)
+	assert.Same(t, bad, sent)
+	sendSnapshot(t, tracker, sent, "b", &status.Status{Message: "Error adding/updating listener(s) listener~443: invalid"})
+
+	pinned := tracker.PinnedResources()
+	assert.Equal(t, map[string][]PinnedResource{testProxyKey: {{
+		TypeUrl:      resource.ListenerType,
+		Name:         "listener~443",
+		AckedVersion: "1",
+		Error:        "Error adding/updating listener(s) listener~443: invalid",
+	}}}, pinned)
+
+	var resynced *envoycache.Snapshot
+	ctx, cancel := context.WithCancel(context.Background())
+	go tracker.resyncs(ctx, func(proxyKey string, snap *envoycache.Snapshot) {
+		assert.Equal(t, testProxyKey, proxyKey)
+		resynced = snap
+		cancel()
+	})
+	<-ctx.Done()
+	require.NotNil(t, resynced)
+	assert.Equal(t, map[string]uint32{"listener~80": 2, "listener~443": 1}, listeners(resynced))
+	assert.Equal(t, "2-pinned-1", resynced.Resources[envoycachetypes.Listener].Version)
+	sendSnapshot(t, tracker, resynced, "c", nil)
+
+	// other resources keep being updated while listener~443 stays pinned
+	next := testSnapshot("3", testListener("listener~80", 3), testListener("listener~443", 2))
+	sent = tracker.apply(testProxyKey, next)
+	assert.Equal(t, map[string]uint32{"listener~80": 3, "listener~443": 1}, listeners(sent))
+	sendSnapshot(t, tracker, sent, "d", nil)
+
+	// a new translation of the pinned resource is sent to the client again
+	fixed := testSnapshot("4", testListener("listener~80", 3), testListener("listener~443", 4))
+	sent = tracker.apply(testProxyKey, fixed)
+	assert.Same(t, fixed, sent)
+	assert.Empty(t, tracker.PinnedResources())
+}
+
+func TestSnapshotTrackerWithoutRollback(t *testing.T) {
+	tracker := NewSnapshotTracker(false)
+	openStream(t, tracker)
+
+	good := testSnapshot("1", testListener("listener~80", 1))
+	sendSnapshot(t, tracker, tracker.apply(testProxyKey, good), "a", nil)
+	assert.Equal(t, "1", tracker.clients[testProxyKey].acked[envoycachetypes.Listener].Version)
+
+	bad := testSnapshot("2", testListener("listener~80", 2))
+	sent := tracker.apply(testProxyKey, bad)
+	sendSnapshot(t, tracker, sent, "b", &status.Status{Message: "invalid"})
+	assert.Empty(t, tracker.PinnedResources())
+	assert.Same(t, bad, tracker.apply(testProxyKey, bad))
+	// the last ACKed resources are still tracked
+	assert.Equal(t, "1", tracker.clients[testProxyKey].acked[envoycachetypes.Listener].Version)
+}
+
+func TestSnapshotTrackerIgnoresStaleNack(t *testing.T) {
+	tracker := NewSnapshotTracker(true)
+	openStream(t, tracker)
+
+	sendSnapshot(t, tracker, tracker.apply(testProxyKey, testSnapshot("1", testListener("listener~80", 1))), "a", nil)
+	bad := tracker.apply(testProxyKey, testSnapshot("2", testListener("listener~80", 2)))
+	// a newer snapshot is translated before the client rejects the previous one
+	tracker.apply(testProxyKey, testSnapshot("3", testListener("listener~80", 3)))
+	sendSnapshot(t, tracker, bad, "b", &status.Status{Message: "invalid"})
+	assert.Empty(t, tracker.PinnedResources())
+}
+
+func TestSnapshotTrackerResyncDoesNotOverwriteNewerSnapshot(t *testing.T) {
+	tracker := NewSnapshotTracker(true)
+	openStream(t, tracker)
+
+	sendSnapshot(t, tracker, tracker.apply(testProxyKey, testSnapshot("1", testListener("listener~80", 1))), "a", nil)
+	bad := tracker.apply(testProxyKey, testSnapshot("2", testListener("listener~80", 2)))
+
+	// a newer snapshot is being applied while the rejected resources are pinned
+	unlock := tracker.lockProxy(testProxyKey)
+	sendSnapshot(t, tracker, bad, "b", &status.Status{Message: "invalid"})
+	require.NotEmpty(t, tracker.PinnedResources())
+
+	resynced := make(chan *envoycache.Snapshot, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go tracker.resyncs(ctx, func(_ string, snap *envoycache.Snapshot) {
+		resynced <- snap
+	})
+
+	fixed := tracker.apply(testProxyKey, testSnapshot("3", testListener("listener~80", 3)))
+	unlock()
+
+	// the resync sets the newer snapshot rather than the pinned snapshot computed before it
+	assert.Same(t, fixed, <-resynced)
+}
+
+func TestSnapshotTrackerDeltaRollback(t *testing.T) {
+	tracker := NewSnapshotTracker(true)
+	// the node is only set on the first request of the stream
+	require.NoError(t, tracker.OnStreamDeltaRequest(1, &discoveryv3.DeltaDiscoveryRequest{
+		Node: &envoycorev3.Node{Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+			xds.RoleKey: structpb.NewStringValue(testProxyKey),
+		}}},
+		TypeUrl: resource.ListenerType,
+	}))
+	sendDelta := func(snap *envoycache.Snapshot, nonce string, nack *status.Status) {
+		t.Helper()
+		tracker.OnStreamDeltaResponse(1, nil, &discoveryv3.DeltaDiscoveryResponse{
+			TypeUrl:           resource.ListenerType,
+			SystemVersionInfo: snap.Resources[envoycachetypes.Listener].Version,
+			Nonce:             nonce,
+		})
+		require.NoError(t, tracker.OnStreamDeltaRequest(1, &discoveryv3.DeltaDiscoveryRequest{
+			TypeUrl:       resource.ListenerType,
+			ResponseNonce: nonce,
+			ErrorDetail:   nack,
+		}))
+	}
+
+	sendDelta(tracker.apply(testProxyKey, testSnapshot("1", testListener("listener~80", 1))), "1", nil)
+	sendDelta(tracker.apply(testProxyKey, testSnapshot("2", testListener("listener~80", 2))), "2", &status.Status{Message: "invalid"})
+	assert.Equal(t, map[string][]PinnedResource{testProxyKey: {{
+		TypeUrl:      resource.ListenerType,
+		Name:         "listener~80",
+		AckedVersion: "1",
+		Error:        "invalid",
+	}}}, tracker.PinnedResources())
+
+	tracker.OnDeltaStreamClosed(1, nil)
+	assert.Empty(t, tracker.streams)
+}
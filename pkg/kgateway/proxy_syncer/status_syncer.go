@@ -26,6 +26,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/apiclient"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
 	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections/metrics"
 	plug "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
@@ -46,6 +47,10 @@ type StatusSyncer struct {
 	cacheSyncs                     []cache.InformerSynced
 
 	customStatusSync func(ctx context.Context, rm reports.ReportMap)
+	// xdsRejections tracks the xDS responses rejected by the proxies, reported on the status of the Gateways
+	xdsRejections *xds.RejectionTracker
+	// gatewayTranslations gives access to the latest translation of the Gateways, used to trace xDS rejections to routes
+	gatewayTranslations *GatewayTranslations
 }
 
 func NewStatusSyncer(
@@ -69,6 +74,8 @@ func NewStatusSyncer(
 		latestBackendPolicyReportQueue: backendPolicyReportQueue,
 		cacheSyncs:                     cacheSyncs,
 		customStatusSync:               cfg.CustomStatusSync,
+		xdsRejections:                  cfg.XdsRejections,
+		gatewayTranslations:            cfg.GatewayTranslations,
 	}
 }
 
@@ -100,11 +107,19 @@ func (s *StatusSyncer) Start(ctx context.Context) error {
 	listenerSetStatusLogger := logger.With("subcomponent", "listenerSetStatusSyncer")
 	gatewayStatusLogger := logger.With("subcomponent", "gatewayStatusSyncer")
 	go func() {
+		var latestReport reports.ReportMap
+		hasReport := false
 		for {
-			latestReport, err := s.latestReportQueue.Dequeue(ctx)
-			if err != nil {
-				logger.Error("failed to dequeue gateway reports", "error", err)
+			select {
+			case <-ctx.Done():
 				return
+			case latestReport = <-s.latestReportQueue.Next():
+				hasReport = true
+			case <-s.xdsRejectionUpdates():
+				// the status reported for xDS rejections changed, sync the latest report again
+				if !hasReport {
+					continue
+				}
 			}
 			s.syncGatewayStatus(ctx, gatewayStatusLogger, latestReport)
 			s.syncListenerSetStatus(ctx, listenerSetStatusLogger, latestReport)
@@ -168,6 +183,10 @@ func (s *StatusSyncer) syncRouteStatus(ctx context.Context, logger *slog.Logger,
 					for _, parentRef := range r.Spec.ParentRefs {
 						gatewayNames = append(gatewayNames, string(parentRef.Name))
 					}
+				case *gwv1a2.UDPRoute:
+					for _, parentRef := range r.Spec.ParentRefs {
+						gatewayNames = append(gatewayNames, string(parentRef.Name))
+					}
 				case *gwv1.GRPCRoute:
 					for _, parentRef := range r.Spec.ParentRefs {
 						gatewayNames = append(gatewayNames, string(parentRef.Name))
@@ -254,30 +273,43 @@ func (s *StatusSyncer) syncRouteStatus(ctx context.Context, logger *slog.Logger,
 		)
 	}
 
+	xdsRejections := s.xdsRejectionReport()
+
 	// Helper function to build route status and update if needed
 	buildAndUpdateStatus := func(route client.Object, routeType string) (*gwv1.RouteStatus, error) {
 		var status *gwv1.RouteStatus
 		switch r := route.(type) {
 		case *gwv1.HTTPRoute:
 			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
+			xdsRejections.setRouteStatus(routeType, r, status)
 			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
 				return nil, nil
 			}
 			r.Status.RouteStatus = *status
 		case *gwv1a2.TCPRoute:
 			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
+			xdsRejections.setRouteStatus(routeType, r, status)
 			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
 				return nil, nil
 			}
 			r.Status.RouteStatus = *status
 		case *gwv1a2.TLSRoute:
 			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
+			xdsRejections.setRouteStatus(routeType, r, status)
+			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
+				return nil, nil
+			}
+			r.Status.RouteStatus = *status
+		case *gwv1a2.UDPRoute:
+			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
+			xdsRejections.setRouteStatus(routeType, r, status)
 			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
 				return nil, nil
 			}
 			r.Status.RouteStatus = *status
 		case *gwv1.GRPCRoute:
 			status = rm.BuildRouteStatus(ctx, r, s.controllerName)
+			xdsRejections.setRouteStatus(routeType, r, status)
 			if status == nil || isRouteStatusEqual(&r.Status.RouteStatus, status) {
 				return nil, nil
 			}
@@ -330,6 +362,18 @@ func (s *StatusSyncer) syncRouteStatus(ctx context.Context, logger *slog.Logger,
 		}
 	}
 
+	// Sync UDPRoute statuses
+	for rnn := range rm.UDPRoutes {
+		err := syncStatusWithRetry(wellknown.UDPRouteKind, rnn,
+			func() client.Object { return new(gwv1a2.UDPRoute) },
+			func(route client.Object) (*gwv1.RouteStatus, error) {
+				return buildAndUpdateStatus(route, wellknown.UDPRouteKind)
+			})
+		if err != nil {
+			logger.Error("all attempts failed at updating UDPRoute status", "error", err, "route", rnn)
+		}
+	}
+
 	// Sync GRPCRoute statuses
 	for rnn := range rm.GRPCRoutes {
 		err := syncStatusWithRetry(wellknown.GRPCRouteKind, rnn,
@@ -345,6 +389,7 @@ func (s *StatusSyncer) syncRouteStatus(ctx context.Context, logger *slog.Logger,
 
 // syncGatewayStatus will build and update status for all Gateways in a reportMap
 func (s *StatusSyncer) syncGatewayStatus(ctx context.Context, logger *slog.Logger, rm reports.ReportMap) {
+	xdsRejections := s.xdsRejectionReport()
 	for gwnn := range rm.Gateways {
 		finishMetrics := CollectStatusSyncMetrics(StatusSyncMetricLabels{
 			Name:      gwnn.Name,
@@ -387,6 +432,7 @@ func (s *StatusSyncer) syncGatewayStatus(ctx context.Context, logger *slog.Logge
 				logger.Debug("new status is nil; skipping status update", "gateway", gwnn.String())
 				return nil
 			}
+			xdsRejections.setGatewayStatus(&gw, newStatus)
 
 			// Skip if status hasn’t changed (ignoring Addresses)
 			old := gw.Status
@@ -517,6 +563,8 @@ func (s *StatusSyncer) syncListenerSetStatus(ctx context.Context, logger *slog.L
 }
 
 func (s *StatusSyncer) syncPolicyStatus(ctx context.Context, rm reports.ReportMap) {
+	xdsRejections := s.xdsRejectionReport()
+
 	// Sync Policy statuses
 	for key := range rm.Policies {
 		gk := schema.GroupKind{Group: key.Group, Kind: key.Kind}
@@ -544,6 +592,12 @@ func (s *StatusSyncer) syncPolicyStatus(ctx context.Context, rm reports.ReportMa
 		if status == nil {
 			continue
 		}
+		if gk == wellknown.TrafficPolicyGVK.GroupKind() {
+			if err := s.setTrafficPolicyXdsRejectedStatus(ctx, xdsRejections, nsName, status); err != nil {
+				logger.Error("error getting policy", "error", err, "resource_ref", nsName)
+				continue
+			}
+		}
 
 		var statusErr error
 
@@ -0,0 +1,44 @@
+package proxy_syncer
+
+import (
+	"strconv"
+
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+)
+
+// resourceVersions holds the version of each resource of an xDS type, keyed by resource name.
+//
+// The versions are computed once, when the resources are translated, from the hashes already used
+// to detect changes in the krt collections. They are set as the version map of the snapshots, so that
+// the snapshot cache can serve incremental (delta) xDS without marshaling and hashing every resource
+// of every snapshot of every client, and only the changed resources are sent to the clients.
+type resourceVersions map[string]string
+
+func formatVersion(hash uint64) string {
+	return strconv.FormatUint(hash, 10)
+}
+
+// setVersionMap sets the version map of the snapshot from the versions of its resources, indexed by type.
+// The version of a resource missing from the versions is computed from the resource itself.
+func setVersionMap(snap *envoycache.Snapshot, versions [envoycachetypes.UnknownType]resourceVersions) {
+	snap.VersionMap = make(map[string]map[string]string, len(snap.Resources))
+	for typ, resources := range snap.Resources {
+		typeUrl, err := envoycache.GetResponseTypeURL(envoycachetypes.ResponseType(typ))
+		if err != nil {
+			// should never happen
+			continue
+		}
+		versionMap := make(map[string]string, len(resources.Items))
+		for name, item := range resources.Items {
+			version := versions[typ][name]
+			if version == "" {
+				version = formatVersion(utils.HashProto(item.Resource))
+			}
+			versionMap[name] = version
+		}
+		snap.VersionMap[typeUrl] = versionMap
+	}
+}
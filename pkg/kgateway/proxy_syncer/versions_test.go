@@ -0,0 +1,164 @@
+package proxy_syncer
+
+import (
+	"fmt"
+	"testing"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/server/stream/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+)
+
+func testClusterLoadAssignment(cluster string, port uint32) *envoyendpointv3.ClusterLoadAssignment {
+	return &envoyendpointv3.ClusterLoadAssignment{
+		ClusterName: cluster,
+		Endpoints: []*envoyendpointv3.LocalityLbEndpoints{{
+			LbEndpoints: []*envoyendpointv3.LbEndpoint{{
+				HostIdentifier: &envoyendpointv3.LbEndpoint_Endpoint{Endpoint: &envoyendpointv3.Endpoint{
+					Address: &envoycorev3.Address{Address: &envoycorev3.Address_SocketAddress{SocketAddress: &envoycorev3.SocketAddress{
+						Address:       "10.0.0.1",
+						PortSpecifier: &envoycorev3.SocketAddress_PortValue{PortValue: port},
+					}}},
+				}},
+			}},
+		}},
+	}
+}
+
+func TestSetVersionMap(t *testing.T) {
+	snap := &envoycache.Snapshot{}
+	snap.Resources[envoycachetypes.Endpoint] = envoycache.NewResources("1", []envoycachetypes.Resource{
+		testClusterLoadAssignment("translated", 8080),
+		testClusterLoadAssignment("untranslated", 8080),
+	})
+	var versions [envoycachetypes.UnknownType]resourceVersions
+	versions[envoycachetypes.Endpoint] = resourceVersions{"translated": "1234"}
+
+	setVersionMap(snap, versions)
+
+	assert.Equal(t, map[string]string{
+		"translated":   "1234",
+		"untranslated": formatVersion(utils.HashProto(testClusterLoadAssignment("untranslated", 8080))),
+	}, snap.GetVersionMap(resource.EndpointType))
+	// the version map is already set, so the snapshot cache does not hash the resources again
+	require.NoError(t, snap.ConstructVersionMap())
+	assert.Equal(t, "1234", snap.GetVersionMap(resource.EndpointType)["translated"])
+	assert.Empty(t, snap.GetVersionMap(resource.ClusterType))
+}
+
+// churnSnapshots generates the snapshots of a client with the given number of clusters,
+// where the endpoints of a single cluster change between consecutive snapshots.
+type churnSnapshots struct {
+	endpoints []envoycachetypes.Resource
+	versions  resourceVersions
+	hash      uint64
+	// translatedVersions sets the version map of the snapshots from the versions of the translation,
+	// otherwise the snapshot cache hashes every resource of every snapshot to serve delta xDS
+	translatedVersions bool
+}
+
+func newChurnSnapshots(clusters int, translatedVersions bool) *churnSnapshots {
+	c := &churnSnapshots{
+		endpoints:          make([]envoycachetypes.Resource, clusters),
+		versions:           make(resourceVersions, clusters),
+		translatedVersions: translatedVersions,
+	}
+	for i := range clusters {
+		c.update(i, 8080)
+	}
+	return c
+}
+
+func (c *churnSnapshots) update(i int, port uint32) {
+	if prev := c.endpoints[i]; prev != nil {
+		c.hash ^= utils.HashProto(prev)
+	}
+	cla := testClusterLoadAssignment(fmt.Sprintf("cluster-%d", i), port)
+	hash := utils.HashProto(cla)
+	c.endpoints[i] = cla
+	c.versions[cla.GetClusterName()] = formatVersion(hash)
+	c.hash ^= hash
+}
+
+// next changes the endpoints of a single cluster and returns the resulting snapshot
+func (c *churnSnapshots) next(n int) *envoycache.Snapshot {
+	c.update(n%len(c.endpoints), uint32(8081+n))
+
+	snap := &envoycache.Snapshot{}
+	snap.Resources[envoycachetypes.Endpoint] = envoycache.NewResources(formatVersion(c.hash), c.endpoints)
+	if c.translatedVersions {
+		var versions [envoycachetypes.UnknownType]resourceVersions
+		versions[envoycachetypes.Endpoint] = c.versions
+		setVersionMap(snap, versions)
+	}
+	return snap
+}
+
+// BenchmarkSnapshotChurn measures setting a snapshot where the endpoints of a single cluster changed and serving
+// it to a connected client, and reports the number of resources sent to the client for each snapshot.
+func BenchmarkSnapshotChurn(b *testing.B) {
+	const nodeID = "kgateway-kube-gateway-api~default~gw"
+	node := &envoycorev3.Node{Id: nodeID}
+
+	// benchmarkServe sets the snapshots in the cache and serves them to the client, once before
+	// the benchmark starts so that the client received the initial resources
+	benchmarkServe := func(b *testing.B, snapshots *churnSnapshots, serve func(cache envoycache.SnapshotCache, n int) int) {
+		cache := envoycache.NewSnapshotCache(true, envoycache.IDHash{}, nil)
+		setAndServe := func(n int) int {
+			if err := cache.SetSnapshot(b.Context(), nodeID, snapshots.next(n)); err != nil {
+				b.Fatal(err)
+			}
+			return serve(cache, n)
+		}
+		setAndServe(0)
+
+		var sent, n int
+		for b.Loop() {
+			n++
+			sent += setAndServe(n)
+		}
+		b.ReportMetric(float64(sent)/float64(n), "resources/op")
+	}
+
+	for _, clusters := range []int{1000, 10000} {
+		b.Run(fmt.Sprintf("clusters=%d/sotw", clusters), func(b *testing.B) {
+			sub := stream.NewSotwSubscription(nil, true)
+			var version string
+			benchmarkServe(b, newChurnSnapshots(clusters, true), func(cache envoycache.SnapshotCache, _ int) int {
+				responses := make(chan envoycache.Response, 1)
+				req := &discoveryv3.DiscoveryRequest{Node: node, TypeUrl: resource.EndpointType, VersionInfo: version}
+				if _, err := cache.CreateWatch(req, &sub, responses); err != nil {
+					b.Fatal(err)
+				}
+				resp := (<-responses).(*envoycache.RawResponse)
+				version = resp.GetResponseVersion()
+				sub.SetReturnedResources(resp.GetReturnedResources())
+				return len(resp.GetRawResources())
+			})
+		})
+
+		for _, translatedVersions := range []bool{true, false} {
+			b.Run(fmt.Sprintf("clusters=%d/delta/translatedVersions=%t", clusters, translatedVersions), func(b *testing.B) {
+				sub := stream.NewDeltaSubscription(nil, nil, nil, true)
+				benchmarkServe(b, newChurnSnapshots(clusters, translatedVersions), func(cache envoycache.SnapshotCache, n int) int {
+					responses := make(chan envoycache.DeltaResponse, 1)
+					req := &discoveryv3.DeltaDiscoveryRequest{Node: node, TypeUrl: resource.EndpointType, ResponseNonce: formatVersion(uint64(n))}
+					if _, err := cache.CreateDeltaWatch(req, &sub, responses); err != nil {
+						b.Fatal(err)
+					}
+					resp := (<-responses).(*envoycache.RawDeltaResponse)
+					sub.SetReturnedResources(resp.GetNextVersionMap())
+					return len(resp.GetRawResources())
+				})
+			})
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package proxy_syncer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/irtranslator"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/stringutils"
+)
+
+// maxConditionMessageLen is the maximum length of the message of a metav1.Condition
+const maxConditionMessageLen = 32768
+
+// xdsRejectedRoute identifies a route traced back from an xDS response rejected by a proxy
+type xdsRejectedRoute struct {
+	kind string
+	types.NamespacedName
+}
+
+// xdsRejectionReport is the status to report for the xDS responses currently rejected by the proxies
+type xdsRejectionReport struct {
+	// gateways maps the Gateways whose proxies reject xDS responses to the message to report
+	gateways map[types.NamespacedName]string
+	// routes maps the routes traced back from the rejected responses to the Gateways rejecting them
+	routes map[xdsRejectedRoute]sets.Set[types.NamespacedName]
+	// policies maps the IDs of the policies attached to the routes traced back from the rejected responses,
+	// whether by targetRefs, targetSelectors or extensionRefs, to the routes and the Gateways rejecting them
+	policies map[string]map[xdsRejectedRoute]sets.Set[types.NamespacedName]
+}
+
+// newXdsRejectionReport builds the status to report for the given xDS rejections. Routes are traced back
+// from the names of the Envoy routes, found in the error messages of the proxies, translated from the
+// IR of the Gateway. gatewayIR returns the latest IR of a Gateway, or nil if it was not translated (yet).
+//
+// The rejections are only those of the proxies connected to this controller replica, so when several
+// replicas are running, the status reports the rejections of the proxies connected to the replica
+// that last wrote it.
+func newXdsRejectionReport(
+	rejections map[types.NamespacedName][]xds.Rejection,
+	gatewayIR func(types.NamespacedName) *ir.GatewayIR,
+) xdsRejectionReport {
+	out := xdsRejectionReport{
+		gateways: make(map[types.NamespacedName]string, len(rejections)),
+		routes:   make(map[xdsRejectedRoute]sets.Set[types.NamespacedName]),
+		policies: make(map[string]map[xdsRejectedRoute]sets.Set[types.NamespacedName]),
+	}
+	for gw, gwRejections := range rejections {
+		messages := make([]string, 0, len(gwRejections))
+		for _, r := range gwRejections {
+			// e.g. "Listener: <error>" for envoy.config.listener.v3.Listener
+			messages = append(messages, fmt.Sprintf("%s: %s", r.TypeUrl[strings.LastIndex(r.TypeUrl, ".")+1:], r.Message))
+		}
+		out.gateways[gw] = "The proxy rejected the xDS configuration: " + strings.Join(messages, "; ")
+
+		if gatewayIR == nil {
+			continue
+		}
+		gwIR := gatewayIR(gw)
+		if gwIR == nil {
+			continue
+		}
+		for name, source := range xdsRouteSources(gwIR) {
+			if !routeInRejections(name, gwRejections) {
+				continue
+			}
+			insertGateway(out.routes, source.route, gw)
+			for id := range source.policies {
+				if out.policies[id] == nil {
+					out.policies[id] = make(map[xdsRejectedRoute]sets.Set[types.NamespacedName])
+				}
+				insertGateway(out.policies[id], source.route, gw)
+			}
+		}
+	}
+	return out
+}
+
+func insertGateway(routes map[xdsRejectedRoute]sets.Set[types.NamespacedName], route xdsRejectedRoute, gw types.NamespacedName) {
+	if routes[route] == nil {
+		routes[route] = sets.New[types.NamespacedName]()
+	}
+	routes[route].Insert(gw)
+}
+
+// xdsRouteSource is the route an Envoy route is translated from, and the policies attached to the route
+type xdsRouteSource struct {
+	route    xdsRejectedRoute
+	policies sets.Set[string]
+}
+
+// xdsRouteSources maps the names of the Envoy routes translated from the IR of a Gateway
+// to the routes they are translated from.
+func xdsRouteSources(gwIR *ir.GatewayIR) map[string]xdsRouteSource {
+	out := make(map[string]xdsRouteSource)
+	for _, l := range gwIR.Listeners {
+		for _, fc := range l.HttpFilterChain {
+			for _, vh := range fc.Vhosts {
+				for i, rule := range vh.Rules {
+					if rule.Parent == nil {
+						continue
+					}
+					src := rule.Parent.ObjectSource
+					out[irtranslator.XdsRouteName(vh, i, rule)] = xdsRouteSource{
+						route: xdsRejectedRoute{
+							kind:           src.Kind,
+							NamespacedName: types.NamespacedName{Namespace: src.Namespace, Name: src.Name},
+						},
+						policies: attachedPolicyIDs(&rule),
+					}
+				}
+			}
+		}
+	}
+	return out
+}
+
+// attachedPolicyIDs returns the IDs of the policies attached to the route rule, its route and
+// the rules delegating to it, including the policies merged into a single attachment.
+func attachedPolicyIDs(rule *ir.HttpRouteRuleMatchIR) sets.Set[string] {
+	ids := sets.New[string]()
+	for ; rule != nil; rule = rule.DelegatingParent {
+		all := []ir.AttachedPolicies{rule.ExtensionRefs, rule.AttachedPolicies}
+		if rule.Parent != nil {
+			all = append(all, rule.Parent.AttachedPolicies)
+		}
+		for _, attached := range all {
+			for _, pols := range attached.Policies {
+				for _, pol := range pols {
+					if pol.PolicyRef != nil {
+						ids.Insert(pol.PolicyRef.ID())
+					}
+					for _, origins := range pol.MergeOrigins {
+						ids = ids.Union(origins)
+					}
+				}
+			}
+		}
+	}
+	return ids
+}
+
+// routeInRejections returns true if the Envoy route with the given name is named in the rejections.
+// The name must not be part of a longer name, e.g. the name of a route whose name it prefixes.
+func routeInRejections(name string, rejections []xds.Rejection) bool {
+	for _, r := range rejections {
+		if containsName(r.Message, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsName returns true if the message contains the name delimited by characters
+// that are not part of Envoy resource names generated by the translator.
+func containsName(msg, name string) bool {
+	for i := 0; i+len(name) <= len(msg); {
+		j := strings.Index(msg[i:], name)
+		if j < 0 {
+			return false
+		}
+		start, end := i+j, i+j+len(name)
+		if (start == 0 || !isNameChar(msg[start-1])) && (end == len(msg) || !isNameChar(msg[end])) {
+			return true
+		}
+		i = start + 1
+	}
+	return false
+}
+
+func isNameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '-' || c == '_' || c == '.' || c == '~' || c == '*'
+}
+
+// setGatewayStatus sets the Programmed condition of the Gateway to False if its proxies reject
+// the xDS configuration.
+func (r xdsRejectionReport) setGatewayStatus(gw *gwv1.Gateway, status *gwv1.GatewayStatus) {
+	msg, ok := r.gateways[client.ObjectKeyFromObject(gw)]
+	if !ok {
+		return
+	}
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               string(gwv1.GatewayConditionProgrammed),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(reporter.GatewayReasonXdsRejected),
+		Message:            stringutils.TruncateMaxLength(msg, maxConditionMessageLen),
+		ObservedGeneration: gw.Generation,
+	})
+}
+
+// setRouteStatus sets the XdsRejected condition on the parents of the route whose proxies reject
+// the xDS configuration translated from the route, and removes it from the other parents.
+func (r xdsRejectionReport) setRouteStatus(kind string, route client.Object, status *gwv1.RouteStatus) {
+	if status == nil {
+		return
+	}
+	rejectingGateways := r.routes[xdsRejectedRoute{kind: kind, NamespacedName: client.ObjectKeyFromObject(route)}]
+	for i := range status.Parents {
+		parent := &status.Parents[i]
+		gw, ok := parentGateway(parent.ParentRef, route.GetNamespace())
+		if !ok || !rejectingGateways.Has(gw) {
+			meta.RemoveStatusCondition(&parent.Conditions, string(reporter.RouteConditionXdsRejected))
+			continue
+		}
+		meta.SetStatusCondition(&parent.Conditions, metav1.Condition{
+			Type:               string(reporter.RouteConditionXdsRejected),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(reporter.RouteReasonXdsRejected),
+			Message:            stringutils.TruncateMaxLength(r.gateways[gw], maxConditionMessageLen),
+			ObservedGeneration: route.GetGeneration(),
+		})
+	}
+}
+
+// setPolicyStatus sets the XdsRejected condition on the ancestors of the TrafficPolicy whose proxies
+// reject the xDS configuration of a route the policy is attached to, and removes it from the other ancestors.
+func (r xdsRejectionReport) setPolicyStatus(policy *kgateway.TrafficPolicy, status *gwv1.PolicyStatus) {
+	rejectedRoutes := r.policies[trafficPolicyID(client.ObjectKeyFromObject(policy))]
+	for i := range status.Ancestors {
+		ancestor := &status.Ancestors[i]
+		gw, ok := parentGateway(ancestor.AncestorRef, policy.Namespace)
+		var routes []string
+		if ok {
+			for route, gws := range rejectedRoutes {
+				if gws.Has(gw) {
+					routes = append(routes, fmt.Sprintf("%s %s", route.kind, route.NamespacedName))
+				}
+			}
+		}
+		if len(routes) == 0 {
+			meta.RemoveStatusCondition(&ancestor.Conditions, string(reporter.PolicyConditionXdsRejected))
+			continue
+		}
+		slices.Sort(routes)
+		msg := fmt.Sprintf("Targeted %s: %s", strings.Join(routes, ", "), r.gateways[gw])
+		meta.SetStatusCondition(&ancestor.Conditions, metav1.Condition{
+			Type:               string(reporter.PolicyConditionXdsRejected),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(reporter.PolicyReasonXdsRejected),
+			Message:            stringutils.TruncateMaxLength(msg, maxConditionMessageLen),
+			ObservedGeneration: policy.Generation,
+		})
+	}
+}
+
+func trafficPolicyID(nsName types.NamespacedName) string {
+	ref := ir.AttachedPolicyRef{
+		Group:     wellknown.TrafficPolicyGVK.Group,
+		Kind:      wellknown.TrafficPolicyGVK.Kind,
+		Namespace: nsName.Namespace,
+		Name:      nsName.Name,
+	}
+	return ref.ID()
+}
+
+// xdsRejectionReport returns the status to report for the xDS responses currently rejected by the proxies
+// connected to this replica.
+func (s *StatusSyncer) xdsRejectionReport() xdsRejectionReport {
+	var rejections map[types.NamespacedName][]xds.Rejection
+	if s.xdsRejections != nil {
+		rejections = s.xdsRejections.Rejections()
+	}
+	var gatewayIR func(types.NamespacedName) *ir.GatewayIR
+	if s.gatewayTranslations != nil {
+		gatewayIR = func(gw types.NamespacedName) *ir.GatewayIR {
+			if translation := s.gatewayTranslations.Get(gw); translation != nil {
+				return translation.Gateway
+			}
+			return nil
+		}
+	}
+	return newXdsRejectionReport(rejections, gatewayIR)
+}
+
+// xdsRejectionUpdates returns a channel that receives a value when the xDS rejections change.
+// The channel is nil, i.e., never receives, if xDS rejections are not tracked.
+func (s *StatusSyncer) xdsRejectionUpdates() <-chan struct{} {
+	if s.xdsRejections == nil {
+		return nil
+	}
+	return s.xdsRejections.Updates()
+}
+
+// setTrafficPolicyXdsRejectedStatus sets the XdsRejected condition on the status of the TrafficPolicy.
+// The policy is only fetched to report its generation when it is attached to a route traced back from
+// xDS rejections.
+func (s *StatusSyncer) setTrafficPolicyXdsRejectedStatus(
+	ctx context.Context,
+	xdsRejections xdsRejectionReport,
+	nsName types.NamespacedName,
+	status *gwv1.PolicyStatus,
+) error {
+	policy := &kgateway.TrafficPolicy{}
+	if _, ok := xdsRejections.policies[trafficPolicyID(nsName)]; !ok {
+		policy.Name, policy.Namespace = nsName.Name, nsName.Namespace
+	} else if err := s.mgr.GetClient().Get(ctx, nsName, policy); err != nil {
+		return err
+	}
+	xdsRejections.setPolicyStatus(policy, status)
+	return nil
+}
+
+// parentGateway returns the Gateway referenced by the parent reference, if any
+func parentGateway(ref gwv1.ParentReference, defaultNamespace string) (types.NamespacedName, bool) {
+	if ref.Group != nil && *ref.Group != gwv1.GroupName {
+		return types.NamespacedName{}, false
+	}
+	if ref.Kind != nil && *ref.Kind != wellknown.GatewayKind {
+		return types.NamespacedName{}, false
+	}
+	ns := defaultNamespace
+	if ref.Namespace != nil {
+		ns = string(*ref.Namespace)
+	}
+	return types.NamespacedName{Namespace: ns, Name: string(ref.Name)}, true
+}
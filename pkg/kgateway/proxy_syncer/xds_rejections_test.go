@@ -0,0 +1,220 @@
+package proxy_syncer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+)
+
+func TestXdsRejectionReport(t *testing.T) {
+	gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default", Generation: 2}}
+	otherGw := types.NamespacedName{Name: "other-gw", Namespace: "default"}
+	rejectedRoute := &gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "rejected", Namespace: "default", Generation: 3}}
+	acceptedRoute := &gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "accepted", Namespace: "default"}}
+
+	routeIR := func(route *gwv1.HTTPRoute) *ir.HttpRouteIR {
+		return &ir.HttpRouteIR{ObjectSource: ir.ObjectSource{
+			Group: gwv1.GroupName, Kind: wellknown.HTTPRouteKind, Namespace: route.Namespace, Name: route.Name,
+		}}
+	}
+	attached := func(policies ...ir.PolicyAtt) ir.AttachedPolicies {
+		return ir.AttachedPolicies{Policies: map[schema.GroupKind][]ir.PolicyAtt{
+			wellknown.TrafficPolicyGVK.GroupKind(): policies,
+		}}
+	}
+	policyRef := func(name string) *ir.AttachedPolicyRef {
+		return &ir.AttachedPolicyRef{
+			Group:     wellknown.TrafficPolicyGVK.Group,
+			Kind:      wellknown.TrafficPolicyGVK.Kind,
+			Namespace: "default",
+			Name:      name,
+		}
+	}
+	rejectedRouteIR := routeIR(rejectedRoute)
+	// e.g. attached with targetSelectors
+	rejectedRouteIR.AttachedPolicies = attached(ir.PolicyAtt{PolicyRef: policyRef("policy")})
+	acceptedRouteIR := routeIR(acceptedRoute)
+	acceptedRouteIR.AttachedPolicies = attached(ir.PolicyAtt{PolicyRef: policyRef("accepted-policy")})
+	gwIR := &ir.GatewayIR{Listeners: []ir.ListenerIR{{
+		HttpFilterChain: []ir.HttpFilterChainIR{{
+			Vhosts: []*ir.VirtualHost{{
+				Name: "listener~80~example_com",
+				Rules: []ir.HttpRouteRuleMatchIR{
+					{Parent: acceptedRouteIR, Name: "httproute-accepted-default-0-0"},
+					{
+						Parent: rejectedRouteIR,
+						Name:   "httproute-rejected-default-0-0",
+						AttachedPolicies: attached(ir.PolicyAtt{MergeOrigins: ir.MergeOrigins{
+							"timeouts": sets.New(policyRef("merged-policy").ID()),
+						}}),
+					},
+				},
+			}},
+		}},
+	}}}
+
+	report := newXdsRejectionReport(map[types.NamespacedName][]xds.Rejection{
+		{Name: "gw", Namespace: "default"}: {
+			{
+				TypeUrl: "envoy.config.listener.v3.Listener",
+				Message: "Error adding/updating listener(s) listener~80: invalid filter",
+			},
+			{
+				TypeUrl: "envoy.config.route.v3.RouteConfiguration",
+				Message: "route listener~80~example_com-route-1-httproute-rejected-default-0-0-matcher-0: invalid regex",
+			},
+		},
+	}, func(nn types.NamespacedName) *ir.GatewayIR {
+		if nn == client.ObjectKeyFromObject(gw) {
+			return gwIR
+		}
+		return nil
+	})
+
+	t.Run("gateway", func(t *testing.T) {
+		status := &gwv1.GatewayStatus{Conditions: []metav1.Condition{{
+			Type:   string(gwv1.GatewayConditionProgrammed),
+			Status: metav1.ConditionTrue,
+			Reason: string(gwv1.GatewayReasonProgrammed),
+		}}}
+		report.setGatewayStatus(gw, status)
+		programmed := meta.FindStatusCondition(status.Conditions, string(gwv1.GatewayConditionProgrammed))
+		require.NotNil(t, programmed)
+		assert.Equal(t, metav1.ConditionFalse, programmed.Status)
+		assert.Equal(t, string(reporter.GatewayReasonXdsRejected), programmed.Reason)
+		assert.Equal(t, "The proxy rejected the xDS configuration: "+
+			"Listener: Error adding/updating listener(s) listener~80: invalid filter; "+
+			"RouteConfiguration: route listener~80~example_com-route-1-httproute-rejected-default-0-0-matcher-0: invalid regex",
+			programmed.Message)
+		assert.Equal(t, int64(2), programmed.ObservedGeneration)
+
+		other := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: otherGw.Name, Namespace: otherGw.Namespace}}
+		otherStatus := &gwv1.GatewayStatus{}
+		report.setGatewayStatus(other, otherStatus)
+		assert.Empty(t, otherStatus.Conditions)
+	})
+
+	t.Run("routes", func(t *testing.T) {
+		status := &gwv1.RouteStatus{Parents: []gwv1.RouteParentStatus{
+			{ParentRef: gwv1.ParentReference{Name: "gw"}},
+			{
+				ParentRef: gwv1.ParentReference{Name: gwv1.ObjectName(otherGw.Name)},
+				Conditions: []metav1.Condition{{
+					Type:   string(reporter.RouteConditionXdsRejected),
+					Status: metav1.ConditionTrue,
+				}},
+			},
+		}}
+		report.setRouteStatus(wellknown.HTTPRouteKind, rejectedRoute, status)
+		rejected := meta.FindStatusCondition(status.Parents[0].Conditions, string(reporter.RouteConditionXdsRejected))
+		require.NotNil(t, rejected)
+		assert.Equal(t, metav1.ConditionTrue, rejected.Status)
+		assert.Equal(t, string(reporter.RouteReasonXdsRejected), rejected.Reason)
+		assert.Equal(t, int64(3), rejected.ObservedGeneration)
+		// the condition is removed once the proxies of the parent accept the configuration
+		assert.Empty(t, status.Parents[1].Conditions)
+
+		acceptedStatus := &gwv1.RouteStatus{Parents: []gwv1.RouteParentStatus{{ParentRef: gwv1.ParentReference{Name: "gw"}}}}
+		report.setRouteStatus(wellknown.HTTPRouteKind, acceptedRoute, acceptedStatus)
+		assert.Empty(t, acceptedStatus.Parents[0].Conditions)
+	})
+
+	t.Run("traffic policy", func(t *testing.T) {
+		for _, name := range []string{"policy", "merged-policy"} {
+			policy := &kgateway.TrafficPolicy{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+			status := &gwv1.PolicyStatus{Ancestors: []gwv1.PolicyAncestorStatus{
+				{AncestorRef: gwv1.ParentReference{Name: "gw", Namespace: new(gwv1.Namespace("default"))}},
+				{AncestorRef: gwv1.ParentReference{Name: gwv1.ObjectName(otherGw.Name)}},
+			}}
+			report.setPolicyStatus(policy, status)
+			rejected := meta.FindStatusCondition(status.Ancestors[0].Conditions, string(reporter.PolicyConditionXdsRejected))
+			require.NotNil(t, rejected, name)
+			assert.Equal(t, metav1.ConditionTrue, rejected.Status)
+			assert.Contains(t, rejected.Message, "Targeted HTTPRoute default/rejected: The proxy rejected the xDS configuration")
+			assert.Empty(t, status.Ancestors[1].Conditions)
+		}
+
+		policy := &kgateway.TrafficPolicy{ObjectMeta: metav1.ObjectMeta{Name: "accepted-policy", Namespace: "default"}}
+		status := &gwv1.PolicyStatus{Ancestors: []gwv1.PolicyAncestorStatus{{
+			AncestorRef: gwv1.ParentReference{Name: "gw"},
+			Conditions: []metav1.Condition{{
+				Type:   string(reporter.PolicyConditionXdsRejected),
+				Status: metav1.ConditionTrue,
+			}},
+		}}}
+		report.setPolicyStatus(policy, status)
+		assert.Empty(t, status.Ancestors[0].Conditions)
+	})
+}
+
+func TestXdsRejectionReportTracesExactRouteNames(t *testing.T) {
+	// the Envoy routes of both routes are prefixed with httproute-foo-bar-baz-0-0
+	fooBarBaz := types.NamespacedName{Namespace: "bar-baz", Name: "foo"}
+	fooBarInBaz := types.NamespacedName{Namespace: "baz", Name: "foo-bar"}
+	rule := func(nn types.NamespacedName) ir.HttpRouteRuleMatchIR {
+		return ir.HttpRouteRuleMatchIR{
+			Parent: &ir.HttpRouteIR{ObjectSource: ir.ObjectSource{
+				Group: gwv1.GroupName, Kind: wellknown.HTTPRouteKind, Namespace: nn.Namespace, Name: nn.Name,
+			}},
+			Name: "httproute-foo-bar-baz-0-0",
+		}
+	}
+	rules := []ir.HttpRouteRuleMatchIR{rule(fooBarInBaz), rule(fooBarBaz)}
+	// route 10 is named after route 1
+	for range 9 {
+		rules = append(rules, rule(fooBarInBaz))
+	}
+	gwIR := &ir.GatewayIR{Listeners: []ir.ListenerIR{{
+		HttpFilterChain: []ir.HttpFilterChainIR{{
+			Vhosts: []*ir.VirtualHost{{Name: "listener~80~example_com", Rules: rules}},
+		}},
+	}}}
+	gw := types.NamespacedName{Namespace: "default", Name: "gw"}
+
+	tests := []struct {
+		name    string
+		message string
+		want    []types.NamespacedName
+	}{
+		{
+			name:    "exact route name",
+			message: "route listener~80~example_com-route-1-httproute-foo-bar-baz-0-0-matcher-0: invalid regex",
+			want:    []types.NamespacedName{fooBarBaz},
+		},
+		{
+			name:    "route name prefixed by another route name",
+			message: "route listener~80~example_com-route-10-httproute-foo-bar-baz-0-0-matcher-0: invalid regex",
+			want:    []types.NamespacedName{fooBarInBaz},
+		},
+		{
+			name:    "no route name",
+			message: "route listener~80~example_com-route-1-httproute-foo-bar-baz-0-0-matcher-01: invalid regex",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := newXdsRejectionReport(map[types.NamespacedName][]xds.Rejection{
+				gw: {{TypeUrl: "envoy.config.route.v3.RouteConfiguration", Message: tt.message}},
+			}, func(types.NamespacedName) *ir.GatewayIR { return gwIR })
+			var got []types.NamespacedName
+			for route := range report.routes {
+				got = append(got, route.NamespacedName)
+			}
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
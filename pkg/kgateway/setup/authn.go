@@ -113,7 +113,15 @@ type authenticationManager struct {
 
 // Authenticate loops through all the configured Authenticators and returns if one of the authenticator succeeds.
 func (am *authenticationManager) authenticate(ctx context.Context) *security.Caller {
-	req := security.AuthContext{GrpcContext: ctx}
+	return am.authenticateRequest(security.AuthContext{GrpcContext: ctx})
+}
+
+// authenticateHTTP authenticates an HTTP request, e.g. a request for a Wasm module, like an xDS stream.
+func (am *authenticationManager) authenticateHTTP(r *http.Request) *security.Caller {
+	return am.authenticateRequest(security.AuthContext{Request: r})
+}
+
+func (am *authenticationManager) authenticateRequest(req security.AuthContext) *security.Caller {
 	for _, authn := range am.Authenticators {
 		u, err := authn.Authenticate(req)
 		if u != nil && err == nil { // we don't validate len(u.Identities) here like Istio does since this isn't relevant
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"math"
 	"net"
+	"net/http"
 
 	envoy_service_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/service/cluster/v3"
 	envoy_service_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
@@ -25,6 +26,7 @@ import (
 	"istio.io/istio/pkg/security"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
 
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wasm"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
 	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
 )
@@ -95,10 +97,11 @@ func NewControlPlane(
 	authenticators []security.Authenticator,
 	xdsAuth bool,
 	certWatcher *certwatcher.CertWatcher,
+	xdsRejections *xds.RejectionTracker,
 ) envoycache.SnapshotCache {
 	baseLogger := slog.Default().With("component", "envoy-controlplane")
 	envoyLoggerAdapter := &slogAdapterForEnvoy{logger: baseLogger}
-	lnc := newLogNackCallback()
+	lnc := newLogNackCallback(xdsRejections)
 	allCallbacks := chainCallbacks(callbacks, lnc)
 
 	// Create separate gRPC servers for each listener
@@ -182,3 +185,33 @@ func getGRPCServerOpts(
 
 	return opts
 }
+
+// getWasmModuleServeOptions returns the options of the server of the Wasm modules, authenticating the proxies
+// and serving TLS like the xDS server.
+func getWasmModuleServeOptions(
+	authenticators []security.Authenticator,
+	xdsAuth bool,
+	certWatcher *certwatcher.CertWatcher,
+) wasm.ServeOptions {
+	var opts wasm.ServeOptions
+	if xdsAuth {
+		opts.Authenticate = func(r *http.Request) error {
+			am := authenticationManager{
+				Authenticators: authenticators,
+			}
+			if am.authenticateHTTP(r) == nil {
+				return fmt.Errorf("authentication failed: %v", am.authFailMsgs)
+			}
+			return nil
+		}
+	} else {
+		slog.Warn("Wasm module server authentication is disabled")
+	}
+	if certWatcher != nil {
+		opts.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: certWatcher.GetCertificate,
+		}
+	}
+	return opts
+}
@@ -8,6 +8,7 @@ import (
 	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	xdsserver "github.com/envoyproxy/go-control-plane/pkg/server/v3"
 	"google.golang.org/genproto/googleapis/rpc/status"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
@@ -43,28 +44,39 @@ type resourceKey struct {
 	ResourceTypeUrl string
 }
 
+func (k resourceKey) gateway() types.NamespacedName {
+	return types.NamespacedName{Namespace: k.Namespace, Name: k.Name}
+}
+
 type resourceState struct {
-	errors map[resourceKey]struct{}
+	// errors holds the message of the last error reported for each rejected resource
+	errors map[resourceKey]string
 }
 
 func newResourceState() resourceState {
 	return resourceState{
-		errors: make(map[resourceKey]struct{}),
+		errors: make(map[resourceKey]string),
 	}
 }
 
 type logNackCallback struct {
 	xdsserver.CallbackFuncs
 	streamState map[int64]resourceState
+	// deltaNodes holds the node of each incremental xDS stream, as the node may only be set on the first request
+	deltaNodes map[int64]*envoycorev3.Node
+	// rejections tracks the active NACKs to report them on the status of the Gateways
+	rejections *xds.RejectionTracker
 
 	lock sync.Mutex
 }
 
 var _ xdsserver.Callbacks = (*logNackCallback)(nil)
 
-func newLogNackCallback() *logNackCallback {
+func newLogNackCallback(rejections *xds.RejectionTracker) *logNackCallback {
 	return &logNackCallback{
 		streamState: make(map[int64]resourceState),
+		deltaNodes:  make(map[int64]*envoycorev3.Node),
+		rejections:  rejections,
 	}
 }
 
@@ -80,13 +92,44 @@ func (l *logNackCallback) OnStreamClosed(streamID int64, node *envoycorev3.Node)
 	}
 }
 
+// OnDeltaStreamClosed implements server.Callbacks.
+func (l *logNackCallback) OnDeltaStreamClosed(streamID int64, node *envoycorev3.Node) {
+	l.lock.Lock()
+	delete(l.deltaNodes, streamID)
+	l.lock.Unlock()
+
+	l.OnStreamClosed(streamID, node)
+}
+
 // OnStreamRequest implements server.Callbacks.
 func (l *logNackCallback) OnStreamRequest(streamID int64, req *discoveryv3.DiscoveryRequest) error {
+	l.handleRequest(streamID, req.GetNode(), req.GetTypeUrl(), req.GetErrorDetail())
+	return nil
+}
+
+// OnStreamDeltaRequest implements server.Callbacks.
+func (l *logNackCallback) OnStreamDeltaRequest(streamID int64, req *discoveryv3.DeltaDiscoveryRequest) error {
+	l.handleRequest(streamID, l.deltaNode(streamID, req.GetNode()), req.GetTypeUrl(), req.GetErrorDetail())
+	return nil
+}
+
+// deltaNode returns the node of the request, or the node of the first request of the stream if it is not set.
+func (l *logNackCallback) deltaNode(streamID int64, node *envoycorev3.Node) *envoycorev3.Node {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if node == nil {
+		return l.deltaNodes[streamID]
+	}
+	l.deltaNodes[streamID] = node
+	return node
+}
+
+func (l *logNackCallback) handleRequest(streamID int64, node *envoycorev3.Node, typeUrl string, errorDetail *status.Status) {
 	// get gateway and typeURL from request
-	role := req.GetNode().GetMetadata().GetFields()[xds.RoleKey].GetStringValue()
+	role := node.GetMetadata().GetFields()[xds.RoleKey].GetStringValue()
 	parts := strings.SplitN(role, xds.KeyDelimiter, 3)
 	if len(parts) != 3 {
-		return nil
+		return
 	}
 	namespace := parts[1]
 	name := parts[2]
@@ -96,26 +139,26 @@ func (l *logNackCallback) OnStreamRequest(streamID int64, req *discoveryv3.Disco
 		name = localityParts[0]
 	}
 
-	typeUrl := req.GetTypeUrl()
 	key := resourceKey{
 		Namespace:       namespace,
 		Name:            name,
 		ResourceTypeUrl: strings.TrimPrefix(typeUrl, "type.googleapis.com/"),
 	}
 
-	if req.ErrorDetail != nil {
-		if !l.handleError(streamID, key) {
-			// Log NACK only once per resource
-			return nil
+	if errorDetail != nil {
+		// Log NACK only once per resource and error
+		newError, changed := l.handleError(streamID, key, errorDetail.GetMessage())
+		if newError {
+			l.onNewError(key, errorDetail)
+		} else if changed {
+			l.onErrorChanged(key, errorDetail)
 		}
-		l.onNewError(key, req.ErrorDetail)
 	} else {
 		errorGone := l.handleNoError(streamID, key)
 		if errorGone {
 			l.onErrorGone(key)
 		}
 	}
-	return nil
 }
 
 func (l *logNackCallback) onNewError(key resourceKey, err *status.Status) {
@@ -123,10 +166,18 @@ func (l *logNackCallback) onNewError(key resourceKey, err *status.Status) {
 	xdsRejectsTotal.Inc(labels...)
 	xdsRejectsCurrent.Add(1, labels...)
 	logger.Warn("xds error", "gateway_name", key.Name, "gateway_ns", key.Namespace, "resource", key.ResourceTypeUrl, "error", err.Message)
+	l.rejections.Reject(key.gateway(), key.ResourceTypeUrl, err.Message)
+}
+
+func (l *logNackCallback) onErrorChanged(key resourceKey, err *status.Status) {
+	xdsRejectsTotal.Inc(toLabels(key)...)
+	logger.Warn("xds error", "gateway_name", key.Name, "gateway_ns", key.Namespace, "resource", key.ResourceTypeUrl, "error", err.Message)
+	l.rejections.Update(key.gateway(), key.ResourceTypeUrl, err.Message)
 }
 
 func (l *logNackCallback) onErrorGone(key resourceKey) {
 	xdsRejectsCurrent.Add(-1, toLabels(key)...)
+	l.rejections.Resolve(key.gateway(), key.ResourceTypeUrl)
 }
 
 func (l *logNackCallback) handleNoError(streamID int64, key resourceKey) bool {
@@ -138,7 +189,9 @@ func (l *logNackCallback) handleNoError(streamID int64, key resourceKey) bool {
 	return hadKey
 }
 
-func (l *logNackCallback) handleError(streamID int64, key resourceKey) bool {
+// handleError records the error of the stream for the resource, and returns whether the resource was not
+// already rejected by the stream, or was rejected with a different error.
+func (l *logNackCallback) handleError(streamID int64, key resourceKey, message string) (newError bool, changed bool) {
 	l.lock.Lock()
 	defer l.lock.Unlock()
 	streamState := l.streamState[streamID]
@@ -146,11 +199,9 @@ func (l *logNackCallback) handleError(streamID int64, key resourceKey) bool {
 		streamState = newResourceState()
 		l.streamState[streamID] = streamState
 	}
-	if _, exists := streamState.errors[key]; exists {
-		return false
-	}
-	streamState.errors[key] = struct{}{}
-	return true
+	prev, exists := streamState.errors[key]
+	streamState.errors[key] = message
+	return !exists, exists && prev != message
 }
 
 func toLabels(key resourceKey) []metrics.Label {
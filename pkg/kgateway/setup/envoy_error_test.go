@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
 	kmetrics "github.com/kgateway-dev/kgateway/v2/pkg/metrics"
@@ -60,13 +61,19 @@ func expectedGauge(val float64, typeURL string) *metricstest.ExpectedMetric {
 
 func TestSingleErrorLifecycle(t *testing.T) {
 	resetMetrics()
-	cb := newLogNackCallback()
+	rejections := xds.NewRejectionTracker()
+	cb := newLogNackCallback(rejections)
+	gw := types.NamespacedName{Namespace: ns, Name: name}
 
 	// First request with an error -> increments total and gauge
 	require.NoError(t, cb.OnStreamRequest(1, dr(fullType, &status.Status{Message: "boom"})))
 	gathered := metricstest.MustGatherMetrics(t)
 	gathered.AssertMetricsInclude("kgateway_envoy_xds_rejects_total", []metricstest.ExpectMetric{expectedCounter(1, typeURL)})
 	gathered.AssertMetricsInclude("kgateway_envoy_xds_rejects_active", []metricstest.ExpectMetric{expectedGauge(1, typeURL)})
+	// The rejection is tracked to be reported on the Gateway status
+	require.Equal(t, map[types.NamespacedName][]xds.Rejection{gw: {{TypeUrl: typeURL, Message: "boom"}}}, rejections.Rejections())
+	require.Len(t, rejections.Updates(), 1)
+	<-rejections.Updates()
 
 	// Second identical error for same stream/resource should not change metrics
 	require.NoError(t, cb.OnStreamRequest(1, dr(fullType, &status.Status{Message: "boom"})))
@@ -74,10 +81,22 @@ func TestSingleErrorLifecycle(t *testing.T) {
 	gathered.AssertMetricsInclude("kgateway_envoy_xds_rejects_total", []metricstest.ExpectMetric{expectedCounter(1, typeURL)})
 	gathered.AssertMetricsInclude("kgateway_envoy_xds_rejects_active", []metricstest.ExpectMetric{expectedGauge(1, typeURL)})
 
+	// A different error for the same stream/resource counts a new rejection and updates the tracked message,
+	// but the resource is still rejected once
+	require.NoError(t, cb.OnStreamRequest(1, dr(fullType, &status.Status{Message: "bang"})))
+	gathered = metricstest.MustGatherMetrics(t)
+	gathered.AssertMetricsInclude("kgateway_envoy_xds_rejects_total", []metricstest.ExpectMetric{expectedCounter(2, typeURL)})
+	gathered.AssertMetricsInclude("kgateway_envoy_xds_rejects_active", []metricstest.ExpectMetric{expectedGauge(1, typeURL)})
+	require.Equal(t, map[types.NamespacedName][]xds.Rejection{gw: {{TypeUrl: typeURL, Message: "bang"}}}, rejections.Rejections())
+	require.Len(t, rejections.Updates(), 1)
+	<-rejections.Updates()
+
 	// Successful request clears gauge but not counter
 	require.NoError(t, cb.OnStreamRequest(1, dr(fullType, nil)))
+	require.Empty(t, rejections.Rejections())
+	require.Len(t, rejections.Updates(), 1)
 	gathered = metricstest.MustGatherMetrics(t)
-	gathered.AssertMetricsInclude("kgateway_envoy_xds_rejects_total", []metricstest.ExpectMetric{expectedCounter(1, typeURL)})
+	gathered.AssertMetricsInclude("kgateway_envoy_xds_rejects_total", []metricstest.ExpectMetric{expectedCounter(2, typeURL)})
 	// Gauge metric may disappear entirely after reset to 0; we assert either absence or value 0 for our labels
 	// If present, it must have value 0 with our labels; if not present, that's acceptable
 	if gathered.MetricLength("kgateway_envoy_xds_rejects_active") > 0 {
@@ -87,7 +106,9 @@ func TestSingleErrorLifecycle(t *testing.T) {
 
 func TestMultipleResourcesAndStreams(t *testing.T) {
 	resetMetrics()
-	cb := newLogNackCallback()
+	rejections := xds.NewRejectionTracker()
+	cb := newLogNackCallback(rejections)
+	gw := types.NamespacedName{Namespace: ns, Name: name}
 
 	// Stream 1 errors on resource A and B
 	require.NoError(t, cb.OnStreamRequest(1, dr(fullType, &status.Status{Message: "errA"})))
@@ -112,6 +133,11 @@ func TestMultipleResourcesAndStreams(t *testing.T) {
 
 	// Clear resource A error on stream 1 only
 	require.NoError(t, cb.OnStreamRequest(1, dr(fullType, nil)))
+	// Resource A is still rejected by stream 2
+	require.Equal(t, map[types.NamespacedName][]xds.Rejection{gw: {
+		{TypeUrl: typeURL, Message: "errA"},
+		{TypeUrl: typeURL2, Message: "errB"},
+	}}, rejections.Rejections())
 	gathered = metricstest.MustGatherMetrics(t)
 	gathered.AssertMetricsInclude("kgateway_envoy_xds_rejects_total", []metricstest.ExpectMetric{
 		expectedCounter(2, typeURL),
@@ -126,6 +152,7 @@ func TestMultipleResourcesAndStreams(t *testing.T) {
 	// Close stream 2 (remaining A error) and stream 1 (B error)
 	cb.OnStreamClosed(2, nil)
 	cb.OnStreamClosed(1, nil)
+	require.Empty(t, rejections.Rejections())
 	gathered = metricstest.MustGatherMetrics(t)
 	// Counter values unchanged
 	gathered.AssertMetricsInclude("kgateway_envoy_xds_rejects_total", []metricstest.ExpectMetric{
@@ -141,3 +168,28 @@ func TestMultipleResourcesAndStreams(t *testing.T) {
 		})
 	}
 }
+
+func TestDeltaErrorLifecycle(t *testing.T) {
+	resetMetrics()
+	rejections := xds.NewRejectionTracker()
+	cb := newLogNackCallback(rejections)
+	gw := types.NamespacedName{Namespace: ns, Name: name}
+
+	// the node is only set on the first request of the stream
+	first := dr(fullType, nil)
+	require.NoError(t, cb.OnStreamDeltaRequest(1, &discoveryv3.DeltaDiscoveryRequest{Node: first.GetNode(), TypeUrl: fullType}))
+	require.Empty(t, rejections.Rejections())
+
+	require.NoError(t, cb.OnStreamDeltaRequest(1, &discoveryv3.DeltaDiscoveryRequest{
+		TypeUrl:     fullType,
+		ErrorDetail: &status.Status{Message: "boom"},
+	}))
+	gathered := metricstest.MustGatherMetrics(t)
+	gathered.AssertMetricsInclude("kgateway_envoy_xds_rejects_total", []metricstest.ExpectMetric{expectedCounter(1, typeURL)})
+	gathered.AssertMetricsInclude("kgateway_envoy_xds_rejects_active", []metricstest.ExpectMetric{expectedGauge(1, typeURL)})
+	require.Equal(t, map[types.NamespacedName][]xds.Rejection{gw: {{TypeUrl: typeURL, Message: "boom"}}}, rejections.Rejections())
+
+	cb.OnDeltaStreamClosed(1, first.GetNode())
+	require.Empty(t, rejections.Rejections())
+	require.Empty(t, cb.deltaNodes)
+}
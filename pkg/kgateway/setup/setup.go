@@ -274,8 +274,9 @@ func New(opts ...func(*setup)) (*setup, error) {
 	}
 
 	if s.validator == nil {
-		s.validator = validator.NewBinary()
+		s.validator = validator.NewWithFallback(s.globalSettings.XdsValidatorFallback)
 	}
+	slog.Info("using xds validator", "validator", fmt.Sprint(s.validator), "validation_mode", s.globalSettings.ValidationMode)
 
 	return s, nil
 }
@@ -323,15 +324,26 @@ func (s *setup) Start(ctx context.Context) error {
 
 	// Only create Envoy control plane if Envoy controller is enabled
 	var cache envoycache.SnapshotCache
+	xdsRejections := xds.NewRejectionTracker()
+	var snapshotTracker *proxy_syncer.SnapshotTracker
+	var gatewayTranslations *proxy_syncer.GatewayTranslations
 	if s.globalSettings.EnableEnvoy {
-		cache = NewControlPlane(ctx, s.xdsListener, uniqueClientCallbacks, authenticators, s.globalSettings.XdsAuth, certWatcher)
+		gatewayTranslations = proxy_syncer.NewGatewayTranslations()
+		// the snapshot tracker relies on the unique client name set by the uniquely connected clients callbacks
+		snapshotTracker = proxy_syncer.NewSnapshotTracker(s.globalSettings.XdsNackRollback)
+		callbacks := chainCallbacks(uniqueClientCallbacks, snapshotTracker)
+		cache = NewControlPlane(ctx, s.xdsListener, callbacks, authenticators, s.globalSettings.XdsAuth, certWatcher, xdsRejections)
 	}
 
 	setupOpts := &controller.SetupOpts{
-		Cache:          cache,
-		KrtDebugger:    s.krtDebugger,
-		GlobalSettings: s.globalSettings,
-		CertWatcher:    certWatcher,
+		Cache:               cache,
+		KrtDebugger:         s.krtDebugger,
+		GlobalSettings:      s.globalSettings,
+		CertWatcher:         certWatcher,
+		XdsRejections:       xdsRejections,
+		SnapshotTracker:     snapshotTracker,
+		GatewayTranslations: gatewayTranslations,
+		XdsValidator:        s.validator,
 	}
 
 	slog.Info("creating krt collections")
@@ -376,6 +388,11 @@ func (s *setup) Start(ctx context.Context) error {
 		return err
 	}
 
+	if s.globalSettings.EnableEnvoy && s.globalSettings.EnableWasmModuleServer {
+		go commoncol.WasmModules.Serve(ctx, s.globalSettings.WasmServicePort,
+			getWasmModuleServeOptions(authenticators, s.globalSettings.XdsAuth, certWatcher))
+	}
+
 	slog.Info("starting admin server")
 	go admin.RunAdminServer(ctx, setupOpts)
 
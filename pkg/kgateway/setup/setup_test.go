@@ -322,6 +322,114 @@ spec:
 	})
 }
 
+func TestDeltaXds(t *testing.T) {
+	st, err := envtestutil.BuildSettings()
+	if err != nil {
+		t.Fatalf("can't get settings %v", err)
+	}
+	setupEnvTestAndRun(t, st, func(t *testing.T, ctx context.Context, kdbg *krt.DebugHandler, client istiokube.CLIClient, xdsPort int) {
+		client.Kube().CoreV1().Namespaces().Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "gwtest"}}, metav1.CreateOptions{})
+
+		err = client.ApplyYAMLContents("gwtest", `kind: Gateway
+apiVersion: gateway.networking.k8s.io/v1
+metadata:
+  name: delta-gw
+  namespace: gwtest
+spec:
+  gatewayClassName: kgateway
+  listeners:
+  - protocol: HTTP
+    port: 8080
+    name: http
+    allowedRoutes:
+      namespaces:
+        from: All`, `apiVersion: gateway.networking.k8s.io/v1beta1
+kind: HTTPRoute
+metadata:
+  name: delta
+  namespace: gwtest
+spec:
+  parentRefs:
+    - name: delta-gw
+  hostnames:
+    - "www.example.com"
+  rules:
+    - backendRefs:
+        - name: kubernetes
+          port: 443`)
+		if err != nil {
+			t.Fatalf("failed to apply yaml: %v", err)
+		}
+		t.Cleanup(func() {
+			if t.Failed() {
+				logKrtState(t, fmt.Sprintf("krt state for failed test: %s", t.Name()), kdbg)
+			}
+		})
+
+		conn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", xdsPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			t.Fatalf("failed to connect to xds server: %v", err)
+		}
+		t.Cleanup(func() { conn.Close() })
+		ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		t.Cleanup(cancel)
+		deltaClient, err := envoy_service_discovery_v3.NewAggregatedDiscoveryServiceClient(conn).DeltaAggregatedResources(ctx)
+		if err != nil {
+			t.Fatalf("failed to get delta ads client: %v", err)
+		}
+
+		// like envoy, only set the node on the first request of the stream
+		err = deltaClient.Send(&envoy_service_discovery_v3.DeltaDiscoveryRequest{
+			Node: &envoycorev3.Node{
+				Id: "gateway.gwtest",
+				Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"role": structpb.NewStringValue("kgateway-kube-gateway-api~gwtest~delta-gw"),
+				}},
+			},
+			TypeUrl: "type.googleapis.com/envoy.config.listener.v3.Listener",
+		})
+		if err != nil {
+			t.Fatalf("failed to send delta request: %v", err)
+		}
+
+		// the listener is sent once the Gateway is translated, without its routes at first
+		listeners := map[string]*envoylistenerv3.Listener{}
+		for len(listeners) == 0 || len(getroutesnames(listeners["listener~8080"])) == 0 {
+			resp, err := deltaClient.Recv()
+			if err != nil {
+				t.Fatalf("failed to receive delta response: %v", err)
+			}
+			t.Logf("got delta response: %s resources: %d removed: %d", resp.GetTypeUrl(), len(resp.GetResources()), len(resp.GetRemovedResources()))
+			for _, res := range resp.GetResources() {
+				var listener envoylistenerv3.Listener
+				if err := res.GetResource().UnmarshalTo(&listener); err != nil {
+					t.Fatalf("failed to unmarshal listener: %v", err)
+				}
+				if res.GetVersion() == "" {
+					t.Errorf("expected listener %s to be versioned", res.GetName())
+				}
+				listeners[res.GetName()] = &listener
+			}
+			for _, name := range resp.GetRemovedResources() {
+				delete(listeners, name)
+			}
+			// ACK the response, without the node
+			err = deltaClient.Send(&envoy_service_discovery_v3.DeltaDiscoveryRequest{
+				TypeUrl:       resp.GetTypeUrl(),
+				ResponseNonce: resp.GetNonce(),
+			})
+			if err != nil {
+				t.Fatalf("failed to ack delta response: %v", err)
+			}
+		}
+		if _, ok := listeners["listener~8080"]; !ok {
+			t.Fatalf("expected listener~8080, got %v", slices.Collect(maps.Keys(listeners)))
+		}
+
+		t.Logf("%s finished", t.Name())
+	})
+}
+
 func TestServiceAppProtocolUpdate(t *testing.T) {
 	st, err := envtestutil.BuildSettings()
 	if err != nil {
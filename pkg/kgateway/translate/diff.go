@@ -0,0 +1,332 @@
+package translate
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyhcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcpv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	"google.golang.org/protobuf/proto"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/irtranslator"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
+)
+
+// ChangeType is the type of change of a resource between two translations.
+type ChangeType string
+
+const (
+	Added    ChangeType = "added"
+	Removed  ChangeType = "removed"
+	Modified ChangeType = "modified"
+)
+
+// ResourceChange is the change of an xDS resource, or of a Gateway API or kgateway resource.
+type ResourceChange struct {
+	// Name identifies the resource. A route is named after its route configuration and virtual host,
+	// and the rule and match of the Gateway API route it is translated from, as the names of the
+	// Envoy routes contain their position in the virtual host. The name of a filter is prefixed with
+	// the names of its listener and filter chain, as these names are only unique within their parent.
+	Name   string     `json:"name"`
+	Change ChangeType `json:"change"`
+}
+
+// GatewayDiff holds the changes of the xDS resources of a Gateway.
+type GatewayDiff struct {
+	Gateway   string           `json:"gateway"`
+	Change    ChangeType       `json:"change"`
+	Listeners []ResourceChange `json:"listeners,omitempty"`
+	Routes    []ResourceChange `json:"routes,omitempty"`
+	// Clusters are the changed clusters used by the Gateway.
+	Clusters []ResourceChange `json:"clusters,omitempty"`
+	// Filters are the changed network filters and HTTP filters of the listeners.
+	Filters []ResourceChange `json:"filters,omitempty"`
+}
+
+// ConfigDiff is the semantic difference between the translations of two sets of resources.
+type ConfigDiff struct {
+	// Resources are the changed Gateway API and kgateway resources, named kind/namespace/name.
+	Resources []ResourceChange `json:"resources,omitempty"`
+	// Gateways are the Gateways whose xDS resources changed.
+	Gateways []GatewayDiff `json:"gateways,omitempty"`
+}
+
+// Diff returns the difference between the translations of two sets of resources.
+func Diff(
+	beforeObjs []client.Object,
+	before map[types.NamespacedName]Result,
+	afterObjs []client.Object,
+	after map[types.NamespacedName]Result,
+) *ConfigDiff {
+	d := &ConfigDiff{
+		Resources: diffObjects(beforeObjs, afterObjs),
+	}
+
+	added, removed, modified := cmputils.MapDiff(before, after, func(_, _ Result) bool {
+		// the resources of the Gateways in both translations are compared below
+		return false
+	})
+	for _, gwNN := range added {
+		d.Gateways = append(d.Gateways, diffGateway(gwNN, Added, Result{}, after[gwNN]))
+	}
+	for _, gwNN := range removed {
+		d.Gateways = append(d.Gateways, diffGateway(gwNN, Removed, before[gwNN], Result{}))
+	}
+	for _, gwNN := range modified {
+		gwDiff := diffGateway(gwNN, Modified, before[gwNN], after[gwNN])
+		if len(gwDiff.Listeners)+len(gwDiff.Routes)+len(gwDiff.Clusters)+len(gwDiff.Filters) > 0 {
+			d.Gateways = append(d.Gateways, gwDiff)
+		}
+	}
+	slices.SortFunc(d.Gateways, func(a, b GatewayDiff) int {
+		return cmp.Compare(a.Gateway, b.Gateway)
+	})
+	return d
+}
+
+// Changes returns the total number of changed listeners, routes, clusters and filters of the Gateways.
+func (d *ConfigDiff) Changes() (listeners, routes, clusters, filters int) {
+	for _, gw := range d.Gateways {
+		listeners += len(gw.Listeners)
+		routes += len(gw.Routes)
+		clusters += len(gw.Clusters)
+		filters += len(gw.Filters)
+	}
+	return listeners, routes, clusters, filters
+}
+
+func diffGateway(gwNN types.NamespacedName, change ChangeType, before, after Result) GatewayDiff {
+	beforeIdx := indexGateway(before)
+	afterIdx := indexGateway(after)
+	return GatewayDiff{
+		Gateway:   gwNN.String(),
+		Change:    change,
+		Listeners: diffResources(beforeIdx.listeners, afterIdx.listeners),
+		Routes:    diffResources(beforeIdx.routes, afterIdx.routes),
+		Clusters:  diffResources(beforeIdx.clusters, afterIdx.clusters),
+		Filters:   diffResources(beforeIdx.filters, afterIdx.filters),
+	}
+}
+
+func diffResources[T proto.Message](before, after map[string]T) []ResourceChange {
+	added, removed, modified := cmputils.MapDiff(before, after, func(a, b T) bool {
+		return proto.Equal(a, b)
+	})
+	return resourceChanges(added, removed, modified)
+}
+
+func resourceChanges(added, removed, modified []string) []ResourceChange {
+	changes := make([]ResourceChange, 0, len(added)+len(removed)+len(modified))
+	for _, name := range added {
+		changes = append(changes, ResourceChange{Name: name, Change: Added})
+	}
+	for _, name := range removed {
+		changes = append(changes, ResourceChange{Name: name, Change: Removed})
+	}
+	for _, name := range modified {
+		changes = append(changes, ResourceChange{Name: name, Change: Modified})
+	}
+	slices.SortFunc(changes, func(a, b ResourceChange) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return changes
+}
+
+// gatewayIndex indexes the xDS resources of a Gateway by name.
+type gatewayIndex struct {
+	// listeners are indexed without their filters, which are indexed separately
+	listeners map[string]*envoylistenerv3.Listener
+	routes    map[string]*envoyroutev3.Route
+	clusters  map[string]*envoyclusterv3.Cluster
+	filters   map[string]proto.Message
+}
+
+func indexGateway(result Result) gatewayIndex {
+	idx := gatewayIndex{
+		listeners: make(map[string]*envoylistenerv3.Listener),
+		routes:    make(map[string]*envoyroutev3.Route),
+		clusters:  make(map[string]*envoyclusterv3.Cluster),
+		filters:   make(map[string]proto.Message),
+	}
+	if result.Proxy == nil {
+		return idx
+	}
+
+	sources := routeSources(result.IR)
+	usedClusters := make(map[string]struct{})
+	for _, routeConfig := range result.Proxy.Routes {
+		for _, vhost := range routeConfig.GetVirtualHosts() {
+			prefix := routeConfig.GetName() + "/" + vhost.GetName() + "/"
+			for i, route := range vhost.GetRoutes() {
+				name, ok := sources[route.GetName()]
+				if !ok {
+					name = route.GetName()
+				}
+				if name == "" {
+					name = strconv.Itoa(i)
+				}
+				// rules with the same match of the same route are numbered in their order
+				key := prefix + name
+				for n := 2; idx.routes[key] != nil; n++ {
+					key = fmt.Sprintf("%s%s#%d", prefix, name, n)
+				}
+				addRouteClusters(usedClusters, route)
+				// the name of the Envoy route contains its position, which is not a change of the route
+				route = proto.Clone(route).(*envoyroutev3.Route)
+				route.Name = ""
+				idx.routes[key] = route
+			}
+		}
+	}
+
+	for _, listener := range result.Proxy.Listeners {
+		listener = proto.Clone(listener).(*envoylistenerv3.Listener)
+		filterChains := listener.GetFilterChains()
+		if listener.GetDefaultFilterChain() != nil {
+			filterChains = append(filterChains, listener.GetDefaultFilterChain())
+		}
+		for _, filterChain := range filterChains {
+			prefix := listener.GetName() + "/" + filterChain.GetName() + "/"
+			for _, filter := range filterChain.GetFilters() {
+				idx.indexFilter(prefix, filter, usedClusters)
+			}
+			filterChain.Filters = nil
+		}
+		idx.listeners[listener.GetName()] = listener
+	}
+
+	for _, cluster := range result.Proxy.ExtraClusters {
+		idx.clusters[cluster.GetName()] = cluster
+	}
+	for _, cluster := range result.Clusters {
+		if _, ok := usedClusters[cluster.GetName()]; ok {
+			idx.clusters[cluster.GetName()] = cluster
+		}
+	}
+	return idx
+}
+
+// routeSources maps the names of the Envoy routes translated from the IR of a Gateway to a name that
+// identifies the rule and match of the route they are translated from, and does not change when
+// routes are added or removed before them.
+func routeSources(gwIR *ir.GatewayIR) map[string]string {
+	out := make(map[string]string)
+	if gwIR == nil {
+		return out
+	}
+	for _, l := range gwIR.Listeners {
+		for _, fc := range l.HttpFilterChain {
+			for _, vh := range fc.Vhosts {
+				for i, rule := range vh.Rules {
+					if rule.Parent == nil {
+						continue
+					}
+					src := rule.Parent.ObjectSource
+					name := fmt.Sprintf("%s/%s/%s", src.Kind, src.Namespace, src.Name)
+					if ruleName := sourceRuleName(rule); ruleName != "" {
+						name += "/" + ruleName
+					}
+					out[irtranslator.XdsRouteName(vh, i, rule)] = name + "/" + matchName(rule.Match)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// sourceRuleName returns the name of the rule of the route a match is translated from, if the rule
+// is named. The name of the match IR is not used, as it contains the index of the rule.
+func sourceRuleName(rule ir.HttpRouteRuleMatchIR) string {
+	for _, r := range rule.Parent.Rules {
+		if r.Name != "" && rule.MatchIndex < len(r.Matches) && apiequality.Semantic.DeepEqual(r.Matches[rule.MatchIndex], rule.Match) {
+			return r.Name
+		}
+	}
+	return ""
+}
+
+// matchName returns a name identifying an HTTP route match.
+func matchName(match gwv1.HTTPRouteMatch) string {
+	b, err := json.Marshal(match)
+	if err != nil {
+		return fmt.Sprintf("%v", match)
+	}
+	return string(b)
+}
+
+// indexFilter indexes a network filter. The HTTP filters of an HTTP connection manager are indexed
+// as separate filters, so that a change of an HTTP filter is reported once.
+func (idx gatewayIndex) indexFilter(prefix string, filter *envoylistenerv3.Filter, usedClusters map[string]struct{}) {
+	msg, err := utils.AnyToMessage(filter.GetTypedConfig())
+	if err != nil {
+		idx.filters[prefix+filter.GetName()] = filter
+		return
+	}
+	switch config := msg.(type) {
+	case *envoyhcmv3.HttpConnectionManager:
+		for _, httpFilter := range config.GetHttpFilters() {
+			idx.filters[prefix+filter.GetName()+"/"+httpFilter.GetName()] = httpFilter
+		}
+		config.HttpFilters = nil
+		idx.filters[prefix+filter.GetName()] = config
+	case *envoytcpv3.TcpProxy:
+		if cluster := config.GetCluster(); cluster != "" {
+			usedClusters[cluster] = struct{}{}
+		}
+		for _, weighted := range config.GetWeightedClusters().GetClusters() {
+			usedClusters[weighted.GetName()] = struct{}{}
+		}
+		idx.filters[prefix+filter.GetName()] = config
+	default:
+		idx.filters[prefix+filter.GetName()] = msg
+	}
+}
+
+func addRouteClusters(usedClusters map[string]struct{}, route *envoyroutev3.Route) {
+	action := route.GetRoute()
+	if action == nil {
+		return
+	}
+	if cluster := action.GetCluster(); cluster != "" {
+		usedClusters[cluster] = struct{}{}
+	}
+	for _, weighted := range action.GetWeightedClusters().GetClusters() {
+		usedClusters[weighted.GetName()] = struct{}{}
+	}
+	for _, mirror := range action.GetRequestMirrorPolicies() {
+		usedClusters[mirror.GetCluster()] = struct{}{}
+	}
+}
+
+// diffObjects returns the changes of the Gateway API and kgateway resources.
+func diffObjects(before, after []client.Object) []ResourceChange {
+	added, removed, modified := cmputils.MapDiff(indexObjects(before), indexObjects(after), func(a, b client.Object) bool {
+		return apiequality.Semantic.DeepEqual(a, b)
+	})
+	return resourceChanges(added, removed, modified)
+}
+
+func indexObjects(objs []client.Object) map[string]client.Object {
+	idx := make(map[string]client.Object, len(objs))
+	for _, obj := range objs {
+		obj = obj.DeepCopyObject().(client.Object)
+		// the creation timestamps of the loaded resources depend on their order in the manifests
+		obj.SetCreationTimestamp(metav1.Time{})
+		kind := obj.GetObjectKind().GroupVersionKind().Kind
+		idx[fmt.Sprintf("%s/%s/%s", kind, obj.GetNamespace(), obj.GetName())] = obj
+	}
+	return idx
+}
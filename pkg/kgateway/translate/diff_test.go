@@ -0,0 +1,68 @@
+package translate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+)
+
+func TestDiff(t *testing.T) {
+	ctx := t.Context()
+
+	settings, err := apisettings.BuildSettings()
+	require.NoError(t, err)
+	opts := Options{
+		Settings:  *settings,
+		Validator: &mockValidator{},
+	}
+	before, beforeObjs, err := TranslateFiles(ctx, []string{"testdata/gateway.yaml"}, opts)
+	require.NoError(t, err)
+	after, afterObjs, err := TranslateFiles(ctx, []string{"testdata/gateway-changed.yaml"}, opts)
+	require.NoError(t, err)
+
+	d := Diff(beforeObjs, before, afterObjs, after)
+
+	assert.Equal(t, []ResourceChange{
+		{Name: "Gateway/default/gw2", Change: Added},
+		{Name: "TrafficPolicy/default/timeout", Change: Modified},
+	}, d.Resources)
+
+	require.Len(t, d.Gateways, 2)
+	gw := d.Gateways[0]
+	assert.Equal(t, "default/gw", gw.Gateway)
+	assert.Equal(t, Modified, gw.Change)
+	assert.Empty(t, gw.Listeners)
+	assert.Empty(t, gw.Clusters)
+	assert.Empty(t, gw.Filters)
+	assert.Equal(t, []ResourceChange{{
+		Name:   `listener~8080/listener~8080~example_com/HTTPRoute/default/example-route/{"path":{"type":"PathPrefix","value":"/"}}`,
+		Change: Modified,
+	}}, gw.Routes)
+
+	gw2 := d.Gateways[1]
+	assert.Equal(t, "default/gw2", gw2.Gateway)
+	assert.Equal(t, Added, gw2.Change)
+	assert.Equal(t, []ResourceChange{{Name: "listener~8081", Change: Added}}, gw2.Listeners)
+	assert.Len(t, gw2.Filters, 2)
+
+	listeners, routes, clusters, filters := d.Changes()
+	assert.Equal(t, []int{1, 1, 0, 2}, []int{listeners, routes, clusters, filters})
+
+	// adding a rule before an existing rule does not change the existing route
+	ruleAdded, ruleAddedObjs, err := TranslateFiles(ctx, []string{"testdata/gateway-rule-added.yaml"}, opts)
+	require.NoError(t, err)
+	d = Diff(beforeObjs, before, ruleAddedObjs, ruleAdded)
+	require.Len(t, d.Gateways, 1)
+	assert.Equal(t, []ResourceChange{{
+		Name:   `listener~8080/listener~8080~example_com/HTTPRoute/default/example-route/api/{"path":{"type":"PathPrefix","value":"/api"}}`,
+		Change: Added,
+	}}, d.Gateways[0].Routes)
+
+	// translating the same resources results in no changes
+	d = Diff(beforeObjs, before, beforeObjs, before)
+	assert.Empty(t, d.Resources)
+	assert.Empty(t, d.Gateways)
+}
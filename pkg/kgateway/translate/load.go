@@ -0,0 +1,140 @@
+package translate
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"time"
+
+	apiserverschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwxv1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	kgatewaycrds "github.com/kgateway-dev/kgateway/v2/install/helm/kgateway-crds"
+	"github.com/kgateway-dev/kgateway/v2/pkg/apiclient"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/crds"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/schemes"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/manifests"
+)
+
+// Scheme returns the scheme of the Gateway API and kgateway resources that can be translated.
+func Scheme() (*runtime.Scheme, error) {
+	scheme := schemes.GatewayScheme()
+	if err := kgateway.Install(scheme); err != nil {
+		return nil, fmt.Errorf("error adding kgateway types to scheme: %w", err)
+	}
+	return scheme, nil
+}
+
+// StructuralSchemas returns the structural schemas of the Gateway API and kgateway CRDs, used to apply
+// the defaults of the API server to the loaded resources.
+func StructuralSchemas() (map[schema.GroupVersionKind]*apiserverschema.Structural, error) {
+	crdYAMLs := [][]byte{crds.GatewayCrds}
+	crdFiles, err := fs.Glob(kgatewaycrds.CRDs, "templates/*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	for _, crdFile := range crdFiles {
+		crdYAML, err := fs.ReadFile(kgatewaycrds.CRDs, crdFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading CRD %s: %w", crdFile, err)
+		}
+		crdYAMLs = append(crdYAMLs, crdYAML)
+	}
+	return manifests.StructuralSchemasFromYAML(crdYAMLs...)
+}
+
+// LoadFiles loads the resources of the given manifest files, or directories of manifests, and applies the defaults of their CRDs.
+// Resources of unknown types are ignored.
+func LoadFiles(files []string) ([]client.Object, error) {
+	scheme, err := Scheme()
+	if err != nil {
+		return nil, err
+	}
+	gvkToStructuralSchema, err := StructuralSchemas()
+	if err != nil {
+		return nil, fmt.Errorf("error getting structural schemas: %w", err)
+	}
+
+	var allObjs []client.Object
+	var fakeNow time.Time
+	for _, file := range files {
+		objs, err := manifests.LoadFromFiles(file, scheme, gvkToStructuralSchema, "default", nil)
+		if err != nil {
+			return nil, fmt.Errorf("error loading %s: %w", file, err)
+		}
+		// the oldest policy wins conflicts, so order the resources as they appear in the files
+		for _, obj := range objs {
+			if ts := obj.GetCreationTimestamp(); ts.IsZero() {
+				fakeNow = fakeNow.Add(time.Second)
+				obj.SetCreationTimestamp(metav1.NewTime(fakeNow))
+			}
+		}
+		allObjs = append(allObjs, objs...)
+	}
+	return allObjs, nil
+}
+
+// NewClient returns an in-memory client serving the given resources. The default GatewayClasses are
+// created for the kgateway controller unless they are part of the resources.
+func NewClient(ctx context.Context, objs ...client.Object) (apiclient.Client, error) {
+	cli, err := apiclient.NewInMemoryClient(nil, objs...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating client: %w", err)
+	}
+
+	for _, className := range []string{wellknown.DefaultGatewayClassName, wellknown.DefaultWaypointClassName} {
+		_, err := cli.GatewayAPI().GatewayV1().GatewayClasses().Create(ctx, &gwv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: className,
+			},
+			Spec: gwv1.GatewayClassSpec{
+				ControllerName: wellknown.DefaultGatewayControllerName,
+			},
+		}, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			cli.Shutdown()
+			return nil, fmt.Errorf("error creating GatewayClass %s: %w", className, err)
+		}
+	}
+	return cli, nil
+}
+
+// TranslateFiles translates the resources of the given manifest files, or directories of manifests,
+// and returns the translation of each Gateway along with the loaded resources.
+func TranslateFiles(ctx context.Context, files []string, opts Options) (map[types.NamespacedName]Result, []client.Object, error) {
+	objs, err := LoadFiles(files)
+	if err != nil {
+		return nil, nil, err
+	}
+	cli, err := NewClient(ctx, objs...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer cli.Shutdown()
+
+	results, err := Translate(ctx, cli, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return results, objs, nil
+}
+
+// ListenerSets returns the XListenerSets of the resources, used to populate the status of their listeners.
+func ListenerSets(objs []client.Object) map[types.NamespacedName]*gwxv1.XListenerSet {
+	listenerSets := make(map[types.NamespacedName]*gwxv1.XListenerSet)
+	for _, obj := range objs {
+		if ls, ok := obj.(*gwxv1.XListenerSet); ok {
+			listenerSets[client.ObjectKeyFromObject(ls)] = ls
+		}
+	}
+	return listenerSets
+}
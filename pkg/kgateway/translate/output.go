@@ -0,0 +1,136 @@
+package translate
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwxv1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+// Output is the JSON output of a translation.
+type Output struct {
+	// Gateways are the xDS resources of each Gateway, keyed by namespace/name.
+	Gateways map[string]*GatewayOutput `json:"gateways"`
+	// Statuses are the statuses of all the translated resources.
+	Statuses *Statuses `json:"statuses"`
+}
+
+// GatewayOutput holds the xDS resources of a Gateway, marshaled with protojson and sorted by name.
+type GatewayOutput struct {
+	Listeners     []json.RawMessage `json:"listeners,omitempty"`
+	Routes        []json.RawMessage `json:"routes,omitempty"`
+	Clusters      []json.RawMessage `json:"clusters,omitempty"`
+	ExtraClusters []json.RawMessage `json:"extraClusters,omitempty"`
+	Secrets       []json.RawMessage `json:"secrets,omitempty"`
+}
+
+// NewOutput builds the output of the translation results. The XListenerSets are used to populate
+// the status of their listeners.
+func NewOutput(
+	results map[types.NamespacedName]Result,
+	listenerSets map[types.NamespacedName]*gwxv1.XListenerSet,
+) (*Output, error) {
+	out := &Output{
+		Gateways: make(map[string]*GatewayOutput, len(results)),
+	}
+	gateways := make(map[types.NamespacedName]*gwv1.Gateway, len(results))
+	reportsMap := reports.NewReportMap()
+	for gwNN, result := range results {
+		gateways[gwNN] = result.Gateway
+		mergeReports(reportsMap, result.Reports)
+
+		gwOut := &GatewayOutput{}
+		var err error
+		if gwOut.Clusters, err = marshalSorted(result.Clusters); err != nil {
+			return nil, err
+		}
+		if result.Proxy != nil {
+			if gwOut.Listeners, err = marshalSorted(result.Proxy.Listeners); err != nil {
+				return nil, err
+			}
+			if gwOut.Routes, err = marshalSorted(result.Proxy.Routes); err != nil {
+				return nil, err
+			}
+			if gwOut.ExtraClusters, err = marshalSorted(result.Proxy.ExtraClusters); err != nil {
+				return nil, err
+			}
+			if gwOut.Secrets, err = marshalSorted(result.Proxy.Secrets); err != nil {
+				return nil, err
+			}
+		}
+		out.Gateways[gwNN.String()] = gwOut
+	}
+	out.Statuses = BuildStatuses(reportsMap, gateways, listenerSets)
+	return out, nil
+}
+
+// mergeReports merges the reports of a Gateway translation. Each Gateway translation reports the routes
+// and policies attached to the Gateway, so a route or policy attached to several Gateways is reported once
+// per Gateway, and the reports of the same parent or ancestor are identical.
+func mergeReports(dst, src reports.ReportMap) {
+	for nn, report := range src.Gateways {
+		dst.Gateways[nn] = report
+	}
+	for gvk, listenerSets := range src.ListenerSets {
+		if dst.ListenerSets[gvk] == nil {
+			dst.ListenerSets[gvk] = make(map[types.NamespacedName]*reports.ListenerSetReport)
+		}
+		for nn, report := range listenerSets {
+			dst.ListenerSets[gvk][nn] = report
+		}
+	}
+	mergeRouteReports(dst.HTTPRoutes, src.HTTPRoutes)
+	mergeRouteReports(dst.TCPRoutes, src.TCPRoutes)
+	mergeRouteReports(dst.TLSRoutes, src.TLSRoutes)
+	mergeRouteReports(dst.UDPRoutes, src.UDPRoutes)
+	mergeRouteReports(dst.GRPCRoutes, src.GRPCRoutes)
+	for key, report := range src.Policies {
+		if dst.Policies[key] == nil {
+			dst.Policies[key] = report
+			continue
+		}
+		for ancestor, ancestorReport := range report.Ancestors {
+			dst.Policies[key].Ancestors[ancestor] = ancestorReport
+		}
+	}
+}
+
+func mergeRouteReports(dst, src map[types.NamespacedName]*reports.RouteReport) {
+	for nn, report := range src {
+		if dst[nn] == nil {
+			dst[nn] = report
+			continue
+		}
+		for parentRef, parentReport := range report.Parents {
+			dst[nn].Parents[parentRef] = parentReport
+		}
+	}
+}
+
+type namedMessage interface {
+	proto.Message
+	GetName() string
+}
+
+func marshalSorted[T namedMessage](msgs []T) ([]json.RawMessage, error) {
+	msgs = slices.Clone(msgs)
+	slices.SortFunc(msgs, func(a, b T) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	out := make([]json.RawMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		data, err := protojson.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, data)
+	}
+	return out, nil
+}
@@ -0,0 +1,121 @@
+package translate
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwxv1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+// Statuses are the statuses that the controller would write for the translated resources,
+// keyed by namespace/name, or kind/namespace/name for the policies.
+type Statuses struct {
+	Gateways     map[string]*gwv1.GatewayStatus      `json:"gateways,omitempty"`
+	ListenerSets map[string]*gwxv1.ListenerSetStatus `json:"listenerSets,omitempty"`
+	HTTPRoutes   map[string]*gwv1.RouteStatus        `json:"httpRoutes,omitempty"`
+	TCPRoutes    map[string]*gwv1.RouteStatus        `json:"tcpRoutes,omitempty"`
+	TLSRoutes    map[string]*gwv1.RouteStatus        `json:"tlsRoutes,omitempty"`
+	UDPRoutes    map[string]*gwv1.RouteStatus        `json:"udpRoutes,omitempty"`
+	GRPCRoutes   map[string]*gwv1.RouteStatus        `json:"grpcRoutes,omitempty"`
+	Policies     map[string]*gwv1.PolicyStatus       `json:"policies,omitempty"`
+}
+
+// BuildStatuses builds the statuses of the resources reported in the reports.
+// The Gateway and XListenerSet objects are used to populate the status of their listeners; a missing
+// object is replaced with an empty object of the same name.
+func BuildStatuses(
+	reportsMap reports.ReportMap,
+	gateways map[types.NamespacedName]*gwv1.Gateway,
+	listenerSets map[types.NamespacedName]*gwxv1.XListenerSet,
+) *Statuses {
+	ctx := context.Background()
+
+	statuses := &Statuses{
+		Gateways:     make(map[string]*gwv1.GatewayStatus),
+		ListenerSets: make(map[string]*gwxv1.ListenerSetStatus),
+		HTTPRoutes:   make(map[string]*gwv1.RouteStatus),
+		TCPRoutes:    make(map[string]*gwv1.RouteStatus),
+		TLSRoutes:    make(map[string]*gwv1.RouteStatus),
+		UDPRoutes:    make(map[string]*gwv1.RouteStatus),
+		GRPCRoutes:   make(map[string]*gwv1.RouteStatus),
+		Policies:     make(map[string]*gwv1.PolicyStatus),
+	}
+
+	for gwNN := range reportsMap.Gateways {
+		gw := gwv1.Gateway{ObjectMeta: objectMeta(gwNN)}
+		if actualGw := gateways[gwNN]; actualGw != nil {
+			gw = *actualGw
+		}
+		if status := reportsMap.BuildGWStatus(ctx, gw, nil); status != nil {
+			statuses.Gateways[gwNN.String()] = status
+		}
+	}
+
+	for listenerSetNN := range reportsMap.ListenerSets[wellknown.XListenerSetGVK] {
+		listenerSet := gwxv1.XListenerSet{ObjectMeta: objectMeta(listenerSetNN)}
+		if actualLS := listenerSets[listenerSetNN]; actualLS != nil {
+			listenerSet = *actualLS
+		}
+		if status := reportsMap.BuildListenerSetStatus(ctx, listenerSet); status != nil {
+			statuses.ListenerSets[listenerSetNN.String()] = status
+		}
+	}
+
+	for routeNN := range reportsMap.HTTPRoutes {
+		route := gwv1.HTTPRoute{ObjectMeta: objectMeta(routeNN)}
+		if status := reportsMap.BuildRouteStatus(ctx, &route, wellknown.DefaultGatewayClassName); status != nil {
+			statuses.HTTPRoutes[routeNN.String()] = status
+		}
+	}
+
+	for routeNN := range reportsMap.TCPRoutes {
+		route := gwv1a2.TCPRoute{ObjectMeta: objectMeta(routeNN)}
+		if status := reportsMap.BuildRouteStatus(ctx, &route, wellknown.DefaultGatewayClassName); status != nil {
+			statuses.TCPRoutes[routeNN.String()] = status
+		}
+	}
+
+	for routeNN := range reportsMap.TLSRoutes {
+		route := gwv1a2.TLSRoute{ObjectMeta: objectMeta(routeNN)}
+		if status := reportsMap.BuildRouteStatus(ctx, &route, wellknown.DefaultGatewayClassName); status != nil {
+			statuses.TLSRoutes[routeNN.String()] = status
+		}
+	}
+
+	for routeNN := range reportsMap.UDPRoutes {
+		route := gwv1a2.UDPRoute{ObjectMeta: objectMeta(routeNN)}
+		if status := reportsMap.BuildRouteStatus(ctx, &route, wellknown.DefaultGatewayClassName); status != nil {
+			statuses.UDPRoutes[routeNN.String()] = status
+		}
+	}
+
+	for routeNN := range reportsMap.GRPCRoutes {
+		route := gwv1.GRPCRoute{ObjectMeta: objectMeta(routeNN)}
+		if status := reportsMap.BuildRouteStatus(ctx, &route, wellknown.DefaultGatewayClassName); status != nil {
+			statuses.GRPCRoutes[routeNN.String()] = status
+		}
+	}
+
+	for policyKey := range reportsMap.Policies {
+		policyKeyStr := fmt.Sprintf("%s/%s/%s", policyKey.Kind, policyKey.Namespace, policyKey.Name)
+		if status := reportsMap.BuildPolicyStatus(ctx, policyKey, wellknown.DefaultGatewayControllerName, gwv1.PolicyStatus{}); status != nil {
+			statuses.Policies[policyKeyStr] = status
+		}
+	}
+
+	return statuses
+}
+
+func objectMeta(nn types.NamespacedName) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      nn.Name,
+		Namespace: nn.Namespace,
+	}
+}
@@ -0,0 +1,207 @@
+// Package translate translates Gateway API and kgateway resources to xDS offline, i.e. without a cluster,
+// by running the same krt collections and translators as the controller against an in-memory client.
+package translate
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"google.golang.org/protobuf/proto"
+	kubeclient "istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/pkg/apiclient"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/registry"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/proxy_syncer"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/irtranslator"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
+)
+
+// Options configures an offline translation.
+type Options struct {
+	// Settings are the controller settings to translate the resources with.
+	Settings apisettings.Settings
+	// Validator validates the translated xDS configuration, depending on the xDS validation mode of the settings.
+	Validator validator.Validator
+	// ExtraPlugins returns plugins to merge with the kgateway plugins.
+	ExtraPlugins func(ctx context.Context, commoncol *collections.CommonCollections, mergeSettingsJSON string) []pluginsdk.Plugin
+	// ExtendPlugins is called with the merged plugins before they are initialized.
+	ExtendPlugins func(plugins *pluginsdk.Plugin)
+}
+
+// Result is the translation of a Gateway.
+type Result struct {
+	Gateway *gwv1.Gateway
+	// Proxy holds the listeners, routes, clusters and secrets translated for the Gateway.
+	Proxy *irtranslator.TranslationResult
+	// IR is the intermediate representation the Proxy is translated from.
+	IR *ir.GatewayIR
+	// Clusters are the clusters translated for the backends.
+	Clusters []*envoyclusterv3.Cluster
+	// Reports are the status reports of the translation, including the backend policy reports.
+	Reports reports.ReportMap
+}
+
+// translatedCluster is the cluster translated for a backend.
+type translatedCluster struct {
+	backend string
+	cluster *envoyclusterv3.Cluster
+}
+
+func (c translatedCluster) ResourceName() string {
+	return c.backend
+}
+
+func (c translatedCluster) Equals(in translatedCluster) bool {
+	return c.backend == in.backend && proto.Equal(c.cluster, in.cluster)
+}
+
+// translatedGateway is the translation of a Gateway.
+type translatedGateway struct {
+	types.NamespacedName
+	gateway *gwv1.Gateway
+	proxy   *irtranslator.TranslationResult
+	ir      *ir.GatewayIR
+	reports reports.ReportMap
+}
+
+func (g translatedGateway) ResourceName() string {
+	return g.NamespacedName.String()
+}
+
+// Equals returns true if both are the same translation; every translation of a Gateway is considered a change.
+func (g translatedGateway) Equals(in translatedGateway) bool {
+	return g.NamespacedName == in.NamespacedName && g.proxy == in.proxy
+}
+
+// Translate translates the Gateways of the client, and returns the translation of each Gateway.
+// The client must not be started yet, as the krt collections are built before running it.
+func Translate(ctx context.Context, cli apiclient.Client, opts Options) (map[types.NamespacedName]Result, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	krtOpts := krtutil.KrtOptions{
+		Stop: ctx.Done(),
+	}
+	commoncol, err := collections.NewCommonCollections(
+		ctx,
+		krtOpts,
+		cli,
+		wellknown.DefaultGatewayControllerName,
+		opts.Settings,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating common collections: %w", err)
+	}
+
+	plugins := registry.Plugins(ctx, commoncol, opts.Settings, opts.Validator)
+	plugins = append(plugins, krtcollections.NewBuiltinPlugin(ctx))
+	var extraPlugins []pluginsdk.Plugin
+	if opts.ExtraPlugins != nil {
+		extraPlugins = opts.ExtraPlugins(ctx, commoncol, opts.Settings.PolicyMerge)
+	}
+	plugins = append(plugins, extraPlugins...)
+	extensions := registry.MergePlugins(plugins...)
+	if opts.ExtendPlugins != nil {
+		opts.ExtendPlugins(&extensions)
+	}
+
+	commoncol.InitPlugins(ctx, extensions, opts.Settings)
+
+	gwTranslator := translator.NewCombinedTranslator(ctx, extensions, commoncol, opts.Validator)
+	gwTranslator.Init(ctx)
+
+	// the clusters do not depend on the Gateway, but on the connected client, so translate them once
+	// for a client without per-client configuration
+	backendTranslator := gwTranslator.GetBackendTranslator()
+	ucc := ir.NewUniqlyConnectedClient("translate", "translate", nil, ir.PodLocality{})
+	var clusterCols []krt.Collection[translatedCluster]
+	for i, col := range commoncol.BackendIndex.BackendsWithPolicy() {
+		clusterCols = append(clusterCols, krt.NewCollection(col, func(kctx krt.HandlerContext, backend *ir.BackendObjectIR) *translatedCluster {
+			// backends that fail to translate are replaced with a blackhole cluster, or dropped
+			// by the proxy syncer, and reported on the status of the backend
+			cluster, _ := backendTranslator.TranslateBackend(ctx, kctx, ucc, backend)
+			if cluster == nil {
+				return nil
+			}
+			return &translatedCluster{backend: backend.ResourceName(), cluster: cluster}
+		}, krtOpts.ToOptions(fmt.Sprintf("TranslatedClusters-%d", i))...))
+	}
+
+	gateways := krt.NewCollection(commoncol.GatewayIndex.Gateways, func(kctx krt.HandlerContext, gw ir.Gateway) *translatedGateway {
+		xdsSnap, gwIR, reportsMap := gwTranslator.TranslateGatewayWithIR(kctx, ctx, gw)
+		return &translatedGateway{
+			NamespacedName: types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name},
+			gateway:        gw.Obj,
+			proxy:          xdsSnap,
+			ir:             gwIR,
+			reports:        reportsMap,
+		}
+	}, krtOpts.ToOptions("TranslatedGateways")...)
+
+	cli.RunAndWait(ctx.Done())
+	commoncol.GatewayIndex.Gateways.WaitUntilSynced(ctx.Done())
+
+	kubeclient.WaitForCacheSync("routes", ctx.Done(), commoncol.Routes.HasSynced)
+	kubeclient.WaitForCacheSync("extensions", ctx.Done(), extensions.HasSynced)
+	kubeclient.WaitForCacheSync("commoncol", ctx.Done(), commoncol.HasSynced)
+	kubeclient.WaitForCacheSync("translator", ctx.Done(), gwTranslator.HasSynced)
+	kubeclient.WaitForCacheSync("backends", ctx.Done(), commoncol.BackendIndex.HasSynced)
+	kubeclient.WaitForCacheSync("endpoints", ctx.Done(), commoncol.Endpoints.HasSynced)
+	for i, plug := range extraPlugins {
+		kubeclient.WaitForCacheSync(fmt.Sprintf("extra-%d", i), ctx.Done(), plug.HasSynced)
+	}
+	for _, col := range clusterCols {
+		col.WaitUntilSynced(ctx.Done())
+	}
+	gateways.WaitUntilSynced(ctx.Done())
+
+	var clusters []*envoyclusterv3.Cluster
+	for _, col := range clusterCols {
+		for _, c := range col.List() {
+			clusters = append(clusters, c.cluster)
+		}
+	}
+
+	// Backend policies (e.g. BackendConfigPolicy) are not reported during the gateway translation,
+	// their reports are generated separately by the proxy syncer, so merge both.
+	var backendIRs []*ir.BackendObjectIR
+	for _, col := range commoncol.BackendIndex.BackendsWithPolicyRequiringStatus() {
+		backendIRs = append(backendIRs, col.List()...)
+	}
+
+	results := make(map[types.NamespacedName]Result)
+	for _, gw := range gateways.List() {
+		// the reports of the collection must not be modified, and the reports of the Gateways
+		// must not share the backend policy reports
+		reportsMap := gw.reports
+		reportsMap.Policies = maps.Clone(gw.reports.Policies)
+		if reportsMap.Policies == nil {
+			reportsMap.Policies = make(map[reporter.PolicyKey]*reports.PolicyReport)
+		}
+		maps.Copy(reportsMap.Policies, proxy_syncer.GenerateBackendPolicyReport(backendIRs).Policies)
+		results[gw.NamespacedName] = Result{
+			Gateway:  gw.gateway,
+			Proxy:    gw.proxy,
+			IR:       gw.ir,
+			Clusters: clusters,
+			Reports:  reportsMap,
+		}
+	}
+
+	return results, nil
+}
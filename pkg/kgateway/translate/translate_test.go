@@ -0,0 +1,92 @@
+package translate
+
+import (
+	"context"
+	"testing"
+
+	envoybootstrapv3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoyhcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
+)
+
+type mockValidator struct {
+	bootstrap *envoybootstrapv3.Bootstrap
+}
+
+var _ validator.Validator = &mockValidator{}
+
+func (m *mockValidator) Validate(_ context.Context, bootstrap *envoybootstrapv3.Bootstrap) error {
+	m.bootstrap = bootstrap
+	return nil
+}
+
+func TestTranslate(t *testing.T) {
+	ctx := t.Context()
+
+	objs, err := LoadFiles([]string{"testdata/gateway.yaml"})
+	require.NoError(t, err)
+	cli, err := NewClient(ctx, objs...)
+	require.NoError(t, err)
+	defer cli.Shutdown()
+
+	settings, err := apisettings.BuildSettings()
+	require.NoError(t, err)
+	results, err := Translate(ctx, cli, Options{
+		Settings:  *settings,
+		Validator: &mockValidator{},
+	})
+	require.NoError(t, err)
+
+	gwNN := types.NamespacedName{Namespace: "default", Name: "gw"}
+	require.Contains(t, results, gwNN)
+	result := results[gwNN]
+	require.Len(t, result.Proxy.Listeners, 1)
+	require.Len(t, result.Proxy.Routes, 1)
+	assert.Equal(t, "5s", result.Proxy.Routes[0].GetVirtualHosts()[0].GetRoutes()[0].GetRoute().GetTimeout().AsDuration().String())
+
+	out, err := NewOutput(results, nil)
+	require.NoError(t, err)
+	require.Contains(t, out.Gateways, "default/gw")
+	assert.Len(t, out.Gateways["default/gw"].Listeners, 1)
+	assert.NotEmpty(t, out.Gateways["default/gw"].Clusters)
+	require.Contains(t, out.Statuses.HTTPRoutes, "default/example-route")
+	require.Contains(t, out.Statuses.Policies, "TrafficPolicy/default/timeout")
+	assert.True(t, meta.IsStatusConditionTrue(out.Statuses.Gateways["default/gw"].Conditions, "Programmed"))
+
+	// the routes are inlined in the listeners of the bootstrap
+	v := &mockValidator{}
+	require.NoError(t, Validate(ctx, v, result))
+	require.Len(t, v.bootstrap.GetStaticResources().GetListeners(), 1)
+	filter := v.bootstrap.GetStaticResources().GetListeners()[0].GetFilterChains()[0].GetFilters()[0]
+	msg, err := utils.AnyToMessage(filter.GetTypedConfig())
+	require.NoError(t, err)
+	hcm, ok := msg.(*envoyhcmv3.HttpConnectionManager)
+	require.True(t, ok)
+	assert.Nil(t, hcm.GetRds())
+	assert.Equal(t, result.Proxy.Routes[0].GetName(), hcm.GetRouteConfig().GetName())
+	// the translation result is left unchanged
+	assert.Equal(t, 0, countInlinedRoutes(t, result))
+}
+
+func countInlinedRoutes(t *testing.T, result Result) int {
+	var inlined int
+	for _, listener := range result.Proxy.Listeners {
+		for _, filterChain := range listener.GetFilterChains() {
+			for _, filter := range filterChain.GetFilters() {
+				msg, err := utils.AnyToMessage(filter.GetTypedConfig())
+				require.NoError(t, err)
+				if hcm, ok := msg.(*envoyhcmv3.HttpConnectionManager); ok && hcm.GetRouteConfig() != nil {
+					inlined++
+				}
+			}
+		}
+	}
+	return inlined
+}
@@ -0,0 +1,156 @@
+package translate
+
+import (
+	"context"
+	"fmt"
+
+	envoybootstrapv3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyhcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	eiutils "github.com/kgateway-dev/kgateway/v2/internal/envoyinit/pkg/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/validator"
+)
+
+// xdsClusterName is the name of the cluster of the xDS server in the bootstrap of the proxies,
+// which the clusters discovering their endpoints or secrets over ADS depend on.
+const xdsClusterName = "xds_cluster"
+
+// Validate validates the translation of a Gateway with the validator, as Envoy would load it.
+// The routes are inlined in the listeners and the other resources are added as static resources,
+// so that Envoy validates every translated resource.
+func Validate(ctx context.Context, v validator.Validator, result Result) error {
+	bootstrap, err := buildBootstrap(result)
+	if err != nil {
+		return fmt.Errorf("failed to build bootstrap config: %w", err)
+	}
+	if err := v.Validate(ctx, bootstrap); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	return nil
+}
+
+func buildBootstrap(result Result) (*envoybootstrapv3.Bootstrap, error) {
+	routes := make(map[string]*envoyroutev3.RouteConfiguration)
+	var listeners []*envoylistenerv3.Listener
+	var clusters []*envoyclusterv3.Cluster
+	// the system CA secret is added to the bootstrap of the proxies when they start
+	secrets := []*envoytlsv3.Secret{{
+		Name: eiutils.SystemCaSecretName,
+		Type: &envoytlsv3.Secret_ValidationContext{
+			ValidationContext: &envoytlsv3.CertificateValidationContext{},
+		},
+	}}
+	if result.Proxy != nil {
+		for _, secret := range result.Proxy.Secrets {
+			if secret.GetName() != eiutils.SystemCaSecretName {
+				secrets = append(secrets, secret)
+			}
+		}
+		for _, route := range result.Proxy.Routes {
+			routes[route.GetName()] = route
+		}
+		for _, listener := range result.Proxy.Listeners {
+			inlined, err := inlineRoutes(listener, routes)
+			if err != nil {
+				return nil, fmt.Errorf("listener %s: %w", listener.GetName(), err)
+			}
+			listeners = append(listeners, inlined)
+		}
+		clusters = append(clusters, result.Proxy.ExtraClusters...)
+	}
+	clusters = append(clusters, result.Clusters...)
+	clusters = append(clusters, &envoyclusterv3.Cluster{
+		Name:                 xdsClusterName,
+		ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_STATIC},
+		// the validator does not connect to the xDS server
+		LoadAssignment: &envoyendpointv3.ClusterLoadAssignment{
+			ClusterName: xdsClusterName,
+			Endpoints: []*envoyendpointv3.LocalityLbEndpoints{{
+				LbEndpoints: []*envoyendpointv3.LbEndpoint{{
+					HostIdentifier: &envoyendpointv3.LbEndpoint_Endpoint{Endpoint: &envoyendpointv3.Endpoint{
+						Address: &envoycorev3.Address{Address: &envoycorev3.Address_SocketAddress{SocketAddress: &envoycorev3.SocketAddress{
+							Address:       "127.0.0.1",
+							PortSpecifier: &envoycorev3.SocketAddress_PortValue{PortValue: 9977},
+						}}},
+					}},
+				}},
+			}},
+		},
+		Http2ProtocolOptions: &envoycorev3.Http2ProtocolOptions{},
+	})
+
+	return &envoybootstrapv3.Bootstrap{
+		Node: &envoycorev3.Node{
+			Id:      "validation-node-id",
+			Cluster: "validation-cluster",
+		},
+		StaticResources: &envoybootstrapv3.Bootstrap_StaticResources{
+			Listeners: listeners,
+			Clusters:  clusters,
+			Secrets:   secrets,
+		},
+		DynamicResources: &envoybootstrapv3.Bootstrap_DynamicResources{
+			AdsConfig: &envoycorev3.ApiConfigSource{
+				ApiType:             envoycorev3.ApiConfigSource_GRPC,
+				TransportApiVersion: envoycorev3.ApiVersion_V3,
+				GrpcServices: []*envoycorev3.GrpcService{{
+					TargetSpecifier: &envoycorev3.GrpcService_EnvoyGrpc_{EnvoyGrpc: &envoycorev3.GrpcService_EnvoyGrpc{
+						ClusterName: xdsClusterName,
+					}},
+				}},
+			},
+		},
+	}, nil
+}
+
+// inlineRoutes returns a copy of the listener where the HTTP connection managers embed the route
+// configurations they would otherwise discover over RDS.
+func inlineRoutes(listener *envoylistenerv3.Listener, routes map[string]*envoyroutev3.RouteConfiguration) (*envoylistenerv3.Listener, error) {
+	listener = proto.Clone(listener).(*envoylistenerv3.Listener)
+	filterChains := listener.GetFilterChains()
+	if listener.GetDefaultFilterChain() != nil {
+		filterChains = append(filterChains, listener.GetDefaultFilterChain())
+	}
+	for _, filterChain := range filterChains {
+		for _, filter := range filterChain.GetFilters() {
+			if filter.GetName() != envoywellknown.HTTPConnectionManager || filter.GetTypedConfig() == nil {
+				continue
+			}
+			msg, err := utils.AnyToMessage(filter.GetTypedConfig())
+			if err != nil {
+				return nil, err
+			}
+			hcm, ok := msg.(*envoyhcmv3.HttpConnectionManager)
+			if !ok || hcm.GetRds() == nil {
+				continue
+			}
+			routeName := hcm.GetRds().GetRouteConfigName()
+			route, ok := routes[routeName]
+			if !ok {
+				return nil, fmt.Errorf("route configuration %s not found", routeName)
+			}
+			if route.GetValidateClusters() == nil {
+				// the clusters of the route configurations discovered over RDS are not validated by default
+				route = proto.CloneOf(route)
+				route.ValidateClusters = wrapperspb.Bool(false)
+			}
+			hcm.RouteSpecifier = &envoyhcmv3.HttpConnectionManager_RouteConfig{RouteConfig: route}
+			typedConfig, err := utils.MessageToAny(hcm)
+			if err != nil {
+				return nil, err
+			}
+			filter.ConfigType = &envoylistenerv3.Filter_TypedConfig{TypedConfig: typedConfig}
+		}
+	}
+	return listener, nil
+}
@@ -92,14 +92,15 @@ func (t *translator) Translate(
 }
 
 func setAttachedRoutes(gateway *ir.Gateway, routesForGw *query.RoutesForGwResult, reporter reports.Reporter) {
-	for _, listener := range gateway.Listeners {
-		parentReporter := listener.GetParentReporter(reporter)
+	for _, l := range gateway.Listeners {
+		parentReporter := l.GetParentReporter(reporter)
 
 		availRoutes := 0
-		if res := routesForGw.GetListenerResult(listener.Parent, string(listener.Name)); res != nil {
+		// No route kind is supported by a listener with an unsupported protocol, so no route can attach to it
+		if res := routesForGw.GetListenerResult(l.Parent, string(l.Name)); res != nil && listener.IsSupportedProtocol(l.Protocol) {
 			// TODO we've never checked if the ListenerResult has an error.. is it already on RouteErrors?
 			availRoutes = len(res.Routes)
 		}
-		parentReporter.Listener(&listener.Listener).SetAttachedRoutes(uint(availRoutes)) //nolint:gosec // G115: availRoutes is a count of routes, always non-negative
+		parentReporter.Listener(&l.Listener).SetAttachedRoutes(uint(availRoutes)) //nolint:gosec // G115: availRoutes is a count of routes, always non-negative
 	}
 }
@@ -723,6 +723,94 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("TrafficPolicy with fault injection", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/fault-injection.yaml",
+			outputFile: "traffic-policy/fault-injection.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy with lua", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/lua.yaml",
+			outputFile: "traffic-policy/lua.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy with mirror", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/mirror.yaml",
+			outputFile: "traffic-policy/mirror.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy with cache", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/cache.yaml",
+			outputFile: "traffic-policy/cache.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy with wasm", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/wasm.yaml",
+			outputFile: "traffic-policy/wasm.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy with WAF", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/waf.yaml",
+			outputFile: "traffic-policy/waf.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy with gRPC-JSON transcoder and gRPC-Web", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/grpc-json-transcoder.yaml",
+			outputFile: "traffic-policy/grpc-json-transcoder.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy with custom responses", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/custom-response.yaml",
+			outputFile: "traffic-policy/custom-response.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("TrafficPolicy with header modifiers attached to gateway", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "traffic-policy/header-modifiers-gateway.yaml",
@@ -831,6 +919,28 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("udp gateway with basic routing", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "udp-routing/basic.yaml",
+			outputFile: "udp-routing/basic-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("udproute with multiple backends is rejected", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "udp-routing/multi-backend.yaml",
+			outputFile: "udp-routing/multi-backend.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("tls gateway with tcproute", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "tcp-routing/tls.yaml",
@@ -1007,6 +1117,28 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("InferencePool backend with endpoint picker", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "inference-pool/basic.yaml",
+			outputFile: "inference-pool/basic.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("InferencePool backend with missing endpoint picker", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "inference-pool/missing-epp.yaml",
+			outputFile: "inference-pool/missing-epp.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("DFP Backend with simple", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "dfp/simple.yaml",
@@ -1062,6 +1194,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("DirectResponse with headers, formatted body and body from ConfigMap", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "directresponse/body-from-configmap.yaml",
+			outputFile: "directresponse/body-from-configmap.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("DirectResponse with missing reference reports correctly", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "directresponse/missing-ref.yaml",
@@ -1117,6 +1260,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("TrafficPolicy retry with hedging and host predicates", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/retry-hedge.yaml",
+			outputFile: "traffic-policy/retry-hedge.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("TrafficPolicy timeout attached to GRPCRoute", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "traffic-policy/grpcroute-timeout.yaml",
@@ -1150,6 +1304,39 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("http gateway with session persistence from XBackendTrafficPolicy", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "session-persistence/xbackendtrafficpolicy.yaml",
+			outputFile: "session-persistence/xbackendtrafficpolicy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("http gateway with session persistence from BackendConfigPolicy", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "session-persistence/backendconfigpolicy.yaml",
+			outputFile: "session-persistence/backendconfigpolicy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("http gateway with route session persistence overriding the backend session persistence", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "session-persistence/conflict.yaml",
+			outputFile: "session-persistence/conflict.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("HTTPListenerPolicy with upgrades", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "https-listener-pol/upgrades.yaml",
@@ -1678,6 +1865,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("Backend Config Policy with LB Locality", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "backendconfigpolicy/lb-locality.yaml",
+			outputFile: "backendconfigpolicy/lb-locality.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("Backend Config Policy with LB UseHostnameForHashing", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "backendconfigpolicy/lb-usehostnameforhashing.yaml",
@@ -1810,6 +2008,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("Backend Config Policy with Circuit Breakers retry budget and high priority", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "backendconfigpolicy/circuitbreakers-retry-budget.yaml",
+			outputFile: "backendconfigpolicy/circuitbreakers-retry-budget.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("TrafficPolicy with explicit generation", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "traffic-policy/generation.yaml",
@@ -2186,6 +2395,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("ListenerPolicy with HTTP/3 on HTTPS listener", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "listener-policy/http3.yaml",
+			outputFile: "listener-policy/http3.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("ListenerPolicy with proxy protocol on TCP listener", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "listener-policy/tcp-proxy-protocol.yaml",
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"sort"
 
+	xdscorev3 "github.com/cncf/xds/go/xds/core/v3"
+	xdsmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	routerv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
 	envoy_tls_inspector "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/tls_inspector/v3"
 	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	envoyudp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/udp/udp_proxy/v3"
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoymatcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
@@ -28,6 +31,7 @@ import (
 const (
 	DefaultHttpStatPrefix  = "http"
 	UpstreamCodeFilterName = "envoy.filters.http.upstream_codec"
+	UdpProxyFilterName     = "envoy.filters.udp_listener.udp_proxy"
 )
 
 var defaultDownstreamAlpnProtocols = []string{"h2", "http/1.1"}
@@ -41,7 +45,12 @@ type filterChainTranslator struct {
 	pluginPass TranslationPassPlugins
 }
 
-func computeListenerAddress(bindAddress string, port uint32, reporter sdkreporter.GatewayReporter) *envoycorev3.Address {
+func computeListenerAddress(
+	bindAddress string,
+	port uint32,
+	protocol envoycorev3.SocketAddress_Protocol,
+	reporter sdkreporter.GatewayReporter,
+) *envoycorev3.Address {
 	_, isIpv4Address, err := utils.IsIpv4Address(bindAddress)
 	if err != nil {
 		// TODO: return error ????
@@ -56,7 +65,7 @@ func computeListenerAddress(bindAddress string, port uint32, reporter sdkreporte
 	return &envoycorev3.Address{
 		Address: &envoycorev3.Address_SocketAddress{
 			SocketAddress: &envoycorev3.SocketAddress{
-				Protocol: envoycorev3.SocketAddress_TCP,
+				Protocol: protocol,
 				Address:  bindAddress,
 				PortSpecifier: &envoycorev3.SocketAddress_PortValue{
 					PortValue: port,
@@ -81,6 +90,41 @@ func tlsInspectorFilter() *envoylistenerv3.ListenerFilter {
 	}
 }
 
+// udpProxyFilter builds the UDP proxy listener filter that forwards all datagrams to the backend of the UDP listener.
+func udpProxyFilter(l ir.UdpIR) (*envoylistenerv3.ListenerFilter, error) {
+	route, err := utils.MessageToAny(&envoyudp.Route{
+		Cluster: l.BackendRefs[0].ClusterName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert UDP proxy route: %w", err)
+	}
+	configEnvoy := &envoyudp.UdpProxyConfig{
+		StatPrefix: l.Name,
+		RouteSpecifier: &envoyudp.UdpProxyConfig_Matcher{
+			Matcher: &xdsmatcherv3.Matcher{
+				OnNoMatch: &xdsmatcherv3.Matcher_OnMatch{
+					OnMatch: &xdsmatcherv3.Matcher_OnMatch_Action{
+						Action: &xdscorev3.TypedExtensionConfig{
+							Name:        "route",
+							TypedConfig: route,
+						},
+					},
+				},
+			},
+		},
+	}
+	msg, err := utils.MessageToAny(configEnvoy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert UDP proxy config: %w", err)
+	}
+	return &envoylistenerv3.ListenerFilter{
+		Name: UdpProxyFilterName,
+		ConfigType: &envoylistenerv3.ListenerFilter_TypedConfig{
+			TypedConfig: msg,
+		},
+	}, nil
+}
+
 func (h *filterChainTranslator) initFilterChain(fcc ir.FilterChainCommon) *envoylistenerv3.FilterChain {
 	info := &FilterChainInfo{
 		Match: fcc.Matcher,
@@ -6,11 +6,13 @@ import (
 	"strconv"
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -49,13 +51,19 @@ func (t *Translator) Translate(ctx context.Context, gw ir.GatewayIR, reporter sd
 	for _, l := range gw.Listeners {
 		outListener, routes := t.ComputeListener(ctx, pass, gw, l, reporter)
 		// Envoy rejects listeners with no filter chains; skip adding such listeners.
-		if outListener == nil || len(outListener.GetFilterChains()) == 0 {
+		// UDP listeners are the exception as they are configured with listener filters only.
+		if outListener == nil || (len(outListener.GetFilterChains()) == 0 && outListener.GetUdpListenerConfig() == nil) {
 			originalListenerName := findOriginalListenerName(gw, l)
 			logger.Warn("invalid listener due to no filter chains generated", "listener", originalListenerName)
 			continue
 		}
 		res.Listeners = append(res.Listeners, outListener)
 		res.Routes = append(res.Routes, routes...)
+
+		// HTTPS listeners with HTTP/3 enabled are also served by a QUIC listener on the same port.
+		if quicListener := computeQuicListener(l, outListener); quicListener != nil {
+			res.Listeners = append(res.Listeners, quicListener)
+		}
 	}
 
 	for _, c := range pass {
@@ -99,9 +107,13 @@ func (t *Translator) ComputeListener(
 	reporter sdkreporter.Reporter,
 ) (*envoylistenerv3.Listener, []*envoyroutev3.RouteConfiguration) {
 	gwreporter := reporter.Gateway(gw.SourceObject.Obj)
+	if lis.UdpListener != nil {
+		return computeUdpListener(lis, gwreporter), nil
+	}
+
 	ret := &envoylistenerv3.Listener{
 		Name:    lis.Name,
-		Address: computeListenerAddress(lis.BindAddress, lis.BindPort, gwreporter),
+		Address: computeListenerAddress(lis.BindAddress, lis.BindPort, envoycorev3.SocketAddress_TCP, gwreporter),
 	}
 	if gw.PerConnectionBufferLimitBytes != nil {
 		ret.PerConnectionBufferLimitBytes = &wrapperspb.UInt32Value{Value: *gw.PerConnectionBufferLimitBytes}
@@ -137,6 +149,10 @@ func (t *Translator) ComputeListener(
 		}
 		rc := hr.ComputeRouteConfiguration(ctx, hfc.Vhosts)
 		if rc != nil {
+			// Advertise the QUIC listener to clients connecting over TCP.
+			if hfc.Http3 != nil && hfc.TLS != nil {
+				rc.ResponseHeadersToAdd = append(rc.GetResponseHeadersToAdd(), altSvcHeaderValue(lis.BindPort, hfc.Http3))
+			}
 			routes = append(routes, rc)
 
 			// Record metrics for the number of domains per listener.
@@ -194,6 +210,27 @@ func (t *Translator) ComputeListener(
 	return ret, routes
 }
 
+// computeUdpListener builds a UDP listener that proxies all datagrams to the listener's backend.
+// Listener plugins are not applied, as listener level policies only apply to TCP listeners.
+func computeUdpListener(lis ir.ListenerIR, gwreporter sdkreporter.GatewayReporter) *envoylistenerv3.Listener {
+	filter, err := udpProxyFilter(*lis.UdpListener)
+	if err != nil {
+		gwreporter.SetCondition(sdkreporter.GatewayCondition{
+			Type:    gwv1.GatewayConditionProgrammed,
+			Reason:  gwv1.GatewayReasonInvalid,
+			Status:  metav1.ConditionFalse,
+			Message: "Error processing listener: " + err.Error(),
+		})
+		return nil
+	}
+	return &envoylistenerv3.Listener{
+		Name:              lis.Name,
+		Address:           computeListenerAddress(lis.BindAddress, lis.BindPort, envoycorev3.SocketAddress_UDP, gwreporter),
+		UdpListenerConfig: &envoylistenerv3.UdpListenerConfig{},
+		ListenerFilters:   []*envoylistenerv3.ListenerFilter{filter},
+	}
+}
+
 func (t *Translator) runListenerPlugins(
 	pass TranslationPassPlugins,
 	gw ir.GatewayIR,
@@ -0,0 +1,133 @@
+package irtranslator
+
+import (
+	"fmt"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoyquicv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/quic/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	// QuicListenerSuffix is appended to the name of an HTTPS listener to name its QUIC listener
+	QuicListenerSuffix = "~quic"
+
+	altSvcHeader = "alt-svc"
+)
+
+var http3AlpnProtocols = []string{"h3"}
+
+// altSvcHeaderValue returns the alt-svc response header advertising HTTP/3 on the given port.
+func altSvcHeaderValue(port uint32, http3 *ir.Http3IR) *envoycorev3.HeaderValueOption {
+	return &envoycorev3.HeaderValueOption{
+		Header: &envoycorev3.HeaderValue{
+			Key:   altSvcHeader,
+			Value: fmt.Sprintf(`h3=":%d"; ma=%d`, port, http3.AltSvcMaxAge),
+		},
+		// let route level policies override the advertisement
+		AppendAction: envoycorev3.HeaderValueOption_ADD_IF_ABSENT,
+	}
+}
+
+// computeQuicListener builds a QUIC listener that serves the HTTPS filter chains with HTTP/3 enabled
+// over UDP on the same port as the given TCP listener. The QUIC filter chains are derived from the
+// already translated TCP filter chains so that both listeners share certificates, filters and routes.
+// Returns nil if no filter chain of the listener has HTTP/3 enabled.
+func computeQuicListener(lis ir.ListenerIR, tcpListener *envoylistenerv3.Listener) *envoylistenerv3.Listener {
+	http3FilterChains := map[string]bool{}
+	for _, hfc := range lis.HttpFilterChain {
+		if hfc.Http3 != nil && hfc.TLS != nil {
+			http3FilterChains[hfc.FilterChainName] = true
+		}
+	}
+	if len(http3FilterChains) == 0 {
+		return nil
+	}
+
+	address := proto.Clone(tcpListener.GetAddress()).(*envoycorev3.Address)
+	if socketAddress := address.GetSocketAddress(); socketAddress != nil {
+		socketAddress.Protocol = envoycorev3.SocketAddress_UDP
+	}
+
+	ret := &envoylistenerv3.Listener{
+		Name:    lis.Name + QuicListenerSuffix,
+		Address: address,
+		UdpListenerConfig: &envoylistenerv3.UdpListenerConfig{
+			QuicOptions: &envoylistenerv3.QuicProtocolOptions{},
+			DownstreamSocketConfig: &envoycorev3.UdpSocketConfig{
+				PreferGro: wrapperspb.Bool(true),
+			},
+		},
+		PerConnectionBufferLimitBytes: tcpListener.GetPerConnectionBufferLimitBytes(),
+	}
+
+	for _, fc := range tcpListener.GetFilterChains() {
+		if !http3FilterChains[fc.GetName()] {
+			continue
+		}
+		quicFilterChain, err := toQuicFilterChain(fc)
+		if err != nil {
+			logger.Error("failed to translate filter chain for QUIC listener", "listener", ret.GetName(), "filter_chain", fc.GetName(), "error", err)
+			continue
+		}
+		ret.FilterChains = append(ret.GetFilterChains(), quicFilterChain)
+	}
+	if len(ret.GetFilterChains()) == 0 {
+		return nil
+	}
+
+	return ret
+}
+
+// toQuicFilterChain converts a TLS terminating HTTP filter chain into one suitable for a QUIC listener:
+// the TLS transport socket is wrapped in a QUIC transport socket and the HCM codec is set to HTTP/3.
+func toQuicFilterChain(fc *envoylistenerv3.FilterChain) (*envoylistenerv3.FilterChain, error) {
+	out := proto.Clone(fc).(*envoylistenerv3.FilterChain)
+
+	tlsContext := &envoytlsv3.DownstreamTlsContext{}
+	if err := out.GetTransportSocket().GetTypedConfig().UnmarshalTo(tlsContext); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal TLS context: %w", err)
+	}
+	if tlsContext.GetCommonTlsContext() == nil {
+		tlsContext.CommonTlsContext = &envoytlsv3.CommonTlsContext{}
+	}
+	tlsContext.GetCommonTlsContext().AlpnProtocols = http3AlpnProtocols
+
+	quicTransport, err := utils.MessageToAny(&envoyquicv3.QuicDownstreamTransport{
+		DownstreamTlsContext: tlsContext,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out.TransportSocket = &envoycorev3.TransportSocket{
+		Name:       wellknown.TransportSocketQuic,
+		ConfigType: &envoycorev3.TransportSocket_TypedConfig{TypedConfig: quicTransport},
+	}
+
+	for _, f := range out.GetFilters() {
+		if f.GetName() != wellknown.HTTPConnectionManager {
+			continue
+		}
+		hcm := &envoyhttp.HttpConnectionManager{}
+		if err := f.GetTypedConfig().UnmarshalTo(hcm); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal http connection manager: %w", err)
+		}
+		hcm.CodecType = envoyhttp.HttpConnectionManager_HTTP3
+		hcm.Http3ProtocolOptions = &envoycorev3.Http3ProtocolOptions{}
+		typedConfig, err := utils.MessageToAny(hcm)
+		if err != nil {
+			return nil, err
+		}
+		f.ConfigType = &envoylistenerv3.Filter_TypedConfig{TypedConfig: typedConfig}
+	}
+
+	return out, nil
+}
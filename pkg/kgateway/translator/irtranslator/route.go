@@ -48,6 +48,9 @@ const (
 	// directResponseActionBody is the body of the direct response action for replaced
 	// routes.
 	directResponseActionBody = `invalid route configuration detected and replaced with a direct response.`
+	// defaultMaxDirectResponseBodySize is the default maximum size of the direct response bodies of
+	// a route configuration enforced by Envoy.
+	defaultMaxDirectResponseBodySize = 4096
 )
 
 func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(
@@ -62,6 +65,7 @@ func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(
 	// all virtual hosts from multiple listeners that share the same port. Each distinct
 	// hostname on each HTTPRoute attached to a listener will be a separate vhost.
 	cfg.VirtualHosts = h.computeVirtualHosts(ctx, vhosts)
+	cfg.MaxDirectResponseBodySizeBytes = maxDirectResponseBodySize(cfg.GetVirtualHosts())
 
 	// Gateway API spec requires that port values in HTTP Host headers be ignored when performing a match
 	// See https://gateway-api.sigs.k8s.io/reference/spec/#gateway.networking.k8s.io/v1.HTTPRouteSpec - hostnames field
@@ -114,6 +118,22 @@ func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(
 	return cfg
 }
 
+// maxDirectResponseBodySize returns the maximum size of the direct response bodies to configure on the
+// route configuration, so that Envoy accepts bodies larger than its default limit, e.g., sourced from a
+// ConfigMap by a DirectResponse. Returns nil if the bodies fit within the default limit.
+func maxDirectResponseBodySize(vhosts []*envoyroutev3.VirtualHost) *wrapperspb.UInt32Value {
+	maxSize := defaultMaxDirectResponseBodySize
+	for _, vhost := range vhosts {
+		for _, route := range vhost.GetRoutes() {
+			maxSize = max(maxSize, len(route.GetDirectResponse().GetBody().GetInlineString()))
+		}
+	}
+	if maxSize == defaultMaxDirectResponseBodySize {
+		return nil
+	}
+	return wrapperspb.UInt32(uint32(maxSize)) // nolint:gosec // G115: bodies are limited by the plugins
+}
+
 func (h *httpRouteConfigurationTranslator) computeVirtualHosts(
 	ctx context.Context,
 	virtualHosts []*ir.VirtualHost,
@@ -486,6 +506,13 @@ func (h *httpRouteConfigurationTranslator) runBackend(in ir.HttpBackend, pCtx *i
 				errs = append(errs, err)
 			}
 		}
+		// built-in features configured by policies attached to the backend object, such as session persistence
+		if builtinPass := h.pluginPass[ir.VirtualBuiltInGK]; builtinPass != nil {
+			err := builtinPass.ApplyForBackend(pCtx, in, outRoute)
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
 	}
 	// TODO: check return value, if error returned, log error and report condition
 	return errors.Join(errs...)
@@ -605,17 +632,22 @@ func (h *httpRouteConfigurationTranslator) initRoutes(
 	in ir.HttpRouteRuleMatchIR,
 	generatedName string,
 ) *envoyroutev3.Route {
-	out := &envoyroutev3.Route{
+	return &envoyroutev3.Route{
+		Name:  routeName(generatedName, in),
 		Match: translateMatcher(in.Match),
 	}
-	name := in.Name
-	if name != "" {
-		out.Name = fmt.Sprintf("%s-%s-matcher-%d", generatedName, name, in.MatchIndex)
-	} else {
-		out.Name = fmt.Sprintf("%s-matcher-%d", generatedName, in.MatchIndex)
-	}
+}
 
-	return out
+// XdsRouteName returns the name of the xDS route translated from the rule at the index of the virtual host.
+func XdsRouteName(vh *ir.VirtualHost, idx int, rule ir.HttpRouteRuleMatchIR) string {
+	return routeName(fmt.Sprintf("%s-route-%d", vh.Name, idx), rule)
+}
+
+func routeName(generatedName string, in ir.HttpRouteRuleMatchIR) string {
+	if in.Name != "" {
+		return fmt.Sprintf("%s-%s-matcher-%d", generatedName, in.Name, in.MatchIndex)
+	}
+	return fmt.Sprintf("%s-matcher-%d", generatedName, in.MatchIndex)
 }
 
 func translateMatcher(matcher gwv1.HTTPRouteMatch) *envoyroutev3.RouteMatch {
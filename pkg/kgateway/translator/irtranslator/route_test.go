@@ -1,8 +1,10 @@
 package irtranslator
 
 import (
+	"strings"
 	"testing"
 
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -89,3 +91,51 @@ func TestValidateWeightedClusters(t *testing.T) {
 		})
 	}
 }
+
+func TestMaxDirectResponseBodySize(t *testing.T) {
+	directResponse := func(body string) *envoyroutev3.Route {
+		return &envoyroutev3.Route{
+			Action: &envoyroutev3.Route_DirectResponse{
+				DirectResponse: &envoyroutev3.DirectResponseAction{
+					Status: 200,
+					Body: &envoycorev3.DataSource{
+						Specifier: &envoycorev3.DataSource_InlineString{InlineString: body},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		vhosts   []*envoyroutev3.VirtualHost
+		expected *wrapperspb.UInt32Value
+	}{
+		{
+			name: "no direct response",
+			vhosts: []*envoyroutev3.VirtualHost{
+				{Routes: []*envoyroutev3.Route{{}}},
+			},
+		},
+		{
+			name: "bodies within the default limit",
+			vhosts: []*envoyroutev3.VirtualHost{
+				{Routes: []*envoyroutev3.Route{directResponse("ok"), directResponse(strings.Repeat("a", 4096))}},
+			},
+		},
+		{
+			name: "body larger than the default limit",
+			vhosts: []*envoyroutev3.VirtualHost{
+				{Routes: []*envoyroutev3.Route{directResponse("ok")}},
+				{Routes: []*envoyroutev3.Route{directResponse(strings.Repeat("a", 10000)), directResponse(strings.Repeat("a", 5000))}},
+			},
+			expected: wrapperspb.UInt32(10000),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, maxDirectResponseBodySize(tt.vhosts))
+		})
+	}
+}
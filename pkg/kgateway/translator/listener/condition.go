@@ -10,4 +10,5 @@ const (
 
 	ListenerMessageProtocolConflict = "Found conflicting protocols on listeners, a single port can only contain listeners with compatible protocols"
 	ListenerMessageHostnameConflict = "Found conflicting hostnames on listeners, all listeners on a single port must have unique hostnames"
+	ListenerMessageUdpPortConflict  = "Found multiple UDP listeners on a single port, a port can only contain one UDP listener"
 )
@@ -35,6 +35,7 @@ var logger = logging.New("translator/listener")
 
 const (
 	TcpTlsListenerNoBackendsMessage = "TCP/TLS listener has no valid backends or routes"
+	UdpListenerNoBackendsMessage    = "UDP listener has no valid backends or routes"
 	ResourceNotFoundMessageTemplate = "%s %s/%s not found."
 )
 
@@ -121,6 +122,8 @@ func (ml *MergedListeners) AppendListener(
 		ml.AppendTcpListener(listener, routes, reporter)
 	case gwv1.TLSProtocolType:
 		ml.AppendTlsListener(listener, routes, reporter)
+	case gwv1.UDPProtocolType:
+		ml.AppendUdpListener(listener, routes, reporter)
 	default:
 		return fmt.Errorf("unsupported protocol: %v", listener.Protocol)
 	}
@@ -190,6 +193,7 @@ func (ml *MergedListeners) appendHttpsListener(
 		tls:                 tls,
 		routesWithHosts:     routesWithHosts,
 		attachedPolicies:    listener.AttachedPolicies,
+		http3:               getHttp3FromAttached(listener, ml.parentGw),
 	}
 
 	// Perform the port transformation away from privileged ports only once to use
@@ -287,6 +291,55 @@ func (ml *MergedListeners) AppendTlsListener(
 	})
 }
 
+func (ml *MergedListeners) AppendUdpListener(
+	listener ir.Listener,
+	routeInfos []*query.RouteInfo,
+	reporter reports.ListenerReporter,
+) {
+	udp := &udpListener{
+		gatewayListenerName: query.GenerateRouteKey(listener.Parent, string(listener.Name)),
+		listener:            listener,
+		listenerReporter:    reporter,
+		routesWithHosts:     routeInfos,
+	}
+
+	finalPort := getListenerPortNumber(listener)
+	for _, lis := range ml.Listeners {
+		if lis.port == finalPort {
+			// Envoy UDP listeners have no filter chains, so they cannot be merged with other listeners.
+			// Port conflicts are rejected during listener validation, so this is only a safeguard.
+			rejectConflictedUdpListener(reporter)
+			return
+		}
+	}
+
+	ml.Listeners = append(ml.Listeners, &MergedListener{
+		name:        GenerateListenerName(listener),
+		port:        finalPort,
+		udpListener: udp,
+		listener:    listener,
+		gateway:     ml.parentGw,
+		settings:    ml.settings,
+	})
+}
+
+func rejectConflictedUdpListener(reporter reports.ListenerReporter) {
+	for _, conditionType := range []gwv1.ListenerConditionType{gwv1.ListenerConditionAccepted, gwv1.ListenerConditionProgrammed} {
+		reporter.SetCondition(reports.ListenerCondition{
+			Type:    conditionType,
+			Status:  metav1.ConditionFalse,
+			Reason:  gwv1.ListenerReasonProtocolConflict,
+			Message: ListenerMessageUdpPortConflict,
+		})
+	}
+	reporter.SetCondition(reports.ListenerCondition{
+		Type:    gwv1.ListenerConditionConflicted,
+		Status:  metav1.ConditionTrue,
+		Reason:  gwv1.ListenerReasonProtocolConflict,
+		Message: ListenerMessageUdpPortConflict,
+	})
+}
+
 func (ml *MergedListeners) translateListeners(
 	kctx krt.HandlerContext,
 	ctx context.Context,
@@ -307,6 +360,7 @@ type MergedListener struct {
 	httpFilterChain   *httpFilterChain
 	httpsFilterChains []httpsFilterChain
 	TcpFilterChains   []tcpFilterChain
+	udpListener       *udpListener
 	listener          ir.Listener
 	gateway           ir.Gateway
 	settings          ListenerTranslatorConfig
@@ -370,6 +424,20 @@ func (ml *MergedListener) TranslateListener(
 		}
 	}
 
+	// Translate the UDP listener (if it exists)
+	var udpListenerIR *ir.UdpIR
+	if ml.udpListener != nil {
+		udpListenerIR = ml.udpListener.translateUdpListener(ml.name, reporter)
+		if udpListenerIR == nil {
+			ml.udpListener.listenerReporter.SetCondition(reports.ListenerCondition{
+				Type:    gwv1.ListenerConditionProgrammed,
+				Status:  metav1.ConditionFalse,
+				Reason:  gwv1.ListenerReasonInvalid,
+				Message: UdpListenerNoBackendsMessage,
+			})
+		}
+	}
+
 	// Get bind address based on ListenerBindIpv6 setting
 	bindAddress := "0.0.0.0"
 	if ml.settings.ListenerBindIpv6 {
@@ -384,6 +452,7 @@ func (ml *MergedListener) TranslateListener(
 		AttachedPolicies:  ir.AttachedPolicies{}, // TODO: find policies attached to listener and attach them <- this might not be possible due to listener merging. also a gw listener ~= envoy filter chain; and i don't believe we need policies there
 		HttpFilterChain:   httpFilterChains,
 		TcpFilterChain:    matchedTcpListeners,
+		UdpListener:       udpListenerIR,
 		PolicyAncestorRef: ml.listener.PolicyAncestorRef,
 	}
 }
@@ -608,6 +677,83 @@ func (tc *tcpFilterChain) translateTcpFilterChain(
 	}
 }
 
+// udpListener represents a Gateway listener with the UDP protocol. Unlike TCP listeners,
+// UDP listeners are never merged, as Envoy UDP listeners do not support filter chains.
+type udpListener struct {
+	gatewayListenerName string
+	listener            ir.Listener
+	listenerReporter    reports.ListenerReporter
+	routesWithHosts     []*query.RouteInfo
+}
+
+func (uc *udpListener) translateUdpListener(
+	parentName string,
+	reporter reports.Reporter,
+) *ir.UdpIR {
+	if len(uc.routesWithHosts) == 0 {
+		return nil
+	}
+
+	// Only one route per listener is supported, so use the oldest route.
+	r := slices.MinFunc(uc.routesWithHosts, func(a, b *query.RouteInfo) int {
+		return a.Object.GetSourceObject().GetCreationTimestamp().Compare(b.Object.GetSourceObject().GetCreationTimestamp().Time)
+	})
+	uRoute, ok := r.Object.(*ir.UdpRouteIR)
+	if !ok {
+		return nil
+	}
+
+	// Envoy's UDP proxy routes each session to a single cluster, so weighted backends are not supported.
+	// This limitation is documented in docs/guides/udp-routing.md.
+	condition := reports.RouteCondition{
+		Type:   gwv1.RouteConditionAccepted,
+		Status: metav1.ConditionTrue,
+		Reason: gwv1.RouteReasonAccepted,
+	}
+	switch {
+	case len(uRoute.SourceObject.Spec.Rules) != 1:
+		condition = reports.RouteCondition{
+			Type:    gwv1.RouteConditionAccepted,
+			Status:  metav1.ConditionFalse,
+			Reason:  gwv1.RouteReasonUnsupportedValue,
+			Message: "UDPRoute must have exactly one rule: weighted UDP backends are not supported",
+		}
+	case len(uRoute.Backends) != 1:
+		condition = reports.RouteCondition{
+			Type:    gwv1.RouteConditionAccepted,
+			Status:  metav1.ConditionFalse,
+			Reason:  gwv1.RouteReasonUnsupportedValue,
+			Message: "UDPRoute must have exactly one backendRef: weighted UDP backends are not supported",
+		}
+	}
+
+	parentRefReporters := make([]reports.ParentRefReporter, 0, len(uRoute.ParentRefs))
+	for _, parentRef := range uRoute.ParentRefs {
+		parentRefReporter := reporter.Route(uRoute.SourceObject).ParentRef(&parentRef)
+		parentRefReporter.SetCondition(condition)
+		parentRefReporters = append(parentRefReporters, parentRefReporter)
+	}
+	if condition.Status != metav1.ConditionTrue {
+		return nil
+	}
+
+	backend := uRoute.Backends[0]
+	if backend.Err != nil || backend.BackendObject == nil {
+		err := backend.Err
+		if err == nil {
+			err = errors.New("not found")
+		}
+		for _, parentRefReporter := range parentRefReporters {
+			query.ProcessBackendError(err, parentRefReporter)
+		}
+	}
+
+	return &ir.UdpIR{
+		Name:        fmt.Sprintf("%s-%s.%s", parentName, uRoute.Namespace, uRoute.Name),
+		BackendRefs: []ir.BackendRefIR{backend},
+	}
+}
+
 // httpFilterChain each one represents a GW Listener that has been merged into a single Listener (with distinct filter chains).
 // In the case where no GW Listener merging takes place, every listener will use a MergedListener with 1 HTTP filter chain.
 type httpFilterChain struct {
@@ -727,6 +873,7 @@ type httpsFilterChain struct {
 	tls              *gwv1.ListenerTLSConfig
 	routesWithHosts  []*query.RouteInfo
 	attachedPolicies ir.AttachedPolicies
+	http3            *ir.Http3IR
 }
 
 func (hfc *httpsFilterChain) translateHttpsFilterChain(
@@ -806,6 +953,7 @@ func (hfc *httpsFilterChain) translateHttpsFilterChain(
 		},
 		AttachedPolicies: hfc.attachedPolicies,
 		Vhosts:           virtualHosts,
+		Http3:            hfc.http3,
 	}, nil
 }
 
@@ -1128,6 +1276,17 @@ func getCertValidationFromAttached(listener ir.Listener) *ir.ClientCertificateVa
 	}
 }
 
+// getHttp3FromAttached returns the HTTP/3 configuration from the ListenerPolicies attached to the listener,
+// falling back to the ListenerPolicies attached to its Gateway. Returns nil if HTTP/3 is not enabled.
+func getHttp3FromAttached(listener ir.Listener, gw ir.Gateway) *ir.Http3IR {
+	gk := wellknown.ListenerPolicyGVK.GroupKind()
+	port := uint32(listener.Port) //nolint:gosec // G115: Gateway listener port is int32, always positive, safe to convert to uint32
+	if http3 := ir.Http3ForPort(listener.AttachedPolicies.Policies[gk], port); http3 != nil {
+		return http3
+	}
+	return ir.Http3ForPort(gw.AttachedListenerPolicies.Policies[gk], port)
+}
+
 // applyClientCertificateValidation applies the resolved client certificate validation configuration
 // to the TLS config by fetching CA certificates and setting validation parameters.
 // Returns a boolean indicating if any client certificate validation was applied successfully and an error if any errors were encountered.
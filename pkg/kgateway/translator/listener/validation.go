@@ -58,6 +58,11 @@ func getSupportedProtocolsRoutes() map[protocol]map[groupName][]routeKind {
 				wellknown.TCPRouteKind,
 			},
 		},
+		string(gwv1.UDPProtocolType): {
+			gwv1.GroupName: []string{
+				wellknown.UDPRouteKind,
+			},
+		},
 		string(gwv1.TLSProtocolType): {
 			gwv1.GroupName: []string{
 				wellknown.TLSRouteKind,
@@ -76,6 +81,12 @@ func getSupportedProtocolsRoutes() map[protocol]map[groupName][]routeKind {
 	return supportedProtocolToKinds
 }
 
+// IsSupportedProtocol returns whether routes can be attached to listeners with the given protocol.
+func IsSupportedProtocol(protocol gwv1.ProtocolType) bool {
+	_, ok := getSupportedProtocolsRoutes()[string(protocol)]
+	return ok
+}
+
 func buildDefaultRouteKindsForProtocol(supportedRouteKindsForProtocol map[groupName][]routeKind) []gwv1.RouteGroupKind {
 	rgks := []gwv1.RouteGroupKind{}
 	for group, kinds := range supportedRouteKindsForProtocol {
@@ -280,6 +291,9 @@ func validateListeners(gw *ir.Gateway, reporter reports.Reporter, settings Liste
 				// If a listener does not have a protocol conflict with one listener,
 				// it could still have a hostname conflict with another listener
 				rejectConflictedListener(parentReporter, listener, gwv1.ListenerReasonHostnameConflict, ListenerMessageHostnameConflict)
+			} else if udpPortConflict(*pp, listener) {
+				// UDP listeners do not match on hostnames, so only one UDP listener can use a port
+				rejectConflictedListener(parentReporter, listener, gwv1.ListenerReasonProtocolConflict, ListenerMessageUdpPortConflict)
 			} else if err := validate.ListenerPort(listener, port); err != nil {
 				rejectConflictedListener(parentReporter, listener, gwv1.ListenerReasonInvalid, err.Error())
 			} else {
@@ -416,6 +430,20 @@ func hostNameConflict(portProtocol portProtocol, listener ir.Listener) bool {
 	return true
 }
 
+func udpPortConflict(portProtocol portProtocol, listener ir.Listener) bool {
+	// UDP listeners are served by a UDP proxy forwarding all datagrams of the port to a single backend,
+	// so only the first UDP listener of a port is accepted as per listener precedence, regardless of hostnames.
+	// Listeners with other protocols on the port have already been rejected by protocolConflict()
+	if listener.Protocol != gwv1.UDPProtocolType {
+		return false
+	}
+	if generateUniqueListenerName(listener) == generateUniqueListenerName(portProtocol.listeners[0]) {
+		return false
+	}
+	logger.Error("rejected UDP listener sharing a port with another UDP listener as per listener precedence", "name", listener.Name, "parent", listener.Parent.GetName())
+	return true
+}
+
 func rejectDeniedListenerSets(consolidatedGateway *ir.Gateway, reporter reports.Reporter) {
 	for _, gvkLS := range consolidatedGateway.DeniedListenerSets {
 		for _, ls := range gvkLS {
@@ -305,15 +305,15 @@ func TestUnsupportedProtocol(t *testing.T) {
 	g.Expect(validListeners).To(BeEmpty())
 
 	expectedGwStatuses := map[string]gwv1.ListenerStatus{
-		"udp": {
-			Name:           "udp",
+		"sctp": {
+			Name:           "sctp",
 			SupportedKinds: []gwv1.RouteGroupKind{},
 			Conditions: []metav1.Condition{
 				{
 					Type:    string(gwv1.ListenerConditionAccepted),
 					Status:  metav1.ConditionFalse,
 					Reason:  string(gwv1.ListenerReasonUnsupportedProtocol),
-					Message: "Protocol UDP is unsupported.",
+					Message: "Protocol SCTP is unsupported.",
 				},
 			},
 		},
@@ -1045,6 +1045,79 @@ func TestTCPHostnameConflict(t *testing.T) {
 	assertExpectedListenerStatuses(t, g, report.ListenerSet(listenerSet), utils.ToListenerSlice(listenerSet.Spec.Listeners), expectedLsStatuses)
 }
 
+func TestUDPPortConflict(t *testing.T) {
+	gateway := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "udp-gateway",
+		},
+		Spec: gwv1.GatewaySpec{
+			GatewayClassName: "kgateway",
+			Listeners: []gwv1.Listener{
+				{
+					Name:     "udp",
+					Port:     5353,
+					Protocol: gwv1.UDPProtocolType,
+					Hostname: new(gwv1.Hostname("foo.example.com")),
+				},
+				{
+					Name:     "udp2",
+					Port:     5353,
+					Protocol: gwv1.UDPProtocolType,
+					Hostname: new(gwv1.Hostname("bar.example.com")),
+				},
+			},
+		},
+	}
+	report := reports.NewReportMap()
+	reporter := reports.NewReporter(&report)
+
+	validListeners := validateGateway(gwToIr(gateway, nil, nil), reporter, settings)
+	g := NewWithT(t)
+	g.Expect(validListeners).To(HaveLen(1))
+	g.Expect(validListeners[0].Name).To(BeEquivalentTo("udp"))
+
+	supportedKinds := []gwv1.RouteGroupKind{
+		{
+			Group: GroupNameHelper(),
+			Kind:  "UDPRoute",
+		},
+	}
+	expectedGwStatuses := map[string]gwv1.ListenerStatus{
+		"udp": {
+			Name:           "udp",
+			SupportedKinds: supportedKinds,
+			// The first UDP listener of the port should be accepted based on listener precedence
+			Conditions: []metav1.Condition{},
+		},
+		"udp2": {
+			Name:           "udp2",
+			SupportedKinds: supportedKinds,
+			Conditions: []metav1.Condition{
+				{
+					Type:    string(gwv1.ListenerConditionConflicted),
+					Status:  metav1.ConditionTrue,
+					Reason:  string(gwv1.ListenerReasonProtocolConflict),
+					Message: ListenerMessageUdpPortConflict,
+				},
+				{
+					Type:    string(gwv1.ListenerConditionAccepted),
+					Status:  metav1.ConditionFalse,
+					Reason:  string(gwv1.ListenerReasonProtocolConflict),
+					Message: ListenerMessageUdpPortConflict,
+				},
+				{
+					Type:    string(gwv1.ListenerConditionProgrammed),
+					Status:  metav1.ConditionFalse,
+					Reason:  string(gwv1.ListenerReasonProtocolConflict),
+					Message: ListenerMessageUdpPortConflict,
+				},
+			},
+		},
+	}
+	assertExpectedListenerStatuses(t, g, report.Gateway(gateway), gateway.Spec.Listeners, expectedGwStatuses)
+}
+
 func simpleGwTCPRoute() *gwv1.Gateway {
 	return &gwv1.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
@@ -2222,9 +2295,9 @@ func unsupportedProtocolGw() *gwv1.Gateway {
 			GatewayClassName: "kgateway",
 			Listeners: []gwv1.Listener{
 				{
-					Name:     "udp",
+					Name:     "sctp",
 					Port:     8080,
-					Protocol: gwv1.UDPProtocolType,
+					Protocol: gwv1.ProtocolType("SCTP"),
 				},
 			},
 		},
@@ -130,19 +130,26 @@ func (s *CombinedTranslator) GetBackendTranslator() *irtranslator.BackendTransla
 
 // ctx needed for logging; remove once we refactor logging.
 func (s *CombinedTranslator) TranslateGateway(kctx krt.HandlerContext, ctx context.Context, gw ir.Gateway) (*irtranslator.TranslationResult, reports.ReportMap) {
+	xdsSnap, _, rm := s.TranslateGatewayWithIR(kctx, ctx, gw)
+	return xdsSnap, rm
+}
+
+// TranslateGatewayWithIR translates the Gateway as TranslateGateway does, and also returns the IR
+// the xDS resources were translated from.
+func (s *CombinedTranslator) TranslateGatewayWithIR(kctx krt.HandlerContext, ctx context.Context, gw ir.Gateway) (*irtranslator.TranslationResult, *ir.GatewayIR, reports.ReportMap) {
 	rm := reports.NewReportMap()
 	r := reports.NewReporter(&rm)
 	logger.Debug("translating Gateway", "resource_ref", gw.ResourceName(), "resource_version", gw.Obj.GetResourceVersion())
 
 	gwir := s.buildProxy(kctx, ctx, gw, r)
 	if gwir == nil {
-		return nil, reports.ReportMap{}
+		return nil, nil, reports.ReportMap{}
 	}
 
 	// we are recomputing xds snapshots as proxies have changed, signal that we need to sync xds with these new snapshots
 	xdsSnap := s.irtranslator.Translate(ctx, *gwir, r)
 
-	return &xdsSnap, rm
+	return &xdsSnap, gwir, rm
 }
 
 func (s *CombinedTranslator) TranslateEndpoints(kctx krt.HandlerContext, ucc ir.UniqlyConnectedClient, ep ir.EndpointsForBackend) (*envoyendpointv3.ClusterLoadAssignment, uint64) {
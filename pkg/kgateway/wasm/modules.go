@@ -0,0 +1,191 @@
+package wasm
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ModulesPathPrefix is the path prefix of the Wasm modules served by a ModuleStore.
+// A module is served at ModulesPathPrefix followed by the hex-encoded SHA-256 of the module.
+const ModulesPathPrefix = "/modules/"
+
+// ModuleStore stores the Wasm modules served to the proxies. Modules are stored per owner, e.g.
+// the GatewayExtension referencing the module, and served by their SHA-256, so that a module
+// referenced by several owners is only stored once.
+type ModuleStore struct {
+	mu sync.RWMutex
+	// owners maps owner -> SHA-256 of the module of the owner
+	owners map[string]string
+	// modules maps SHA-256 -> module
+	modules map[string]*module
+	// stored is closed once a first module is stored
+	stored     chan struct{}
+	storedOnce sync.Once
+}
+
+type module struct {
+	data []byte
+	refs int
+}
+
+// NewModuleStore returns an empty ModuleStore.
+func NewModuleStore() *ModuleStore {
+	return &ModuleStore{
+		owners:  make(map[string]string),
+		modules: make(map[string]*module),
+		stored:  make(chan struct{}),
+	}
+}
+
+// Put stores the module of an owner, replacing the previous module of the owner,
+// and returns the hex-encoded SHA-256 of the module.
+func (s *ModuleStore) Put(owner string, data []byte) string {
+	sum := SHA256(data)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.owners[owner] == sum {
+		return sum
+	}
+	s.deleteLocked(owner)
+	s.owners[owner] = sum
+	m, ok := s.modules[sum]
+	if !ok {
+		m = &module{data: data}
+		s.modules[sum] = m
+	}
+	m.refs++
+	s.storedOnce.Do(func() { close(s.stored) })
+	return sum
+}
+
+// Delete removes the module of an owner. The module is no longer served once no owner references it.
+func (s *ModuleStore) Delete(owner string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deleteLocked(owner)
+}
+
+func (s *ModuleStore) deleteLocked(owner string) {
+	sum, ok := s.owners[owner]
+	if !ok {
+		return
+	}
+	delete(s.owners, owner)
+	m := s.modules[sum]
+	m.refs--
+	if m.refs == 0 {
+		delete(s.modules, sum)
+	}
+}
+
+// Get returns the module with the given hex-encoded SHA-256.
+func (s *ModuleStore) Get(sum string) ([]byte, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	m, ok := s.modules[sum]
+	if !ok {
+		return nil, false
+	}
+	return m.data, true
+}
+
+// ServeHTTP serves the module at ModulesPathPrefix followed by the SHA-256 of the module.
+// Proxies verify the SHA-256 of the modules they fetch.
+func (s *ModuleStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	sum, ok := strings.CutPrefix(r.URL.Path, ModulesPathPrefix)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	data, ok := s.Get(sum)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/wasm")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// ServeOptions configures how the modules of a ModuleStore are served.
+type ServeOptions struct {
+	// Authenticate authenticates the requests for modules. Requests failing authentication are rejected.
+	// If nil, the requests are not authenticated.
+	Authenticate func(*http.Request) error
+	// TLSConfig is the TLS configuration of the server. If nil, the modules are served over plain HTTP.
+	TLSConfig *tls.Config
+}
+
+// Serve serves the modules of the store on the given port until the context is done.
+// The server only starts listening once a first module is stored.
+func (s *ModuleStore) Serve(ctx context.Context, port uint32, opts ServeOptions) {
+	select {
+	case <-ctx.Done():
+		return
+	case <-s.stored:
+	}
+
+	server := &http.Server{
+		Addr:              net.JoinHostPort("", strconv.FormatUint(uint64(port), 10)),
+		Handler:           s.handler(opts.Authenticate),
+		TLSConfig:         opts.TLSConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	slog.Info("wasm module server starting", "address", server.Addr, "tls", opts.TLSConfig != nil, "auth", opts.Authenticate != nil)
+	go func() {
+		<-ctx.Done()
+		if err := server.Close(); err != nil {
+			slog.Warn("wasm module server shutdown returned error", "error", err)
+		}
+	}()
+	var err error
+	if opts.TLSConfig != nil {
+		// the certificate is provided by the TLS config
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err == http.ErrServerClosed {
+		slog.Info("wasm module server closed")
+	} else {
+		slog.Warn("wasm module server closed with unexpected error", "error", err)
+	}
+}
+
+// handler returns the handler of the modules, rejecting the requests failing authentication if authenticate is set.
+func (s *ModuleStore) handler(authenticate func(*http.Request) error) http.Handler {
+	if authenticate == nil {
+		return s
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := authenticate(r); err != nil {
+			slog.Warn("wasm module request authentication failed", "remote_address", r.RemoteAddr, "error", err)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		s.ServeHTTP(w, r)
+	})
+}
+
+// SHA256 returns the hex-encoded SHA-256 of a module.
+func SHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,114 @@
+package wasm
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleStore(t *testing.T) {
+	s := NewModuleStore()
+	a, b := []byte("module-a"), []byte("module-b")
+
+	// a module referenced by several owners is stored once
+	sumA := s.Put("default/a", a)
+	assert.Equal(t, SHA256(a), sumA)
+	assert.Equal(t, sumA, s.Put("default/other", a))
+	s.Delete("default/other")
+	data, ok := s.Get(sumA)
+	require.True(t, ok)
+	assert.Equal(t, a, data)
+
+	// replacing the module of the last owner removes the previous module
+	sumB := s.Put("default/a", b)
+	_, ok = s.Get(sumA)
+	assert.False(t, ok)
+	_, ok = s.Get(sumB)
+	assert.True(t, ok)
+
+	s.Delete("default/a")
+	_, ok = s.Get(sumB)
+	assert.False(t, ok)
+}
+
+func TestModuleStoreServeHTTP(t *testing.T) {
+	s := NewModuleStore()
+	sum := s.Put("default/a", []byte("module"))
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "module",
+			method:     http.MethodGet,
+			path:       ModulesPathPrefix + sum,
+			wantStatus: http.StatusOK,
+			wantBody:   "module",
+		},
+		{
+			name:       "unknown module",
+			method:     http.MethodGet,
+			path:       ModulesPathPrefix + SHA256([]byte("other")),
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "unknown path",
+			method:     http.MethodGet,
+			path:       "/" + sum,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "unsupported method",
+			method:     http.MethodPost,
+			path:       ModulesPathPrefix + sum,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+			res := rec.Result()
+			assert.Equal(t, tt.wantStatus, res.StatusCode)
+			if tt.wantBody != "" {
+				body, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantBody, string(body))
+				assert.Equal(t, "application/wasm", res.Header.Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestModuleStoreAuthenticatedHandler(t *testing.T) {
+	s := NewModuleStore()
+	sum := s.Put("default/a", []byte("module"))
+	handler := s.handler(func(r *http.Request) error {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			return errors.New("invalid token")
+		}
+		return nil
+	})
+
+	req := httptest.NewRequest(http.MethodGet, ModulesPathPrefix+sum, nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	req.Header.Set("Authorization", "Bearer token")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "module", rec.Body.String())
+}
@@ -22,6 +22,7 @@ const (
 	HTTPRouteKind        = "HTTPRoute"
 	TCPRouteKind         = "TCPRoute"
 	TLSRouteKind         = "TLSRoute"
+	UDPRouteKind         = "UDPRoute"
 	GRPCRouteKind        = "GRPCRoute"
 	GatewayKind          = "Gateway"
 	GatewayClassKind     = "GatewayClass"
@@ -31,6 +32,9 @@ const (
 	// Kind string for XListenerSet resource
 	XListenerSetKind = "XListenerSet"
 
+	// Kind string for XBackendTrafficPolicy resource
+	XBackendTrafficPolicyKind = "XBackendTrafficPolicy"
+
 	// List Kind strings
 	HTTPRouteListKind      = "HTTPRouteList"
 	GatewayListKind        = "GatewayList"
@@ -92,6 +96,16 @@ var (
 		Version:  gwv1a2.GroupVersion.Version,
 		Resource: "tcproutes",
 	}
+	UDPRouteGVK = schema.GroupVersionKind{
+		Group:   GatewayGroup,
+		Version: gwv1a2.GroupVersion.Version,
+		Kind:    UDPRouteKind,
+	}
+	UDPRouteGVR = schema.GroupVersionResource{
+		Group:    GatewayGroup,
+		Version:  gwv1a2.GroupVersion.Version,
+		Resource: "udproutes",
+	}
 	GRPCRouteGVK = schema.GroupVersionKind{
 		Group:   GatewayGroup,
 		Version: gwv1.GroupVersion.Version,
@@ -139,4 +153,15 @@ var (
 		Version:  gwxv1a1.GroupVersion.Version,
 		Resource: "xlistenersets",
 	}
+
+	XBackendTrafficPolicyGVK = schema.GroupVersionKind{
+		Group:   XListenerSetGroup,
+		Version: gwxv1a1.GroupVersion.Version,
+		Kind:    XBackendTrafficPolicyKind,
+	}
+	XBackendTrafficPolicyGVR = schema.GroupVersionResource{
+		Group:    XListenerSetGroup,
+		Version:  gwxv1a1.GroupVersion.Version,
+		Resource: "xbackendtrafficpolicies",
+	}
 )
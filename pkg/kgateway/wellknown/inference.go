@@ -0,0 +1,10 @@
+package wellknown
+
+import (
+	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+)
+
+var (
+	InferencePoolGVK = inf.SchemeGroupVersion.WithKind("InferencePool")
+	InferencePoolGVR = inf.SchemeGroupVersion.WithResource("inferencepools")
+)
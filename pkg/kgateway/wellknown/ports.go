@@ -11,6 +11,11 @@ const DefaultXdsService = "kgateway"
 // - the `controller.service.ports.grpc` value in install/helm/kgateway/values.yaml
 var DefaultXdsPort uint32 = 9977
 
+// DefaultWasmPort is the default port serving Wasm modules to the proxies. This value should stay in sync with:
+// - the default value of `WasmServicePort` in pkg/settings/settings.go
+// - the `controller.service.ports.wasm` value in install/helm/kgateway/values.yaml
+var DefaultWasmPort uint32 = 9978
+
 // EnvoyAdminPort is the default envoy admin port
 var EnvoyAdminPort uint32 = 19000
 
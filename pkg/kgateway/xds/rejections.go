@@ -0,0 +1,127 @@
+package xds
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Rejection is an xDS response currently rejected (NACKed) by the proxies of a Gateway.
+type Rejection struct {
+	// TypeUrl is the type of the rejected resources, e.g. envoy.config.listener.v3.Listener
+	TypeUrl string
+	// Message is the error message reported by the proxy.
+	Message string
+}
+
+type rejection struct {
+	// streams is the number of xDS streams currently rejecting the resources
+	streams int
+	message string
+}
+
+// RejectionTracker tracks the xDS responses currently rejected by the proxies of each Gateway,
+// so that the rejections can be reported on the status of the Gateway and the resources
+// contributing to the rejected configuration.
+// Only the rejections of the proxies connected to this control plane replica are tracked.
+type RejectionTracker struct {
+	lock       sync.Mutex
+	rejections map[types.NamespacedName]map[string]*rejection
+	updates    chan struct{}
+}
+
+func NewRejectionTracker() *RejectionTracker {
+	return &RejectionTracker{
+		rejections: make(map[types.NamespacedName]map[string]*rejection),
+		updates:    make(chan struct{}, 1),
+	}
+}
+
+// Reject records that an xDS stream of the Gateway rejected the resources of the given type.
+func (t *RejectionTracker) Reject(gateway types.NamespacedName, typeUrl, message string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	byType := t.rejections[gateway]
+	if byType == nil {
+		byType = make(map[string]*rejection)
+		t.rejections[gateway] = byType
+	}
+	r := byType[typeUrl]
+	if r == nil {
+		r = &rejection{}
+		byType[typeUrl] = r
+	}
+	r.streams++
+	if r.streams == 1 || r.message != message {
+		r.message = message
+		t.notify()
+	}
+}
+
+// Update records that an xDS stream of the Gateway already rejecting the resources of the given type
+// rejected them again with a different message, e.g. after receiving a new invalid configuration.
+func (t *RejectionTracker) Update(gateway types.NamespacedName, typeUrl, message string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	r := t.rejections[gateway][typeUrl]
+	if r == nil || r.message == message {
+		return
+	}
+	r.message = message
+	t.notify()
+}
+
+// Resolve records that an xDS stream of the Gateway no longer rejects the resources of the given type,
+// either because it accepted a new response or because the stream was closed.
+func (t *RejectionTracker) Resolve(gateway types.NamespacedName, typeUrl string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	r := t.rejections[gateway][typeUrl]
+	if r == nil {
+		return
+	}
+	r.streams--
+	if r.streams > 0 {
+		return
+	}
+	delete(t.rejections[gateway], typeUrl)
+	if len(t.rejections[gateway]) == 0 {
+		delete(t.rejections, gateway)
+	}
+	t.notify()
+}
+
+// Rejections returns the xDS responses currently rejected by the proxies of each Gateway,
+// sorted by type.
+func (t *RejectionTracker) Rejections() map[types.NamespacedName][]Rejection {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	out := make(map[types.NamespacedName][]Rejection, len(t.rejections))
+	for gateway, byType := range t.rejections {
+		for typeUrl, r := range byType {
+			out[gateway] = append(out[gateway], Rejection{TypeUrl: typeUrl, Message: r.message})
+		}
+		slices.SortFunc(out[gateway], func(a, b Rejection) int {
+			return strings.Compare(a.TypeUrl, b.TypeUrl)
+		})
+	}
+	return out
+}
+
+// Updates returns a channel that receives a value when the rejections change.
+func (t *RejectionTracker) Updates() <-chan struct{} {
+	return t.updates
+}
+
+func (t *RejectionTracker) notify() {
+	select {
+	case t.updates <- struct{}{}:
+	default: // an update is already pending
+	}
+}
@@ -12,11 +12,7 @@ import (
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	corsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
 	stateful_sessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/stateful_session/v3"
-	stateful_cookie "github.com/envoyproxy/go-control-plane/envoy/extensions/http/stateful_session/cookie/v3"
-	stateful_header "github.com/envoyproxy/go-control-plane/envoy/extensions/http/stateful_session/header/v3"
-	httpv3 "github.com/envoyproxy/go-control-plane/envoy/type/http/v3"
 	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
-	envoytype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -38,8 +34,6 @@ import (
 )
 
 const (
-	statefulSessionFilterName = "envoy.filters.http.stateful_session"
-
 	httpRedirectStatusCodesAllowedMsg = "must be one of 301, 302, 303, 307, 308"
 )
 
@@ -178,7 +172,11 @@ func (h *RoutesIndex) buildHTTPRouteRulePolicy(rule gwv1.HTTPRouteRule) ruleIR {
 	// Ref: https://github.com/kgateway-dev/kgateway/issues/12825
 	if rule.SessionPersistence != nil {
 		if h.enableExperimentalGatewayAPIFeatures {
-			ir.sessionPersistence = convertSessionPersistence(rule.SessionPersistence)
+			sessionPersistence, err := policy.BuildSessionPersistence(rule.SessionPersistence)
+			if err != nil {
+				logger.Error("failed to create session state", "error", err)
+			}
+			ir.sessionPersistence = sessionPersistence
 		} else {
 			logger.Warn("experimental gateway api features are disabled but SessionPersistence is configured. Skipping")
 		}
@@ -200,7 +198,7 @@ func (p *builtinPluginGwPass) applyRulePolicy(
 	r.applyTimeouts(outputRoute.GetRoute(), r.retry != nil, mergeOpts)
 	r.applyRetry(outputRoute.GetRoute(), mergeOpts)
 
-	if r.sessionPersistence != nil && policy.IsSettable(outputRoute.GetTypedPerFilterConfig()[statefulSessionFilterName], mergeOpts) {
+	if r.sessionPersistence != nil && policy.IsSettable(outputRoute.GetTypedPerFilterConfig()[policy.StatefulSessionFilterName], mergeOpts) {
 		if outputRoute.GetTypedPerFilterConfig() == nil {
 			outputRoute.TypedPerFilterConfig = map[string]*anypb.Any{}
 		}
@@ -209,12 +207,55 @@ func (p *builtinPluginGwPass) applyRulePolicy(
 			logger.Error("error marshalling SessionPersistence", "error", err)
 			return err
 		}
-		outputRoute.GetTypedPerFilterConfig()[statefulSessionFilterName] = anyMsg
+		outputRoute.GetTypedPerFilterConfig()[policy.StatefulSessionFilterName] = anyMsg
 		p.needStatefulSession[pCtx.FilterChainName] = true
+		p.overrideBackendSessionPersistence(pCtx, r.sessionPersistence, outputRoute)
 	}
 	return nil
 }
 
+// overrideBackendSessionPersistence gives precedence to the session persistence of the route rule over the
+// one configured by policies attached to the backends of the rule, and reports a conflict on the route
+// when they disagree.
+func (p *builtinPluginGwPass) overrideBackendSessionPersistence(
+	pCtx *ir.RouteContext,
+	ruleSessionPersistence *stateful_sessionv3.StatefulSessionPerRoute,
+	outputRoute *envoyroutev3.Route,
+) {
+	var conflicts []string
+	for _, backend := range pCtx.In.Backends {
+		if backend.Backend.BackendObject == nil {
+			continue
+		}
+		sp, ref := backend.Backend.BackendObject.GetSessionPersistence()
+		if sp == nil || proto.Equal(sp, ruleSessionPersistence) {
+			continue
+		}
+		conflict := backend.Backend.BackendObject.GetObjectSource().String()
+		if ref != nil {
+			conflict = fmt.Sprintf("%s (%s/%s/%s)", conflict, ref.Kind, ref.Namespace, ref.Name)
+		}
+		conflicts = append(conflicts, conflict)
+	}
+
+	// backend-level config is set on the route for a single backend, and on each weighted cluster otherwise
+	delete(pCtx.TypedFilterConfig, policy.StatefulSessionFilterName)
+	for _, cw := range outputRoute.GetRoute().GetWeightedClusters().GetClusters() {
+		delete(cw.GetTypedPerFilterConfig(), policy.StatefulSessionFilterName)
+	}
+
+	if len(conflicts) == 0 || pCtx.In.Parent == nil {
+		return
+	}
+	p.reporter.Route(pCtx.In.Parent.SourceObject).ParentRef(&pCtx.In.ParentRef).SetCondition(reporter.RouteCondition{
+		Type:   reporter.RouteConditionConflicted,
+		Status: metav1.ConditionTrue,
+		Reason: reporter.RouteReasonSessionPersistenceConflict,
+		Message: fmt.Sprintf("Rule (%d) session persistence overrides the session persistence configured for backends: %s",
+			pCtx.In.MatchIndex, strings.Join(conflicts, ", ")),
+	})
+}
+
 func convertTimeouts(timeout *gwv1.HTTPRouteTimeouts) *timeouts {
 	if timeout == nil {
 		return nil
@@ -331,69 +372,6 @@ func (r ruleIR) applyRetry(
 	action.RetryPolicy = r.retry
 }
 
-func convertSessionPersistence(sessionPersistence *gwv1.SessionPersistence) *stateful_sessionv3.StatefulSessionPerRoute {
-	if sessionPersistence == nil {
-		return nil
-	}
-
-	// Handle session persistence if specified
-	var sessionState proto.Message
-	spType := gwv1.CookieBasedSessionPersistence
-	if sessionPersistence.Type != nil {
-		spType = *sessionPersistence.Type
-	}
-
-	switch spType {
-	case gwv1.CookieBasedSessionPersistence:
-		var ttl *durationpb.Duration
-		if sessionPersistence.AbsoluteTimeout != nil {
-			if parsed, err := time.ParseDuration(string(*sessionPersistence.AbsoluteTimeout)); err == nil {
-				ttl = durationpb.New(parsed)
-			}
-		}
-		cookie := &httpv3.Cookie{
-			Name: utils.SanitizeCookieName(ptr.Deref(sessionPersistence.SessionName, "sessionPersistence")),
-			Ttl:  ttl,
-		}
-		// Only set LifetimeType if present in CookieConfig
-		if sessionPersistence.CookieConfig != nil &&
-			sessionPersistence.CookieConfig.LifetimeType != nil {
-			switch *sessionPersistence.CookieConfig.LifetimeType {
-			case gwv1.SessionCookieLifetimeType:
-				// Session cookies — cookies without a Max-Age or Expires attribute – are deleted when the current session ends
-				cookie.Ttl = nil
-			case gwv1.PermanentCookieLifetimeType:
-				if cookie.GetTtl() == nil {
-					cookie.Ttl = durationpb.New(time.Hour * 24 * 365)
-				}
-			}
-		}
-		sessionState = &stateful_cookie.CookieBasedSessionState{
-			Cookie: cookie,
-		}
-	case gwv1.HeaderBasedSessionPersistence:
-		sessionState = &stateful_header.HeaderBasedSessionState{
-			Name: utils.SanitizeHeaderName(ptr.Deref(sessionPersistence.SessionName, "x-session-persistence")),
-		}
-	}
-	sessionStateAny, err := utils.MessageToAny(sessionState)
-	if err != nil {
-		logger.Error("failed to create session state: %v", "error", err)
-		return nil
-	}
-	statefulSession := &stateful_sessionv3.StatefulSession{
-		SessionState: &envoycorev3.TypedExtensionConfig{
-			Name:        "envoy.http.stateful_session." + strings.ToLower(string(spType)),
-			TypedConfig: sessionStateAny,
-		},
-	}
-	return &stateful_sessionv3.StatefulSessionPerRoute{
-		Override: &stateful_sessionv3.StatefulSessionPerRoute_StatefulSession{
-			StatefulSession: statefulSession,
-		},
-	}
-}
-
 func translatePathRewrite(outputRoute *envoyroutev3.RedirectAction, pathRewrite *gwv1.HTTPPathModifier) {
 	if pathRewrite == nil {
 		return
@@ -644,31 +622,7 @@ func convertHeaderModifierIR(_ krt.HandlerContext, f *gwv1.HTTPHeaderFilter, isR
 }
 
 func getFractionPercent(f gwv1.HTTPRequestMirrorFilter) *envoycorev3.RuntimeFractionalPercent {
-	if f.Percent != nil {
-		return &envoycorev3.RuntimeFractionalPercent{
-			DefaultValue: &envoytype.FractionalPercent{
-				Numerator:   uint32(*f.Percent), //nolint:gosec // G115: percentage values are always non-negative and bounded (0-100)
-				Denominator: envoytype.FractionalPercent_HUNDRED,
-			},
-		}
-	}
-	if f.Fraction != nil {
-		denom := 100.0
-		if f.Fraction.Denominator != nil {
-			denom = float64(*f.Fraction.Denominator)
-		}
-		ratio := float64(f.Fraction.Numerator) / denom
-		// use MILLION denominator to maximize precision since arbitrary fractions are allowed.
-		return &envoycorev3.RuntimeFractionalPercent{
-			DefaultValue: &envoytype.FractionalPercent{
-				Numerator:   uint32(ratio * 1000000),
-				Denominator: envoytype.FractionalPercent_MILLION,
-			},
-		}
-	}
-
-	// nil means 100%
-	return nil
+	return policy.BuildMirrorRuntimeFraction(f.Percent, f.Fraction)
 }
 
 func NewGatewayTranslationPass(tctx ir.GwTranslationCtx, reporter reporter.Reporter) ir.ProxyTranslationPass {
@@ -742,6 +696,22 @@ func (p *builtinPluginGwPass) ApplyForRouteBackend(
 	return nil
 }
 
+// ApplyForBackend enables the session persistence configured by policies attached to the backend
+// (e.g. XBackendTrafficPolicy or BackendConfigPolicy) on the route or weighted cluster to the backend.
+// Session persistence set on the route rule takes precedence, see overrideBackendSessionPersistence.
+func (p *builtinPluginGwPass) ApplyForBackend(pCtx *ir.RouteBackendContext, in ir.HttpBackend, out *envoyroutev3.Route) error {
+	if pCtx.Backend == nil {
+		return nil
+	}
+	sessionPersistence, _ := pCtx.Backend.GetSessionPersistence()
+	if sessionPersistence == nil {
+		return nil
+	}
+	pCtx.TypedFilterConfig.AddTypedConfig(policy.StatefulSessionFilterName, sessionPersistence)
+	p.needStatefulSession[pCtx.FilterChainName] = true
+	return nil
+}
+
 func (p *builtinPluginGwPass) HttpFilters(_ ir.HttpFiltersContext, fcc ir.FilterChainCommon) ([]filters.StagedHttpFilter, error) {
 	builtinStaged := []filters.StagedHttpFilter{}
 
@@ -756,7 +726,7 @@ func (p *builtinPluginGwPass) HttpFilters(_ ir.HttpFiltersContext, fcc ir.Filter
 	}
 
 	if p.needStatefulSession[fcc.FilterChainName] {
-		stagedFilter, err := filters.NewStagedFilter(statefulSessionFilterName, &stateful_sessionv3.StatefulSession{}, filters.DuringStage(filters.AcceptedStage))
+		stagedFilter, err := filters.NewStagedFilter(policy.StatefulSessionFilterName, &stateful_sessionv3.StatefulSession{}, filters.DuringStage(filters.AcceptedStage))
 		if err != nil {
 			return nil, err
 		}
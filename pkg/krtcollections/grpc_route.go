@@ -162,7 +162,7 @@ func convertGRPCHeadersToHTTP(headers []gwv1.GRPCHeaderMatch) []gwv1.HTTPHeaderM
 func (h *RoutesIndex) convertGRPCBackendsToHTTP(kctx krt.HandlerContext, src ir.ObjectSource, backendRefs []gwv1.GRPCBackendRef) []ir.HttpBackendOrDelegate {
 	httpBackends := make([]ir.HttpBackendOrDelegate, 0, len(backendRefs))
 	for _, ref := range backendRefs {
-		backend, err := h.backends.GetBackendFromRef(kctx, src, ref.BackendObjectReference)
+		backend, err := h.backends.GetBackendWithPoliciesFromRef(kctx, src, ref.BackendObjectReference)
 		clusterName := "blackhole-cluster"
 		if backend != nil {
 			clusterName = backend.ClusterName()
@@ -82,6 +82,8 @@ type BackendIndex struct {
 	// availableBackendsWithPolicy is built from availableBackends, attaching policy to the given backends.
 	// BackendsWithPolicy is the public interface to access this.
 	availableBackendsWithPolicy []krt.Collection[*ir.BackendObjectIR]
+	// backendsWithPolicyByGK indexes the availableBackendsWithPolicy collections by the GK of their backends.
+	backendsWithPolicyByGK map[schema.GroupKind]krt.Collection[*ir.BackendObjectIR]
 	// backendsRequiringPolicyStatus is a collection of backends that have policies that may require status to be written to them.
 	// BackendsWithPolicyRequiringStatus is the public interface to access this.
 	backendsRequiringPolicyStatus []krt.Collection[*ir.BackendObjectIR]
@@ -104,12 +106,13 @@ func NewBackendIndex(
 	refgrants *RefGrantIndex,
 ) *BackendIndex {
 	return &BackendIndex{
-		policies:          policies,
-		refgrants:         refgrants,
-		availableBackends: map[schema.GroupKind]krt.Collection[ir.BackendObjectIR]{},
-		aliasIndex:        map[schema.GroupKind]krt.Index[backendKey, ir.BackendObjectIR]{},
-		gkAliases:         map[schema.GroupKind][]schema.GroupKind{},
-		krtopts:           krtopts,
+		policies:               policies,
+		refgrants:              refgrants,
+		availableBackends:      map[schema.GroupKind]krt.Collection[ir.BackendObjectIR]{},
+		backendsWithPolicyByGK: map[schema.GroupKind]krt.Collection[*ir.BackendObjectIR]{},
+		aliasIndex:             map[schema.GroupKind]krt.Index[backendKey, ir.BackendObjectIR]{},
+		gkAliases:              map[schema.GroupKind][]schema.GroupKind{},
+		krtopts:                krtopts,
 	}
 }
 
@@ -197,6 +200,7 @@ func (i *BackendIndex) AddBackends(gk schema.GroupKind, col krt.Collection[ir.Ba
 	i.availableBackends[gk] = col
 	i.aliasIndex[gk] = idx
 	i.availableBackendsWithPolicy = append(i.availableBackendsWithPolicy, backendsWithPoliciesCol)
+	i.backendsWithPolicyByGK[gk] = backendsWithPoliciesCol
 	i.backendsRequiringPolicyStatus = append(i.backendsRequiringPolicyStatus, backendsRequiringPolicyStatus)
 
 	// when we query by the alias, also check our "actual" gk
@@ -310,6 +314,24 @@ func (i *BackendIndex) GetBackendFromRef(kctx krt.HandlerContext, src ir.ObjectS
 	return i.getBackendFromRef(kctx, src.Namespace, ref)
 }
 
+// GetBackendWithPoliciesFromRef is like GetBackendFromRef, but the returned backend also carries the
+// policies attached to it. Use it only when the caller needs the backend policies, as the caller is then
+// recomputed whenever a policy attached to the backend changes.
+func (i *BackendIndex) GetBackendWithPoliciesFromRef(kctx krt.HandlerContext, src ir.ObjectSource, ref gwv1.BackendObjectReference) (*ir.BackendObjectIR, error) {
+	backend, err := i.GetBackendFromRef(kctx, src, ref)
+	if backend == nil {
+		return backend, err
+	}
+	col := i.backendsWithPolicyByGK[backend.GetGroupKind()]
+	if col == nil {
+		return backend, err
+	}
+	if withPolicies := krt.FetchOne(kctx, col, krt.FilterKey(backend.ResourceName())); withPolicies != nil {
+		return *withPolicies, err
+	}
+	return backend, err
+}
+
 // Intentionally long name, to make sure the user doesn't use this by mistake.
 func (i *BackendIndex) GetBackendFromRefWithoutRefGrantValidation(kctx krt.HandlerContext, src ir.ObjectSource, ref gwv1.BackendObjectReference) (*ir.BackendObjectIR, error) {
 	return i.getBackendFromRef(kctx, src.Namespace, ref)
@@ -359,8 +381,30 @@ func GatewaysForDeployerTransformationFunc(config *GatewayIndexConfig) func(kctx
 			return nil
 		}
 		ports := sets.New[int32]()
+		udpPorts := sets.New[int32]()
+		http3Ports := sets.New[int32]()
+		gwSrc := ir.ObjectSource{
+			Group:     gwv1.GroupVersion.Group,
+			Kind:      wellknown.GatewayKind,
+			Namespace: gw.Namespace,
+			Name:      gw.Name,
+		}
+		var gwPolicies []ir.PolicyAtt
+		if config.PolicyIndex != nil {
+			gwPolicies = config.PolicyIndex.GetTargetingPolicies(kctx, gwSrc, "", gw.GetLabels())
+		}
 		for _, l := range gw.Spec.Listeners {
 			ports.Insert(l.Port)
+			if l.Protocol == gwv1.UDPProtocolType {
+				udpPorts.Insert(l.Port)
+			}
+			if l.Protocol == gwv1.HTTPSProtocolType && config.PolicyIndex != nil {
+				// listener policies take precedence over gateway policies
+				listenerPolicies := config.PolicyIndex.GetTargetingPolicies(kctx, gwSrc, string(l.Name), gw.GetLabels())
+				if ir.Http3ForPort(append(listenerPolicies, gwPolicies...), uint32(l.Port)) != nil { //nolint:gosec // G115: Gateway listener port is int32, always positive, safe to convert to uint32
+					http3Ports.Insert(l.Port)
+				}
+			}
 		}
 
 		listenerSets := krt.Fetch(kctx, config.ListenerSets, krt.FilterIndex(config.byParentRefIndex, TargetRefIndexKey{
@@ -371,6 +415,16 @@ func GatewaysForDeployerTransformationFunc(config *GatewayIndexConfig) func(kctx
 		}))
 
 		for _, ls := range listenerSets {
+			lsSrc := ir.ObjectSource{
+				Group:     wellknown.XListenerSetGroup,
+				Kind:      wellknown.XListenerSetKind,
+				Namespace: ls.Namespace,
+				Name:      ls.Name,
+			}
+			var listenerSetPolicies []ir.PolicyAtt
+			if config.PolicyIndex != nil {
+				listenerSetPolicies = config.PolicyIndex.GetTargetingPolicies(kctx, lsSrc, "", ls.GetLabels())
+			}
 			for _, l := range ls.Spec.Listeners {
 				port, portErr := kubeutils.DetectListenerPortNumber(l.Protocol, l.Port)
 				// Don't need to log an error for the deployer as it will be reflected in the listener status during reconciliation
@@ -378,17 +432,25 @@ func GatewaysForDeployerTransformationFunc(config *GatewayIndexConfig) func(kctx
 					continue
 				}
 				ports.Insert(port)
+				if l.Protocol == gwv1.UDPProtocolType {
+					udpPorts.Insert(port)
+				}
+				if l.Protocol == gwv1.HTTPSProtocolType && config.PolicyIndex != nil {
+					// listener policies take precedence over listener set policies, which take precedence over gateway policies
+					listenerPolicies := config.PolicyIndex.GetTargetingPolicies(kctx, lsSrc, string(l.Name), ls.GetLabels())
+					listenerPolicies = append(listenerPolicies, listenerSetPolicies...)
+					if ir.Http3ForPort(append(listenerPolicies, gwPolicies...), uint32(port)) != nil { //nolint:gosec // G115: listener port is int32, always positive, safe to convert to uint32
+						http3Ports.Insert(port)
+					}
+				}
 			}
 		}
 		ir := &ir.GatewayForDeployer{
-			ObjectSource: ir.ObjectSource{
-				Group:     gwv1.GroupVersion.Group,
-				Kind:      wellknown.GatewayKind,
-				Namespace: gw.Namespace,
-				Name:      gw.Name,
-			},
+			ObjectSource:   gwSrc,
 			ControllerName: string(gwClass.Spec.ControllerName),
 			Ports:          smallset.New(ports.UnsortedList()...),
+			UdpPorts:       smallset.New(udpPorts.UnsortedList()...),
+			Http3Ports:     smallset.New(http3Ports.UnsortedList()...),
 		}
 		return ir
 	}
@@ -1004,6 +1066,12 @@ func (c RouteWrapper) Equals(in RouteWrapper) bool {
 		} else {
 			return a.Equals(*bhttp)
 		}
+	case *ir.UdpRouteIR:
+		if budp, ok := in.Route.(*ir.UdpRouteIR); !ok {
+			return false
+		} else {
+			return a.Equals(*budp)
+		}
 	}
 	panic("unknown route type")
 }
@@ -1067,6 +1135,7 @@ func NewRoutesIndex(
 	grpcroutes krt.Collection[*gwv1.GRPCRoute],
 	tcproutes krt.Collection[*gwv1a2.TCPRoute],
 	tlsroutes krt.Collection[*gwv1a2.TLSRoute],
+	udproutes krt.Collection[*gwv1a2.UDPRoute],
 	policies *PolicyIndex,
 	backends *BackendIndex,
 	refgrants *RefGrantIndex,
@@ -1079,7 +1148,7 @@ func NewRoutesIndex(
 		weightedRoutePrecedence:              globalSettings.WeightedRoutePrecedence,
 		enableExperimentalGatewayAPIFeatures: globalSettings.EnableExperimentalGatewayAPIFeatures,
 	}
-	h.hasSyncedFuncs = append(h.hasSyncedFuncs, httproutes.HasSynced, grpcroutes.HasSynced, tcproutes.HasSynced, tlsroutes.HasSynced)
+	h.hasSyncedFuncs = append(h.hasSyncedFuncs, httproutes.HasSynced, grpcroutes.HasSynced, tcproutes.HasSynced, tlsroutes.HasSynced, udproutes.HasSynced)
 
 	h.httpRouteStatusMarkers, h.httpRoutes = krt.NewStatusCollection(httproutes, func(kctx krt.HandlerContext, i *gwv1.HTTPRoute) (*StatusMarker, *ir.HttpRouteIR) {
 		return h.transformHttpRoute(kctx, i, controllerName)
@@ -1098,11 +1167,15 @@ func NewRoutesIndex(
 		t := h.transformTlsRoute(kctx, i)
 		return &RouteWrapper{Route: t}
 	}, krtopts.ToOptions("routes-tls-routes-with-policy")...)
+	udpRoutesCollection := krt.NewCollection(udproutes, func(kctx krt.HandlerContext, i *gwv1a2.UDPRoute) *RouteWrapper {
+		t := h.transformUdpRoute(kctx, i)
+		return &RouteWrapper{Route: t}
+	}, krtopts.ToOptions("routes-udp-routes-with-policy")...)
 	grpcRoutesCollection := krt.NewCollection(grpcroutes, func(kctx krt.HandlerContext, i *gwv1.GRPCRoute) *RouteWrapper {
 		t := h.transformGRPCRoute(kctx, i)
 		return &RouteWrapper{Route: t}
 	}, krtopts.ToOptions("routes-grpc-routes-with-policy")...)
-	h.routes = krt.JoinCollection([]krt.Collection[RouteWrapper]{httpRouteCollection, grpcRoutesCollection, tcpRoutesCollection, tlsRoutesCollection}, krtopts.ToOptions("all-routes-with-policy")...)
+	h.routes = krt.JoinCollection([]krt.Collection[RouteWrapper]{httpRouteCollection, grpcRoutesCollection, tcpRoutesCollection, tlsRoutesCollection, udpRoutesCollection}, krtopts.ToOptions("all-routes-with-policy")...)
 
 	httpBySelector := krtpkg.UnnamedIndex(h.httpRoutes, func(i ir.HttpRouteIR) []HTTPRouteSelector {
 		value, ok := i.SourceObject.GetLabels()[apilabels.DelegationLabelSelector]
@@ -1251,6 +1324,26 @@ func (h *RoutesIndex) transformTlsRoute(kctx krt.HandlerContext, i *gwv1a2.TLSRo
 	}
 }
 
+func (h *RoutesIndex) transformUdpRoute(kctx krt.HandlerContext, i *gwv1a2.UDPRoute) *ir.UdpRouteIR {
+	src := ir.ObjectSource{
+		Group:     gwv1a2.GroupVersion.Group,
+		Kind:      "UDPRoute",
+		Namespace: i.Namespace,
+		Name:      i.Name,
+	}
+	var backends []gwv1.BackendRef
+	if len(i.Spec.Rules) > 0 {
+		backends = i.Spec.Rules[0].BackendRefs
+	}
+	return &ir.UdpRouteIR{
+		ObjectSource:     src,
+		SourceObject:     i,
+		ParentRefs:       i.Spec.ParentRefs,
+		Backends:         h.getTcpBackends(kctx, src, backends),
+		AttachedPolicies: ToAttachedPolicies(h.policies.GetTargetingPolicies(kctx, src, "", i.GetLabels())),
+	}
+}
+
 func (h *RoutesIndex) transformHttpRoute(kctx krt.HandlerContext, i *gwv1.HTTPRoute, controllerName string) (*StatusMarker, *ir.HttpRouteIR) {
 	src := ir.ObjectSource{
 		Group:     gwv1.GroupVersion.Group,
@@ -1471,7 +1564,8 @@ func (h *RoutesIndex) getBackends(kctx krt.HandlerContext, src ir.ObjectSource,
 			continue
 		}
 
-		backend, err := h.backends.GetBackendFromRef(kctx, src, ref.BackendRef.BackendObjectReference)
+		// the backend policies are needed to apply backend-level session persistence to the route
+		backend, err := h.backends.GetBackendWithPoliciesFromRef(kctx, src, ref.BackendRef.BackendObjectReference)
 
 		// TODO: if we can't find the backend, should we
 		// still use its cluster name in case it comes up later?
@@ -12,6 +12,7 @@ import (
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/kube/krt/krttest"
 	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/util/smallset"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -19,6 +20,7 @@ import (
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
 
 	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
@@ -514,8 +516,9 @@ func preRouteIndex(t test.Failer, inputs []any) *RoutesIndex {
 	httproutes := krttest.GetMockCollection[*gwv1.HTTPRoute](mock)
 	tcpproutes := krttest.GetMockCollection[*gwv1a2.TCPRoute](mock)
 	tlsroutes := krttest.GetMockCollection[*gwv1a2.TLSRoute](mock)
+	udproutes := krttest.GetMockCollection[*gwv1a2.UDPRoute](mock)
 	grpcroutes := krttest.GetMockCollection[*gwv1.GRPCRoute](mock)
-	rtidx := NewRoutesIndex(krtutil.KrtOptions{}, wellknown.DefaultGatewayControllerName, httproutes, grpcroutes, tcpproutes, tlsroutes, policies, upstreams, refgrants, apisettings.Settings{})
+	rtidx := NewRoutesIndex(krtutil.KrtOptions{}, wellknown.DefaultGatewayControllerName, httproutes, grpcroutes, tcpproutes, tlsroutes, udproutes, policies, upstreams, refgrants, apisettings.Settings{})
 	services.WaitUntilSynced(nil)
 	policyCol.WaitUntilSynced(nil)
 	for !rtidx.HasSynced() || !refgrants.HasSynced() || !policyCol.HasSynced() {
@@ -710,3 +713,88 @@ func BenchmarkPolicyAttachment(b *testing.B) {
 		})
 	}
 }
+
+type fakeHttp3PolicyIR struct {
+	fakePolicyIR
+	port uint32
+}
+
+func (f fakeHttp3PolicyIR) GetHttp3(port uint32) *ir.Http3IR {
+	if port != f.port {
+		return nil
+	}
+	return &ir.Http3IR{}
+}
+
+func TestGatewaysForDeployerHttp3Ports(t *testing.T) {
+	listenerSetPolicy := func(sectionName string, port uint32) ir.PolicyWrapper {
+		return ir.PolicyWrapper{
+			ObjectSource: ir.ObjectSource{
+				Group:     wellknown.ListenerPolicyGVK.Group,
+				Kind:      wellknown.ListenerPolicyGVK.Kind,
+				Namespace: "default",
+				Name:      "policy-" + fmt.Sprint(port),
+			},
+			Policy:   &kgateway.ListenerPolicy{},
+			PolicyIR: fakeHttp3PolicyIR{port: port},
+			TargetRefs: []ir.PolicyRef{{
+				Group:       wellknown.XListenerSetGroup,
+				Kind:        wellknown.XListenerSetKind,
+				Name:        "ls",
+				SectionName: sectionName,
+			}},
+		}
+	}
+	inputs := []any{
+		&gwv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "kgateway"},
+			Spec:       gwv1.GatewayClassSpec{ControllerName: wellknown.DefaultGatewayControllerName},
+		},
+		&gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default"},
+			Spec: gwv1.GatewaySpec{
+				GatewayClassName: "kgateway",
+				Listeners:        []gwv1.Listener{{Name: "https", Protocol: gwv1.HTTPSProtocolType, Port: 443}},
+			},
+		},
+		&gwxv1a1.XListenerSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "ls", Namespace: "default"},
+			Spec: gwxv1a1.ListenerSetSpec{
+				ParentRef: gwxv1a1.ParentGatewayReference{Name: "gw"},
+				Listeners: []gwxv1a1.ListenerEntry{
+					{Name: "https-8443", Protocol: gwv1.HTTPSProtocolType, Port: 8443},
+					{Name: "https-9443", Protocol: gwv1.HTTPSProtocolType, Port: 9443},
+					{Name: "https-10443", Protocol: gwv1.HTTPSProtocolType, Port: 10443},
+				},
+			},
+		},
+		listenerSetPolicy("", 8443),
+		listenerSetPolicy("https-9443", 9443),
+	}
+
+	mock := krttest.NewMock(t, inputs)
+	policyCol := krttest.GetMockCollection[ir.PolicyWrapper](mock)
+	policies := NewPolicyIndex(
+		krtutil.KrtOptions{},
+		sdk.ContributesPolicies{
+			wellknown.ListenerPolicyGVK.GroupKind(): {
+				Policies: policyCol,
+			},
+		},
+		apisettings.Settings{},
+	)
+	gateways := NewGatewayIndex(GatewayIndexConfig{
+		ControllerNames: smallset.New(wellknown.DefaultGatewayControllerName),
+		PolicyIndex:     policies,
+		Gateways:        krttest.GetMockCollection[*gwv1.Gateway](mock),
+		ListenerSets:    krttest.GetMockCollection[*gwxv1a1.XListenerSet](mock),
+		GatewayClasses:  krttest.GetMockCollection[*gwv1.GatewayClass](mock),
+		Namespaces:      krttest.GetMockCollection[NamespaceMetadata](mock),
+	})
+	gateways.GatewaysForDeployer.WaitUntilSynced(nil)
+
+	gw := gateways.GatewaysForDeployer.GetKey("gateway.networking.k8s.io/Gateway/default/gw")
+	require.NotNil(t, gw)
+	assert.ElementsMatch(t, []int32{443, 8443, 9443, 10443}, gw.Ports.List())
+	assert.ElementsMatch(t, []int32{8443, 9443}, gw.Http3Ports.List())
+}
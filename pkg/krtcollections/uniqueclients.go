@@ -64,13 +64,13 @@ type peerInfo struct {
 	podRef *types.NamespacedName
 }
 
-func (x *callbacks) getPeerInfo(sid int64, r *envoy_service_discovery_v3.DiscoveryRequest, usePod bool) (peerInfo, error) {
+func (x *callbacks) getPeerInfo(sid int64, node *envoycorev3.Node, usePod bool) (peerInfo, error) {
 	var p peerInfo
 	if !x.xdsAuth {
 		// xDS auth is disabled, retrieve the role from Node metadata
-		p.role = roleFromRequest(r)
-		if usePod && r.GetNode() != nil {
-			p.podRef = new(getRef(r.GetNode()))
+		p.role = roleFromNode(node)
+		if usePod && node != nil {
+			p.podRef = new(getRef(node))
 		}
 		return p, nil
 	}
@@ -110,10 +110,13 @@ func NewUniquelyConnectedClients(
 	}
 
 	envoycb := xdsserver.CallbackFuncs{
-		StreamOpenFunc:    cb.OnStreamOpen,
-		StreamClosedFunc:  cb.OnStreamClosed,
-		StreamRequestFunc: cb.OnStreamRequest,
-		FetchRequestFunc:  cb.OnFetchRequest,
+		StreamOpenFunc:         cb.OnStreamOpen,
+		StreamClosedFunc:       cb.OnStreamClosed,
+		StreamRequestFunc:      cb.OnStreamRequest,
+		DeltaStreamOpenFunc:    cb.OnDeltaStreamOpen,
+		DeltaStreamClosedFunc:  cb.OnDeltaStreamClosed,
+		StreamDeltaRequestFunc: cb.OnStreamDeltaRequest,
+		FetchRequestFunc:       cb.OnFetchRequest,
 	}
 	return envoycb, buildCollection(cb)
 }
@@ -196,11 +199,11 @@ func (x *callbacksCollection) del(sid int64) *ir.UniqlyConnectedClient {
 	return nil
 }
 
-func roleFromRequest(r *envoy_service_discovery_v3.DiscoveryRequest) string {
-	return r.GetNode().GetMetadata().GetFields()[xds.RoleKey].GetStringValue()
+func roleFromNode(node *envoycorev3.Node) string {
+	return node.GetMetadata().GetFields()[xds.RoleKey].GetStringValue()
 }
 
-func (x *callbacksCollection) add(sid int64, r *envoy_service_discovery_v3.DiscoveryRequest, peer peerInfo) (string, bool, error) {
+func (x *callbacksCollection) add(sid int64, node *envoycorev3.Node, peer peerInfo) (string, bool, error) {
 	var pod *LocalityPod
 	// see if user wants to use pod locality info; this is only possible when podRef is set in getPeerInfo
 	if peer.podRef != nil {
@@ -218,7 +221,7 @@ func (x *callbacksCollection) add(sid int64, r *envoy_service_discovery_v3.Disco
 		if peer.podRef != nil {
 			if pod == nil {
 				// we need to use the pod locality info, so it's an error if we can't get the pod
-				return "", false, fmt.Errorf("pod not found for node %v", r.GetNode())
+				return "", false, fmt.Errorf("pod not found for node %v", node)
 			} else {
 				locality = pod.Locality
 				ns = pod.Namespace
@@ -254,7 +257,7 @@ func (x *callbacks) OnStreamRequest(sid int64, r *envoy_service_discovery_v3.Dis
 		return errors.New("kgateway not initialized")
 	}
 
-	peerInfo, err := x.getPeerInfo(sid, r, c.augmentedPods != nil)
+	peerInfo, err := x.getPeerInfo(sid, r.GetNode(), c.augmentedPods != nil)
 	if err != nil {
 		return err
 	}
@@ -263,11 +266,64 @@ func (x *callbacks) OnStreamRequest(sid int64, r *envoy_service_discovery_v3.Dis
 		return nil
 	}
 
-	return c.newStream(sid, r, peerInfo)
+	return c.newStream(sid, r.GetNode(), peerInfo)
 }
 
-func (x *callbacksCollection) newStream(sid int64, r *envoy_service_discovery_v3.DiscoveryRequest, peer peerInfo) error {
-	ucc, isNew, err := x.add(sid, r, peer)
+// OnDeltaStreamOpen is called once an incremental xDS stream is opened with a stream ID and the type URL (or "" for ADS).
+func (x *callbacks) OnDeltaStreamOpen(ctx context.Context, sid int64, typeURL string) error {
+	return x.OnStreamOpen(ctx, sid, typeURL)
+}
+
+// OnDeltaStreamClosed is called immediately prior to closing an incremental xDS stream with a stream ID.
+func (x *callbacks) OnDeltaStreamClosed(sid int64, node *envoycorev3.Node) {
+	if x.extraXDSCallbacks != nil {
+		x.extraXDSCallbacks.OnDeltaStreamClosed(sid, node)
+	}
+
+	if x.xdsAuth {
+		x.streamIDToPeerInfo.Delete(sid)
+	}
+	c := x.collection.Load()
+	if c == nil {
+		return
+	}
+	c.streamClosed(sid)
+}
+
+// OnStreamDeltaRequest is called once a request is received on an incremental xDS stream.
+// Returning an error will end processing and close the stream. OnDeltaStreamClosed will still be called.
+func (x *callbacks) OnStreamDeltaRequest(sid int64, r *envoy_service_discovery_v3.DeltaDiscoveryRequest) error {
+	if x.extraXDSCallbacks != nil {
+		if err := x.extraXDSCallbacks.OnStreamDeltaRequest(sid, r); err != nil {
+			return err
+		}
+	}
+
+	// the node may only be set on the first request of the stream. The server sets the node of the first
+	// request, augmented below, on the subsequent requests.
+	if r.GetNode() == nil {
+		return nil
+	}
+
+	c := x.collection.Load()
+	if c == nil {
+		return errors.New("kgateway not initialized")
+	}
+
+	peerInfo, err := x.getPeerInfo(sid, r.GetNode(), c.augmentedPods != nil)
+	if err != nil {
+		return err
+	}
+	// check that this collection only handles kgateway clients
+	if !xds.IsKubeGatewayCacheKey(peerInfo.role) {
+		return nil
+	}
+
+	return c.newStream(sid, r.GetNode(), peerInfo)
+}
+
+func (x *callbacksCollection) newStream(sid int64, node *envoycorev3.Node, peer peerInfo) error {
+	ucc, isNew, err := x.add(sid, node, peer)
 	if err != nil {
 		x.logger.Debug("error processing xds client", "error", err)
 		return err
@@ -276,7 +332,7 @@ func (x *callbacksCollection) newStream(sid int64, r *envoy_service_discovery_v3
 		return fmt.Errorf("got empty unique client name for sid %d", sid)
 	}
 
-	nodeMd := r.GetNode().GetMetadata()
+	nodeMd := node.GetMetadata()
 	if nodeMd == nil {
 		nodeMd = &structpb.Struct{}
 	}
@@ -289,7 +345,7 @@ func (x *callbacksCollection) newStream(sid int64, r *envoy_service_discovery_v3
 	// with how the snapshot is inserted to the cache for the proxy - it needs to be done with
 	// the unique client resource name as well.
 	nodeMd.GetFields()[xds.RoleKey] = structpb.NewStringValue(ucc)
-	r.GetNode().Metadata = nodeMd
+	node.Metadata = nodeMd
 	if isNew {
 		x.trigger.TriggerRecomputation()
 	}
@@ -341,7 +397,7 @@ func (x *callbacksCollection) fetchRequest(_ context.Context, r *envoy_service_d
 	podRef := getRef(r.GetNode())
 	k := krt.Named{Name: podRef.Name, Namespace: podRef.Namespace}.ResourceName()
 	pod = x.augmentedPods.GetKey(k)
-	ucc := ir.NewUniqlyConnectedClient(roleFromRequest(r), pod.Namespace, pod.AugmentedLabels, pod.Locality)
+	ucc := ir.NewUniqlyConnectedClient(roleFromNode(r.GetNode()), pod.Namespace, pod.AugmentedLabels, pod.Locality)
 
 	nodeMd := r.GetNode().GetMetadata()
 	if nodeMd == nil {
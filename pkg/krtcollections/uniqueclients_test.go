@@ -243,3 +243,39 @@ func TestUniqueClients(t *testing.T) {
 		})
 	}
 }
+
+func TestUniqueClientsDelta(t *testing.T) {
+	g := NewWithT(t)
+	role := wellknown.GatewayApiProxyValue + "~best-proxy-role"
+
+	cb, uccBuilder := NewUniquelyConnectedClients(nil, false)
+	ucc := uccBuilder(context.Background(), krtutil.KrtOptions{}, nil)
+	ucc.WaitUntilSynced(context.Background().Done())
+
+	g.Expect(cb.OnDeltaStreamOpen(context.Background(), 1, "")).To(Succeed())
+	req := &envoy_service_discovery_v3.DeltaDiscoveryRequest{
+		Node: &envoycorev3.Node{
+			Id: "podname.ns",
+			Metadata: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					xds.RoleKey: structpb.NewStringValue(role),
+				},
+			},
+		},
+	}
+	g.Expect(cb.OnStreamDeltaRequest(1, req)).To(Succeed())
+	g.Expect(req.GetNode().GetMetadata().GetFields()[xds.RoleKey].GetStringValue()).To(Equal(role))
+	// the node is only set on the first request of the stream
+	g.Expect(cb.OnStreamDeltaRequest(1, &envoy_service_discovery_v3.DeltaDiscoveryRequest{})).To(Succeed())
+
+	g.Eventually(func() []string {
+		var names []string
+		for _, uc := range ucc.List() {
+			names = append(names, uc.ResourceName())
+		}
+		return names
+	}, "1s").Should(ConsistOf(role))
+
+	cb.OnDeltaStreamClosed(1, req.GetNode())
+	g.Eventually(ucc.List, "5s").Should(BeEmpty())
+}
@@ -12,10 +12,12 @@ import (
 	"istio.io/istio/pkg/util/smallset"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
 	"github.com/kgateway-dev/kgateway/v2/pkg/apiclient"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wasm"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
@@ -36,6 +38,11 @@ type CommonCollections struct {
 	GatewayExtensions krt.Collection[ir.GatewayExtension]
 	Services          krt.Collection[*corev1.Service]
 	ServiceEntries    krt.Collection[*networkingclient.ServiceEntry]
+	// HTTPRoutes is the raw HTTPRoute collection, shared by the routes index and plugins that
+	// need to look up the routes referencing their resources. It is nil if Envoy is disabled.
+	HTTPRoutes krt.Collection[*gwv1.HTTPRoute]
+	// WasmModules stores the Wasm modules served to the proxies by the controller.
+	WasmModules *wasm.ModuleStore
 
 	WrappedPods  krt.Collection[krtcollections.WrappedPod]
 	LocalityPods krt.Collection[krtcollections.LocalityPod]
@@ -158,6 +165,16 @@ func NewCommonCollections(
 		gwExts = krtcollections.NewGatewayExtensionsCollection(ctx, client, krtOptions)
 	}
 
+	// Only create HTTPRoutes collection if Envoy is enabled
+	var httpRoutes krt.Collection[*gwv1.HTTPRoute]
+	if settings.EnableEnvoy {
+		httpRoutes = krt.WrapClient(kclient.NewFilteredDelayed[*gwv1.HTTPRoute](
+			client,
+			wellknown.HTTPRouteGVR,
+			kclient.Filter{ObjectFilter: client.ObjectFilter()},
+		), krtOptions.ToOptions("HTTPRoute")...)
+	}
+
 	localityPods, wrappedPods := krtcollections.NewPodsCollection(client, krtOptions)
 
 	return &CommonCollections{
@@ -172,6 +189,8 @@ func NewCommonCollections(
 		Namespaces:        namespaces,
 		Services:          services,
 		ServiceEntries:    serviceEntries,
+		HTTPRoutes:        httpRoutes,
+		WasmModules:       wasm.NewModuleStore(),
 		GatewayExtensions: gwExts,
 
 		DiscoveryNamespacesFilter: discoveryNamespacesFilter,
@@ -80,7 +80,7 @@ func (c *CommonCollections) InitCollections(
 	}
 
 	// create the KRT clients, remember to also register any needed types in the type registration setup.
-	httpRoutes := krt.WrapClient(kclient.NewFilteredDelayed[*gwv1.HTTPRoute](c.Client, wellknown.HTTPRouteGVR, filter), c.KrtOpts.ToOptions("HTTPRoute")...)
+	httpRoutes := c.HTTPRoutes
 	metrics.RegisterEvents(httpRoutes, kmetrics.GetResourceMetricEventHandler[*gwv1.HTTPRoute]())
 
 	// ON_EXPERIMENTAL_PROMOTION : Remove this block
@@ -88,16 +88,20 @@ func (c *CommonCollections) InitCollections(
 	var tcproutes krt.Collection[*gwv1a2.TCPRoute]
 	// Ref: https://github.com/kgateway-dev/kgateway/issues/12880
 	var tlsRoutes krt.Collection[*gwv1a2.TLSRoute]
+	var udpRoutes krt.Collection[*gwv1a2.UDPRoute]
 	if globalSettings.EnableExperimentalGatewayAPIFeatures {
 		tcproutes = krt.WrapClient(kclient.NewDelayedInformer[*gwv1a2.TCPRoute](c.Client, gvr.TCPRoute, kubetypes.StandardInformer, filter), c.KrtOpts.ToOptions("TCPRoute")...)
 		tlsRoutes = krt.WrapClient(kclient.NewDelayedInformer[*gwv1a2.TLSRoute](c.Client, gvr.TLSRoute, kubetypes.StandardInformer, filter), c.KrtOpts.ToOptions("TLSRoute")...)
+		udpRoutes = krt.WrapClient(kclient.NewDelayedInformer[*gwv1a2.UDPRoute](c.Client, gvr.UDPRoute, kubetypes.StandardInformer, filter), c.KrtOpts.ToOptions("UDPRoute")...)
 	} else {
 		// If disabled, still build a collection but make it always empty
 		tcproutes = krt.NewStaticCollection[*gwv1a2.TCPRoute](nil, nil, c.KrtOpts.ToOptions("disable/TCPRoute")...)
 		tlsRoutes = krt.NewStaticCollection[*gwv1a2.TLSRoute](nil, nil, c.KrtOpts.ToOptions("disable/TLSRoute")...)
+		udpRoutes = krt.NewStaticCollection[*gwv1a2.UDPRoute](nil, nil, c.KrtOpts.ToOptions("disable/UDPRoute")...)
 	}
 	metrics.RegisterEvents(tcproutes, kmetrics.GetResourceMetricEventHandler[*gwv1a2.TCPRoute]())
 	metrics.RegisterEvents(tlsRoutes, kmetrics.GetResourceMetricEventHandler[*gwv1a2.TLSRoute]())
+	metrics.RegisterEvents(udpRoutes, kmetrics.GetResourceMetricEventHandler[*gwv1a2.UDPRoute]())
 
 	grpcRoutes := krt.WrapClient(kclient.NewFilteredDelayed[*gwv1.GRPCRoute](c.Client, wellknown.GRPCRouteGVR, filter), c.KrtOpts.ToOptions("GRPCRoute")...)
 	metrics.RegisterEvents(grpcRoutes, kmetrics.GetResourceMetricEventHandler[*gwv1.GRPCRoute]())
@@ -106,7 +110,7 @@ func (c *CommonCollections) InitCollections(
 	initBackends(plugins, backendIndex)
 	endpointIRs := initEndpoints(plugins, c.KrtOpts)
 
-	routes := krtcollections.NewRoutesIndex(c.KrtOpts, c.ControllerName, httpRoutes, grpcRoutes, tcproutes, tlsRoutes, policies, backendIndex, c.RefGrants, globalSettings)
+	routes := krtcollections.NewRoutesIndex(c.KrtOpts, c.ControllerName, httpRoutes, grpcRoutes, tcproutes, tlsRoutes, udpRoutes, policies, backendIndex, c.RefGrants, globalSettings)
 	return gateways, routes, backendIndex, endpointIRs
 }
 
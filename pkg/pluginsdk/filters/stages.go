@@ -3,6 +3,7 @@ package filters
 import (
 	"bytes"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -22,8 +23,8 @@ var (
 // WellKnownFilterStages are represented by an integer that reflects their relative ordering
 type WellKnownFilterStage int
 
-// The set of WellKnownFilterStages, whose order corresponds to the order used to sort filters
-// If new well known filter stages are added, they should be inserted in a position corresponding to their order
+// The set of WellKnownFilterStages. The values of the stages are part of the public API and must not change:
+// new well known filter stages are appended, and their position in the filter chain is set in wellKnownFilterStageOrder.
 const (
 	FaultStage     WellKnownFilterStage = iota // Fault injection // First Filter Stage
 	CorsStage                                  // Cors stage
@@ -34,8 +35,40 @@ const (
 	AcceptedStage                              // Request passed all the checks and will be forwarded upstream
 	OutAuthStage                               // Add auth for the upstream (i.e. aws λ)
 	RouteStage                                 // Request is going to upstream // Last Filter Stage
+	CacheStage                                 // Response caching stage, cached responses skip the later stages
 )
 
+// wellKnownFilterStageOrder is the order of the WellKnownFilterStages in the filter chain, used to sort filters.
+var wellKnownFilterStageOrder = []WellKnownFilterStage{
+	FaultStage,
+	CorsStage,
+	WafStage,
+	AuthNStage,
+	AuthZStage,
+	RateLimitStage,
+	CacheStage,
+	AcceptedStage,
+	OutAuthStage,
+	RouteStage,
+}
+
+// order returns the position of the stage in the filter chain.
+func (s WellKnownFilterStage) order() int {
+	if i := slices.Index(wellKnownFilterStageOrder, s); i >= 0 {
+		return i
+	}
+	return int(s)
+}
+
+// filterStageOrder returns the position of a well known stage in the filter chain. Stages other than
+// WellKnownFilterStages are ordered by their value.
+func filterStageOrder[WellKnown ~int](stage WellKnown) int {
+	if s, ok := any(stage).(WellKnownFilterStage); ok {
+		return s.order()
+	}
+	return int(stage)
+}
+
 type WellKnownUpstreamHTTPFilterStage int
 
 // The set of WellKnownUpstreamHTTPFilterStages, whose order corresponds to the order used to sort filters
@@ -48,9 +81,9 @@ const (
 // returns -1 if less than, 0 if equal, 1 if greater than
 // It is not sufficient to return a Less bool because calling functions need to know if equal or greater when Less is false
 func FilterStageComparison[WellKnown ~int](a, b FilterStage[WellKnown]) int {
-	if a.RelativeTo < b.RelativeTo {
+	if aOrder, bOrder := filterStageOrder(a.RelativeTo), filterStageOrder(b.RelativeTo); aOrder < bOrder {
 		return -1
-	} else if a.RelativeTo > b.RelativeTo {
+	} else if aOrder > bOrder {
 		return 1
 	}
 	if a.RelativeWeight < b.RelativeWeight {
@@ -233,6 +266,7 @@ const (
 	FilterStage_AcceptedStage  FilterStage_Stage = 6
 	FilterStage_OutAuthStage   FilterStage_Stage = 7
 	FilterStage_RouteStage     FilterStage_Stage = 8
+	FilterStage_CacheStage     FilterStage_Stage = 9
 )
 
 // Enum value maps for FilterStage_Stage.
@@ -247,6 +281,7 @@ var (
 		6: "AcceptedStage",
 		7: "OutAuthStage",
 		8: "RouteStage",
+		9: "CacheStage",
 	}
 	FilterStage_Stage_value = map[string]int32{
 		"FaultStage":     0,
@@ -258,6 +293,7 @@ var (
 		"AcceptedStage":  6,
 		"OutAuthStage":   7,
 		"RouteStage":     8,
+		"CacheStage":     9,
 	}
 )
 
@@ -325,6 +361,8 @@ func ConvertFilterStage(in *FilterStageSpec) *FilterStage[WellKnownFilterStage]
 		outStage = AuthZStage
 	case FilterStage_RateLimitStage:
 		outStage = RateLimitStage
+	case FilterStage_CacheStage:
+		outStage = CacheStage
 	case FilterStage_AcceptedStage:
 		outStage = AcceptedStage
 	case FilterStage_OutAuthStage:
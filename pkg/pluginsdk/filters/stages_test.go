@@ -0,0 +1,19 @@
+package filters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterStageComparison(t *testing.T) {
+	// the values of the stages are part of the public API
+	assert.Equal(t, WellKnownFilterStage(6), AcceptedStage)
+	assert.Equal(t, WellKnownFilterStage(8), RouteStage)
+
+	// the cache stage is ordered between the rate limit and accepted stages, whatever its value
+	assert.Equal(t, -1, FilterStageComparison(AfterStage(RateLimitStage), BeforeStage(CacheStage)))
+	assert.Equal(t, -1, FilterStageComparison(AfterStage(CacheStage), BeforeStage(AcceptedStage)))
+	assert.Equal(t, 1, FilterStageComparison(DuringStage(RouteStage), DuringStage(CacheStage)))
+	assert.Equal(t, 0, FilterStageComparison(DuringStage(CacheStage), DuringStage(CacheStage)))
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	stateful_sessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/stateful_session/v3"
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/slices"
 	"istio.io/istio/pkg/util/smallset"
@@ -242,6 +243,32 @@ func (c BackendObjectIR) GetAttachedPolicies() AttachedPolicies {
 	return c.AttachedPolicies
 }
 
+// SessionPersistencePolicyIR is implemented by the IR of backend policies that configure session
+// persistence for all routes to the backends they target.
+type SessionPersistencePolicyIR interface {
+	// GetSessionPersistence returns the stateful session config for routes to the backend, or nil if
+	// the policy does not configure session persistence.
+	GetSessionPersistence() *stateful_sessionv3.StatefulSessionPerRoute
+}
+
+// GetSessionPersistence returns the session persistence configured by the first valid policy attached to
+// the backend, along with a reference to that policy. It returns nil if no attached policy configures it.
+func (c BackendObjectIR) GetSessionPersistence() (*stateful_sessionv3.StatefulSessionPerRoute, *AttachedPolicyRef) {
+	for _, gk := range c.AttachedPolicies.ApplyOrderedGroupKinds() {
+		for _, pol := range c.AttachedPolicies.Policies[gk] {
+			if len(pol.Errors) > 0 {
+				continue
+			}
+			if p, ok := pol.PolicyIr.(SessionPersistencePolicyIR); ok {
+				if sp := p.GetSessionPersistence(); sp != nil {
+					return sp, pol.PolicyRef
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
 type Secret struct {
 	// Ref to source object. sometimes the group and kind are not populated from api-server, so
 	// set them explicitly here, and pass this around as the reference.
@@ -314,6 +341,10 @@ type GatewayForDeployer struct {
 	ControllerName string
 	// All ports from all listeners
 	Ports smallset.Set[int32]
+	// Ports from listeners using the UDP protocol; a subset of Ports
+	UdpPorts smallset.Set[int32]
+	// Ports from HTTPS listeners that are also served over HTTP/3 (QUIC) using UDP; a subset of Ports
+	Http3Ports smallset.Set[int32]
 }
 
 func (c GatewayForDeployer) ResourceName() string {
@@ -323,7 +354,9 @@ func (c GatewayForDeployer) ResourceName() string {
 func (c GatewayForDeployer) Equals(in GatewayForDeployer) bool {
 	return c.ObjectSource.Equals(in.ObjectSource) &&
 		c.ControllerName == in.ControllerName &&
-		slices.Equal(c.Ports.List(), in.Ports.List())
+		slices.Equal(c.Ports.List(), in.Ports.List()) &&
+		slices.Equal(c.UdpPorts.List(), in.UdpPorts.List()) &&
+		slices.Equal(c.Http3Ports.List(), in.Http3Ports.List())
 }
 
 type ListenerForDeployer struct {
@@ -379,6 +412,39 @@ func (c *ClientCertificateValidationIR) Equals(in any) bool {
 	return equalsClientCertValidationIR(c, c2)
 }
 
+// Http3IR holds the HTTP/3 (QUIC) configuration for an HTTPS listener.
+type Http3IR struct {
+	// AltSvcMaxAge is the max age, in seconds, of the alt-svc header advertising HTTP/3 to clients.
+	AltSvcMaxAge uint32
+}
+
+func (h *Http3IR) Equals(in *Http3IR) bool {
+	if h == nil || in == nil {
+		return h == nil && in == nil
+	}
+	return h.AltSvcMaxAge == in.AltSvcMaxAge
+}
+
+// Http3PolicyIR is implemented by policy IRs that can enable HTTP/3 on HTTPS listeners.
+type Http3PolicyIR interface {
+	// GetHttp3 returns the HTTP/3 configuration for the given listener port, or nil if
+	// HTTP/3 is not enabled on that port.
+	GetHttp3(port uint32) *Http3IR
+}
+
+// Http3ForPort returns the HTTP/3 configuration of the first policy that enables HTTP/3 for
+// the given listener port, or nil if none do.
+func Http3ForPort(policies []PolicyAtt, port uint32) *Http3IR {
+	for _, pol := range policies {
+		if p, ok := pol.PolicyIr.(Http3PolicyIR); ok {
+			if h3 := p.GetHttp3(port); h3 != nil {
+				return h3
+			}
+		}
+	}
+	return nil
+}
+
 func (c Gateway) ResourceName() string {
 	return c.ObjectSource.ResourceName()
 }
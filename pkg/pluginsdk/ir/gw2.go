@@ -58,6 +58,9 @@ type ListenerIR struct {
 
 	HttpFilterChain []HttpFilterChainIR
 	TcpFilterChain  []TcpIR
+	// UdpListener is set when the listener serves UDP traffic. Envoy UDP listeners
+	// do not have filter chains, so a UDP listener cannot also have HTTP or TCP filter chains.
+	UdpListener *UdpIR
 
 	PolicyAncestorRef gwv1.ParentReference
 
@@ -132,6 +135,9 @@ type HttpFilterChainIR struct {
 	AttachedPolicies        AttachedPolicies
 	AttachedNetworkPolicies AttachedPolicies
 	CustomHTTPFilters       []CustomEnvoyFilter
+	// Http3 is set when the filter chain should also be served over HTTP/3 (QUIC).
+	// Only applies to filter chains with TLS.
+	Http3 *Http3IR
 }
 
 type TcpIR struct {
@@ -139,6 +145,12 @@ type TcpIR struct {
 	BackendRefs []BackendRefIR
 }
 
+type UdpIR struct {
+	// Name is used as the stat prefix for the UDP proxy.
+	Name        string
+	BackendRefs []BackendRefIR
+}
+
 // this is 1:1 with envoy deployments
 // not in a collection so doesn't need a krt interfaces.
 type GatewayIR struct {
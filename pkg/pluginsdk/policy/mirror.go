@@ -0,0 +1,38 @@
+package policy
+
+import (
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoytypev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// BuildMirrorRuntimeFraction converts the percentage of requests to mirror, given either as a
+// percent or as a fraction, to the runtime fraction of an Envoy request mirror policy.
+// It returns nil, which means 100%, if neither is set.
+func BuildMirrorRuntimeFraction(percent *int32, fraction *gwv1.Fraction) *envoycorev3.RuntimeFractionalPercent {
+	if percent != nil {
+		return &envoycorev3.RuntimeFractionalPercent{
+			DefaultValue: &envoytypev3.FractionalPercent{
+				Numerator:   uint32(*percent), //nolint:gosec // G115: percentage values are always non-negative and bounded (0-100)
+				Denominator: envoytypev3.FractionalPercent_HUNDRED,
+			},
+		}
+	}
+	if fraction != nil {
+		denom := 100.0
+		if fraction.Denominator != nil {
+			denom = float64(*fraction.Denominator)
+		}
+		ratio := float64(fraction.Numerator) / denom
+		// use MILLION denominator to maximize precision since arbitrary fractions are allowed.
+		return &envoycorev3.RuntimeFractionalPercent{
+			DefaultValue: &envoytypev3.FractionalPercent{
+				Numerator:   uint32(ratio * 1000000),
+				Denominator: envoytypev3.FractionalPercent_MILLION,
+			},
+		}
+	}
+
+	// nil means 100%
+	return nil
+}
@@ -4,9 +4,15 @@ import (
 	"strings"
 
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	omitcanaryhostsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/omit_canary_hosts/v3"
+	previoushostsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
@@ -31,9 +37,73 @@ func BuildRetryPolicy(in *kgateway.Retry) *envoyroutev3.RetryPolicy {
 		}
 	}
 
+	if in.PerTryIdleTimeout != nil {
+		policy.PerTryIdleTimeout = durationpb.New(in.PerTryIdleTimeout.Duration)
+	}
+
+	policy.RetryHostPredicate = retryHostPredicates(in.HostPredicates)
+	if in.HostSelectionMaxAttempts != nil {
+		policy.HostSelectionRetryMaxAttempts = int64(*in.HostSelectionMaxAttempts)
+	}
+
 	return policy
 }
 
+// BuildHedgePolicy converts the hedge settings of a Retry to an Envoy HedgePolicy.
+// It returns nil if hedging is not configured.
+func BuildHedgePolicy(in *kgateway.Retry) *envoyroutev3.HedgePolicy {
+	if in == nil || in.Hedge == nil {
+		return nil
+	}
+	hedge := &envoyroutev3.HedgePolicy{
+		HedgeOnPerTryTimeout: ptr.Deref(in.Hedge.HedgeOnPerTryTimeout, false),
+	}
+	if in.Hedge.InitialRequests != nil {
+		hedge.InitialRequests = wrapperspb.UInt32(uint32(*in.Hedge.InitialRequests)) //nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	if in.Hedge.AdditionalRequestPercentage != nil {
+		hedge.AdditionalRequestChance = &typev3.FractionalPercent{
+			Numerator:   uint32(*in.Hedge.AdditionalRequestPercentage), //nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+			Denominator: typev3.FractionalPercent_HUNDRED,
+		}
+	}
+	return hedge
+}
+
+// retryHostPredicates converts the RetryHostPredicates to their Envoy extension configs
+func retryHostPredicates(predicates []kgateway.RetryHostPredicate) []*envoyroutev3.RetryPolicy_RetryHostPredicate {
+	if len(predicates) == 0 {
+		return nil
+	}
+	out := make([]*envoyroutev3.RetryPolicy_RetryHostPredicate, 0, len(predicates))
+	for _, p := range predicates {
+		var (
+			name string
+			cfg  proto.Message
+		)
+		switch p {
+		case kgateway.RetryHostPredicatePreviousHosts:
+			name, cfg = "envoy.retry_host_predicates.previous_hosts", &previoushostsv3.PreviousHostsPredicate{}
+		case kgateway.RetryHostPredicateOmitCanaryHosts:
+			name, cfg = "envoy.retry_host_predicates.omit_canary_hosts", &omitcanaryhostsv3.OmitCanaryHostsPredicate{}
+		default:
+			// enum values are validated by kubebuilder, so this should never happen
+			continue
+		}
+		typedConfig, err := anypb.New(cfg)
+		if err != nil {
+			continue
+		}
+		out = append(out, &envoyroutev3.RetryPolicy_RetryHostPredicate{
+			Name: name,
+			ConfigType: &envoyroutev3.RetryPolicy_RetryHostPredicate_TypedConfig{
+				TypedConfig: typedConfig,
+			},
+		})
+	}
+	return out
+}
+
 // retryOnToString converts a slice of RetryOnCondition to a comma-separated string
 func retryOnToString(retryOn []kgateway.RetryOnCondition, forStatusCodes bool) string {
 	retryOnSet := sets.NewString()
@@ -5,12 +5,18 @@ import (
 	"time"
 
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	omitcanaryhostsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/omit_canary_hosts/v3"
+	previoushostsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/retry/host/previous_hosts/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
@@ -126,6 +132,36 @@ func TestBuildRetryPolicy(t *testing.T) {
 				RetriableStatusCodes: []uint32{404, 408},
 			},
 		},
+		{
+			name: "retry policy with per-try idle timeout and host predicates",
+			input: &kgateway.Retry{
+				RetryOn:                  []kgateway.RetryOnCondition{"reset"},
+				Attempts:                 int32(3),
+				PerTryIdleTimeout:        &metav1.Duration{Duration: 500 * time.Millisecond},
+				HostPredicates:           []kgateway.RetryHostPredicate{kgateway.RetryHostPredicatePreviousHosts, kgateway.RetryHostPredicateOmitCanaryHosts},
+				HostSelectionMaxAttempts: ptr.To[int32](5),
+			},
+			want: &envoyroutev3.RetryPolicy{
+				RetryOn:           "reset",
+				NumRetries:        wrapperspb.UInt32(3),
+				PerTryIdleTimeout: durationpb.New(500 * time.Millisecond),
+				RetryHostPredicate: []*envoyroutev3.RetryPolicy_RetryHostPredicate{
+					{
+						Name: "envoy.retry_host_predicates.previous_hosts",
+						ConfigType: &envoyroutev3.RetryPolicy_RetryHostPredicate_TypedConfig{
+							TypedConfig: mustAny(t, &previoushostsv3.PreviousHostsPredicate{}),
+						},
+					},
+					{
+						Name: "envoy.retry_host_predicates.omit_canary_hosts",
+						ConfigType: &envoyroutev3.RetryPolicy_RetryHostPredicate_TypedConfig{
+							TypedConfig: mustAny(t, &omitcanaryhostsv3.OmitCanaryHostsPredicate{}),
+						},
+					},
+				},
+				HostSelectionRetryMaxAttempts: 5,
+			},
+		},
 		{
 			name: "retry policy with duplicate retryOn conditions (should be deduplicated)",
 			input: &kgateway.Retry{
@@ -149,3 +185,71 @@ func TestBuildRetryPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildHedgePolicy(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *kgateway.Retry
+		want  *envoyroutev3.HedgePolicy
+	}{
+		{
+			name:  "nil input returns nil",
+			input: nil,
+			want:  nil,
+		},
+		{
+			name: "retry without hedge returns nil",
+			input: &kgateway.Retry{
+				RetryOn:  []kgateway.RetryOnCondition{"5xx"},
+				Attempts: int32(3),
+			},
+			want: nil,
+		},
+		{
+			name: "empty hedge",
+			input: &kgateway.Retry{
+				RetryOn: []kgateway.RetryOnCondition{"5xx"},
+				Hedge:   &kgateway.RetryHedgePolicy{},
+			},
+			want: &envoyroutev3.HedgePolicy{},
+		},
+		{
+			name: "hedge with all fields",
+			input: &kgateway.Retry{
+				RetryOn:       []kgateway.RetryOnCondition{"5xx"},
+				PerTryTimeout: &metav1.Duration{Duration: 100 * time.Millisecond},
+				Hedge: &kgateway.RetryHedgePolicy{
+					InitialRequests:             ptr.To[int32](2),
+					AdditionalRequestPercentage: ptr.To[int32](10),
+					HedgeOnPerTryTimeout:        ptr.To(true),
+				},
+			},
+			want: &envoyroutev3.HedgePolicy{
+				InitialRequests: wrapperspb.UInt32(2),
+				AdditionalRequestChance: &typev3.FractionalPercent{
+					Numerator:   10,
+					Denominator: typev3.FractionalPercent_HUNDRED,
+				},
+				HedgeOnPerTryTimeout: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+			got := BuildHedgePolicy(tt.input)
+			diff := cmp.Diff(got, tt.want, protocmp.Transform())
+			a.Empty(diff)
+		})
+	}
+}
+
+func mustAny(t *testing.T, m proto.Message) *anypb.Any {
+	t.Helper()
+	a, err := anypb.New(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
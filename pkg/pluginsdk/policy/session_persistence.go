@@ -0,0 +1,84 @@
+package policy
+
+import (
+	"strings"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	stateful_sessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/stateful_session/v3"
+	stateful_cookie "github.com/envoyproxy/go-control-plane/envoy/extensions/http/stateful_session/cookie/v3"
+	stateful_header "github.com/envoyproxy/go-control-plane/envoy/extensions/http/stateful_session/header/v3"
+	httpv3 "github.com/envoyproxy/go-control-plane/envoy/type/http/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+)
+
+const (
+	// StatefulSessionFilterName is the name of the stateful session filter used to implement session persistence
+	StatefulSessionFilterName = "envoy.filters.http.stateful_session"
+
+	defaultSessionPersistenceCookieName = "sessionPersistence"
+	defaultSessionPersistenceHeaderName = "x-session-persistence"
+)
+
+// BuildSessionPersistence converts the Gateway API SessionPersistence to the per-route config of the
+// stateful session filter. It is shared by HTTPRoute rules and the policies configuring session persistence
+// for backends. It returns nil if session persistence is not configured.
+func BuildSessionPersistence(in *gwv1.SessionPersistence) (*stateful_sessionv3.StatefulSessionPerRoute, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var sessionState proto.Message
+	spType := ptr.Deref(in.Type, gwv1.CookieBasedSessionPersistence)
+	switch spType {
+	case gwv1.CookieBasedSessionPersistence:
+		var ttl *durationpb.Duration
+		if in.AbsoluteTimeout != nil {
+			if parsed, err := time.ParseDuration(string(*in.AbsoluteTimeout)); err == nil {
+				ttl = durationpb.New(parsed)
+			}
+		}
+		cookie := &httpv3.Cookie{
+			Name: utils.SanitizeCookieName(ptr.Deref(in.SessionName, defaultSessionPersistenceCookieName)),
+			Ttl:  ttl,
+		}
+		// Only set LifetimeType if present in CookieConfig
+		if in.CookieConfig != nil && in.CookieConfig.LifetimeType != nil {
+			switch *in.CookieConfig.LifetimeType {
+			case gwv1.SessionCookieLifetimeType:
+				// Session cookies — cookies without a Max-Age or Expires attribute – are deleted when the current session ends
+				cookie.Ttl = nil
+			case gwv1.PermanentCookieLifetimeType:
+				if cookie.GetTtl() == nil {
+					cookie.Ttl = durationpb.New(time.Hour * 24 * 365)
+				}
+			}
+		}
+		sessionState = &stateful_cookie.CookieBasedSessionState{
+			Cookie: cookie,
+		}
+	case gwv1.HeaderBasedSessionPersistence:
+		sessionState = &stateful_header.HeaderBasedSessionState{
+			Name: utils.SanitizeHeaderName(ptr.Deref(in.SessionName, defaultSessionPersistenceHeaderName)),
+		}
+	}
+	sessionStateAny, err := utils.MessageToAny(sessionState)
+	if err != nil {
+		return nil, err
+	}
+	return &stateful_sessionv3.StatefulSessionPerRoute{
+		Override: &stateful_sessionv3.StatefulSessionPerRoute_StatefulSession{
+			StatefulSession: &stateful_sessionv3.StatefulSession{
+				SessionState: &envoycorev3.TypedExtensionConfig{
+					Name:        "envoy.http.stateful_session." + strings.ToLower(string(spType)),
+					TypedConfig: sessionStateAny,
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,98 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	stateful_sessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/stateful_session/v3"
+	stateful_cookie "github.com/envoyproxy/go-control-plane/envoy/extensions/http/stateful_session/cookie/v3"
+	stateful_header "github.com/envoyproxy/go-control-plane/envoy/extensions/http/stateful_session/header/v3"
+	httpv3 "github.com/envoyproxy/go-control-plane/envoy/type/http/v3"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestBuildSessionPersistence(t *testing.T) {
+	tests := []struct {
+		name  string
+		input *gwv1.SessionPersistence
+		want  *stateful_sessionv3.StatefulSessionPerRoute
+	}{
+		{
+			name:  "nil input returns nil",
+			input: nil,
+			want:  nil,
+		},
+		{
+			name:  "cookie based with defaults",
+			input: &gwv1.SessionPersistence{},
+			want: statefulSessionPerRoute(t, "envoy.http.stateful_session.cookie", &stateful_cookie.CookieBasedSessionState{
+				Cookie: &httpv3.Cookie{Name: "sessionPersistence"},
+			}),
+		},
+		{
+			name: "cookie based with absolute timeout",
+			input: &gwv1.SessionPersistence{
+				SessionName:     ptr.To("Session-A"),
+				Type:            ptr.To(gwv1.CookieBasedSessionPersistence),
+				AbsoluteTimeout: ptr.To(gwv1.Duration("1h")),
+			},
+			want: statefulSessionPerRoute(t, "envoy.http.stateful_session.cookie", &stateful_cookie.CookieBasedSessionState{
+				Cookie: &httpv3.Cookie{Name: "Session-A", Ttl: durationpb.New(time.Hour)},
+			}),
+		},
+		{
+			name: "session cookie ignores absolute timeout",
+			input: &gwv1.SessionPersistence{
+				AbsoluteTimeout: ptr.To(gwv1.Duration("1h")),
+				CookieConfig: &gwv1.CookieConfig{
+					LifetimeType: ptr.To(gwv1.SessionCookieLifetimeType),
+				},
+			},
+			want: statefulSessionPerRoute(t, "envoy.http.stateful_session.cookie", &stateful_cookie.CookieBasedSessionState{
+				Cookie: &httpv3.Cookie{Name: "sessionPersistence"},
+			}),
+		},
+		{
+			name: "header based with defaults",
+			input: &gwv1.SessionPersistence{
+				Type: ptr.To(gwv1.HeaderBasedSessionPersistence),
+			},
+			want: statefulSessionPerRoute(t, "envoy.http.stateful_session.header", &stateful_header.HeaderBasedSessionState{
+				Name: "x-session-persistence",
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildSessionPersistence(tt.input)
+			require.NoError(t, err)
+			assert.Empty(t, cmp.Diff(tt.want, got, protocmp.Transform()))
+		})
+	}
+}
+
+func statefulSessionPerRoute(t *testing.T, name string, sessionState proto.Message) *stateful_sessionv3.StatefulSessionPerRoute {
+	t.Helper()
+	sessionStateAny, err := anypb.New(sessionState)
+	require.NoError(t, err)
+	return &stateful_sessionv3.StatefulSessionPerRoute{
+		Override: &stateful_sessionv3.StatefulSessionPerRoute_StatefulSession{
+			StatefulSession: &stateful_sessionv3.StatefulSession{
+				SessionState: &envoycorev3.TypedExtensionConfig{
+					Name:        name,
+					TypedConfig: sessionStateAny,
+				},
+			},
+		},
+	}
+}
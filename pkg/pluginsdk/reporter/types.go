@@ -35,6 +35,41 @@ const (
 	GatewayReplacedReason = "GatewayReplaced"
 )
 
+const (
+	// RouteConditionConflicted is an implementation-specific condition reported on a route parent when the
+	// route configuration conflicts with the configuration of its backends. The route configuration takes
+	// precedence. The condition is removed once the conflict is resolved.
+	RouteConditionConflicted gwv1.RouteConditionType = "gateway.kgateway.dev/Conflicted"
+
+	// RouteReasonSessionPersistenceConflict is used with the Conflicted=True condition when the session
+	// persistence of a route rule differs from the one configured by a policy attached to its backends.
+	RouteReasonSessionPersistenceConflict gwv1.RouteConditionReason = "SessionPersistenceConflict"
+)
+
+const (
+	// GatewayReasonXdsRejected is used with the Programmed=False condition when the proxies of the Gateway
+	// reject (NACK) the xDS configuration translated for the Gateway. Only the proxies connected to the
+	// controller replica writing the status are taken into account.
+	GatewayReasonXdsRejected gwv1.GatewayConditionReason = "XdsRejected"
+
+	// RouteConditionXdsRejected is an implementation-specific condition reported on a route parent when the
+	// proxies of the parent Gateway reject the xDS configuration translated from the route.
+	// The condition is removed once the proxies accept the configuration.
+	RouteConditionXdsRejected gwv1.RouteConditionType = "gateway.kgateway.dev/XdsRejected"
+
+	// RouteReasonXdsRejected is used with the XdsRejected=True route condition.
+	RouteReasonXdsRejected gwv1.RouteConditionReason = "XdsRejected"
+
+	// PolicyConditionXdsRejected is an implementation-specific condition reported on a policy ancestor when the
+	// proxies of the ancestor Gateway reject the xDS configuration of a route the policy is attached to,
+	// whether by targetRefs, targetSelectors or extensionRefs.
+	// The condition is removed once the proxies accept the configuration.
+	PolicyConditionXdsRejected gwv1.PolicyConditionType = "gateway.kgateway.dev/XdsRejected"
+
+	// PolicyReasonXdsRejected is used with the XdsRejected=True policy condition.
+	PolicyReasonXdsRejected gwv1.PolicyConditionReason = "XdsRejected"
+)
+
 // PolicyAttachmentState represents the state of a policy attachment
 type PolicyAttachmentState int
 
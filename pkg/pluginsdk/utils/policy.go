@@ -73,6 +73,19 @@ func TargetRefsToPolicyRefsWithSectionNameV1(targetRefs []gwv1.LocalPolicyTarget
 	return refs
 }
 
+func TargetRefsToPolicyRefsV1(targetRefs []gwv1.LocalPolicyTargetReference) []ir.PolicyRef {
+	refs := make([]ir.PolicyRef, 0, len(targetRefs))
+	for _, targetRef := range targetRefs {
+		refs = append(refs, ir.PolicyRef{
+			Group: string(targetRef.Group),
+			Kind:  string(targetRef.Kind),
+			Name:  string(targetRef.Name),
+		})
+	}
+
+	return refs
+}
+
 func TargetRefsToPolicyRefsWithSectionNameV1Alpha2(targetRefs []gwv1a2.LocalPolicyTargetReferenceWithSectionName) []ir.PolicyRef {
 	refs := make([]ir.PolicyRef, 0, len(targetRefs))
 	for _, targetRef := range targetRefs {
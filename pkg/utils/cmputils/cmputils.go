@@ -32,3 +32,23 @@ func pointersValsEqual[T comparable](a, b *T) bool {
 func PointerValsEqual[T comparable](a, b *T) bool {
 	return CompareWithNils(a, b, pointersValsEqual[T])
 }
+
+// MapDiff compares two maps, and returns the keys that are only in the after map (added), the keys that
+// are only in the before map (removed), and the keys of the values that are not equal in both maps (modified).
+// Values are compared using the passed equal function. The keys are returned in no particular order.
+func MapDiff[K comparable, V any](before, after map[K]V, equal func(a, b V) bool) (added, removed, modified []K) {
+	for k, b := range before {
+		a, ok := after[k]
+		if !ok {
+			removed = append(removed, k)
+		} else if !equal(b, a) {
+			modified = append(modified, k)
+		}
+	}
+	for k := range after {
+		if _, ok := before[k]; !ok {
+			added = append(added, k)
+		}
+	}
+	return added, removed, modified
+}
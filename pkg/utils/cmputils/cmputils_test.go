@@ -1,6 +1,7 @@
 package cmputils
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -119,3 +120,31 @@ func TestPointerValsEqualWithNils(t *testing.T) {
 		})
 	}
 }
+
+func TestMapDiff(t *testing.T) {
+	before := map[string]*foo{
+		"unchanged": {bar: 1},
+		"modified":  {bar: 1},
+		"removed":   {bar: 1},
+		"nil":       nil,
+	}
+	after := map[string]*foo{
+		"unchanged": {bar: 1},
+		"modified":  {bar: 2},
+		"added":     {bar: 1},
+		"nil":       nil,
+	}
+
+	added, removed, modified := MapDiff(before, after, func(a, b *foo) bool {
+		return CompareWithNils(a, b, func(a, b *foo) bool { return a.bar == b.bar })
+	})
+	if !slices.Equal(added, []string{"added"}) {
+		t.Errorf("MapDiff() added = %v, want [added]", added)
+	}
+	if !slices.Equal(removed, []string{"removed"}) {
+		t.Errorf("MapDiff() removed = %v, want [removed]", removed)
+	}
+	if !slices.Equal(modified, []string{"modified"}) {
+		t.Errorf("MapDiff() modified = %v, want [modified]", modified)
+	}
+}
@@ -0,0 +1,363 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+
+	envoybootstrapv3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoyrouterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	envoyhcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	// register the types of all the Envoy extensions, so that the typed configs of any known extension
+	// can be unpacked and validated
+	_ "istio.io/istio/pkg/config/xds"
+)
+
+// inProcessValidator validates envoy configuration without Envoy, by checking the constraints of the
+// Envoy protos, the references between the resources, and a subset of the rules enforced by Envoy
+// when loading the configuration. It does not catch every configuration that Envoy rejects.
+type inProcessValidator struct{}
+
+var _ Validator = &inProcessValidator{}
+
+// NewInProcess creates a new validator that does not need an Envoy binary or docker.
+func NewInProcess() Validator {
+	return &inProcessValidator{}
+}
+
+func (v *inProcessValidator) String() string {
+	return "in-process"
+}
+
+func (v *inProcessValidator) Validate(_ context.Context, bootstrap *envoybootstrapv3.Bootstrap) error {
+	if err := validateBootstrap(bootstrap); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidXDS, err)
+	}
+	return nil
+}
+
+var routerTypeURL = "type.googleapis.com/" + string(proto.MessageName(&envoyrouterv3.Router{}))
+
+func validateBootstrap(bootstrap *envoybootstrapv3.Bootstrap) error {
+	if err := bootstrap.Validate(); err != nil {
+		return err
+	}
+
+	c := &configChecker{
+		clusters: make(map[string]struct{}),
+		secrets:  make(map[string]struct{}),
+	}
+	static := bootstrap.GetStaticResources()
+	for _, cluster := range static.GetClusters() {
+		if _, ok := c.clusters[cluster.GetName()]; ok {
+			return fmt.Errorf("cluster manager: duplicate cluster '%s'", cluster.GetName())
+		}
+		c.clusters[cluster.GetName()] = struct{}{}
+	}
+	for _, secret := range static.GetSecrets() {
+		if _, ok := c.secrets[secret.GetName()]; ok {
+			return fmt.Errorf("duplicate static secret name %s", secret.GetName())
+		}
+		c.secrets[secret.GetName()] = struct{}{}
+	}
+	listenerNames := make(map[string]struct{})
+	listenerAddresses := make(map[string]string)
+	for _, listener := range static.GetListeners() {
+		if _, ok := listenerNames[listener.GetName()]; ok {
+			return fmt.Errorf("error adding listener named '%s': duplicate listener name", listener.GetName())
+		}
+		listenerNames[listener.GetName()] = struct{}{}
+		if listener.GetAddress() == nil {
+			return fmt.Errorf("error adding listener named '%s': address is necessary", listener.GetName())
+		}
+		if addr := listener.GetAddress().GetSocketAddress(); addr != nil {
+			key := fmt.Sprintf("%s:%d/%s", addr.GetAddress(), addr.GetPortValue(), addr.GetProtocol())
+			if existing, ok := listenerAddresses[key]; ok {
+				return fmt.Errorf("error adding listener named '%s': duplicate address '%s:%d' as existing listener '%s'",
+					listener.GetName(), addr.GetAddress(), addr.GetPortValue(), existing)
+			}
+			listenerAddresses[key] = listener.GetName()
+		}
+	}
+
+	return c.walk(bootstrap.ProtoReflect())
+}
+
+// configChecker walks the configuration and checks every message, including the messages packed in
+// typed configs, against the rules that cannot be expressed as proto constraints.
+type configChecker struct {
+	// clusters are the names of the static clusters
+	clusters map[string]struct{}
+	// secrets are the names of the static secrets
+	secrets map[string]struct{}
+	// path is the chain of named resources enclosing the checked message, used in errors
+	path []string
+}
+
+func (c *configChecker) walk(m protoreflect.Message) error {
+	msg := m.Interface()
+	if scope := scopeOf(msg); scope != "" {
+		c.path = append(c.path, scope)
+		defer func() { c.path = c.path[:len(c.path)-1] }()
+	}
+
+	if err := c.check(msg); err != nil {
+		return c.wrap(err)
+	}
+
+	if a, ok := msg.(*anypb.Any); ok {
+		// an empty config is unset, e.g. the config of a disabled filter
+		if a.GetTypeUrl() == "" && len(a.GetValue()) == 0 {
+			return nil
+		}
+		inner, err := a.UnmarshalNew()
+		if err != nil {
+			return c.wrap(fmt.Errorf("didn't find a registered implementation for type URL '%s'", a.GetTypeUrl()))
+		}
+		if validatable, ok := inner.(interface{ Validate() error }); ok {
+			if err := validatable.Validate(); err != nil {
+				return c.wrap(err)
+			}
+		}
+		return c.walk(inner.ProtoReflect())
+	}
+
+	// iterate in the order of the descriptor, so that the first error is deterministic
+	fields := m.Descriptor().Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		if !m.Has(fd) {
+			continue
+		}
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := m.Get(fd).List()
+			for j := range list.Len() {
+				if err := c.walk(list.Get(j).Message()); err != nil {
+					return err
+				}
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			values := m.Get(fd).Map()
+			var keys []protoreflect.MapKey
+			values.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+				keys = append(keys, k)
+				return true
+			})
+			slices.SortFunc(keys, func(a, b protoreflect.MapKey) int {
+				return strings.Compare(a.String(), b.String())
+			})
+			for _, k := range keys {
+				if err := c.walk(values.Get(k).Message()); err != nil {
+					return err
+				}
+			}
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			if err := c.walk(m.Get(fd).Message()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *configChecker) wrap(err error) error {
+	if len(c.path) == 0 {
+		return err
+	}
+	return fmt.Errorf("%s: %w", strings.Join(c.path, ": "), err)
+}
+
+// scopeOf returns the description of the named resources that scope the errors of their fields.
+func scopeOf(msg proto.Message) string {
+	switch m := msg.(type) {
+	case *envoylistenerv3.Listener:
+		return fmt.Sprintf("listener '%s'", m.GetName())
+	case *envoylistenerv3.Filter:
+		return fmt.Sprintf("filter '%s'", m.GetName())
+	case *envoyhcmv3.HttpFilter:
+		return fmt.Sprintf("http filter '%s'", m.GetName())
+	case *envoyclusterv3.Cluster:
+		return fmt.Sprintf("cluster '%s'", m.GetName())
+	case *envoyroutev3.RouteConfiguration:
+		return fmt.Sprintf("route configuration '%s'", m.GetName())
+	case *envoyroutev3.VirtualHost:
+		return fmt.Sprintf("virtual host '%s'", m.GetName())
+	case *envoyroutev3.Route:
+		return fmt.Sprintf("route '%s'", m.GetName())
+	case *envoytlsv3.Secret:
+		return fmt.Sprintf("secret '%s'", m.GetName())
+	}
+	return ""
+}
+
+func (c *configChecker) check(msg proto.Message) error {
+	switch m := msg.(type) {
+	case *envoylistenerv3.Listener:
+		return checkListener(m)
+	case *envoyhcmv3.HttpConnectionManager:
+		return checkHttpConnectionManager(m)
+	case *envoyroutev3.RouteConfiguration:
+		return c.checkRouteConfiguration(m)
+	case *envoyroutev3.Route:
+		return checkRoute(m)
+	case *envoyclusterv3.Cluster:
+		return checkCluster(m)
+	case *envoymatcherv3.RegexMatcher:
+		if _, err := regexp.Compile(m.GetRegex()); err != nil {
+			return err
+		}
+	case *envoytlsv3.SdsSecretConfig:
+		// secrets without a config source are static secrets
+		if m.GetSdsConfig() == nil {
+			if _, ok := c.secrets[m.GetName()]; !ok {
+				return fmt.Errorf("unknown static secret: %s", m.GetName())
+			}
+		}
+	}
+	return nil
+}
+
+func checkListener(listener *envoylistenerv3.Listener) error {
+	udp := listener.GetAddress().GetSocketAddress().GetProtocol() == envoycorev3.SocketAddress_UDP
+	if !udp && len(listener.GetFilterChains()) == 0 && listener.GetDefaultFilterChain() == nil {
+		return errors.New("no filter chains specified")
+	}
+	matches := make(map[string]struct{}, len(listener.GetFilterChains()))
+	for _, filterChain := range listener.GetFilterChains() {
+		key, err := proto.MarshalOptions{Deterministic: true}.Marshal(filterChain.GetFilterChainMatch())
+		if err != nil {
+			return err
+		}
+		if _, ok := matches[string(key)]; ok {
+			return fmt.Errorf("multiple filter chains with the same matching rules are defined: '%s'", filterChain.GetName())
+		}
+		matches[string(key)] = struct{}{}
+	}
+	return nil
+}
+
+func checkHttpConnectionManager(hcm *envoyhcmv3.HttpConnectionManager) error {
+	filters := hcm.GetHttpFilters()
+	for i, filter := range filters {
+		terminal := filter.GetName() == envoywellknown.Router || filter.GetTypedConfig().GetTypeUrl() == routerTypeURL
+		last := i == len(filters)-1
+		if terminal && !last {
+			return fmt.Errorf("terminal filter named %s must be the last filter in a http filter chain", filter.GetName())
+		}
+		if !terminal && last {
+			return fmt.Errorf("non-terminal filter named %s is the last filter in a http filter chain", filter.GetName())
+		}
+	}
+	return nil
+}
+
+func (c *configChecker) checkRouteConfiguration(routeConfig *envoyroutev3.RouteConfiguration) error {
+	vhostNames := make(map[string]struct{})
+	domains := make(map[string]struct{})
+	for _, vhost := range routeConfig.GetVirtualHosts() {
+		if _, ok := vhostNames[vhost.GetName()]; ok {
+			return fmt.Errorf("only unique values for virtual host names are permitted, duplicate entry of %s", vhost.GetName())
+		}
+		vhostNames[vhost.GetName()] = struct{}{}
+		for _, domain := range vhost.GetDomains() {
+			domain = strings.ToLower(domain)
+			if _, ok := domains[domain]; ok {
+				return fmt.Errorf("only unique values for domains are permitted, duplicate entry of domain %s", domain)
+			}
+			domains[domain] = struct{}{}
+		}
+	}
+
+	// the clusters of the route configurations loaded statically are validated by default
+	if routeConfig.GetValidateClusters() != nil && !routeConfig.GetValidateClusters().GetValue() {
+		return nil
+	}
+	for _, vhost := range routeConfig.GetVirtualHosts() {
+		for _, route := range vhost.GetRoutes() {
+			for _, cluster := range routeClusters(route) {
+				if _, ok := c.clusters[cluster]; !ok {
+					return fmt.Errorf("virtual host '%s': route '%s': unknown cluster '%s'", vhost.GetName(), route.GetName(), cluster)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func routeClusters(route *envoyroutev3.Route) []string {
+	action := route.GetRoute()
+	if action == nil {
+		return nil
+	}
+	var clusters []string
+	if cluster := action.GetCluster(); cluster != "" {
+		clusters = append(clusters, cluster)
+	}
+	for _, weighted := range action.GetWeightedClusters().GetClusters() {
+		if weighted.GetName() != "" {
+			clusters = append(clusters, weighted.GetName())
+		}
+	}
+	for _, mirror := range action.GetRequestMirrorPolicies() {
+		if mirror.GetCluster() != "" {
+			clusters = append(clusters, mirror.GetCluster())
+		}
+	}
+	return clusters
+}
+
+func checkRoute(route *envoyroutev3.Route) error {
+	action := route.GetRoute()
+	if action == nil {
+		return nil
+	}
+	if action.GetPrefixRewrite() != "" && action.GetRegexRewrite() != nil {
+		return errors.New("cannot specify both prefix_rewrite and regex_rewrite")
+	}
+	if weighted := action.GetWeightedClusters(); weighted != nil {
+		var total uint64
+		for _, cluster := range weighted.GetClusters() {
+			total += uint64(cluster.GetWeight().GetValue())
+		}
+		if total == 0 {
+			return errors.New("sum of weights in the weighted_cluster must be greater than 0")
+		}
+	}
+	return nil
+}
+
+func checkCluster(cluster *envoyclusterv3.Cluster) error {
+	switch cluster.GetType() {
+	case envoyclusterv3.Cluster_STATIC:
+		for _, locality := range cluster.GetLoadAssignment().GetEndpoints() {
+			for _, lbEndpoint := range locality.GetLbEndpoints() {
+				addr := lbEndpoint.GetEndpoint().GetAddress().GetSocketAddress()
+				if addr != nil && net.ParseIP(addr.GetAddress()) == nil {
+					return fmt.Errorf("malformed IP address: %s", addr.GetAddress())
+				}
+			}
+		}
+	case envoyclusterv3.Cluster_LOGICAL_DNS:
+		localities := cluster.GetLoadAssignment().GetEndpoints()
+		if len(localities) != 1 || len(localities[0].GetLbEndpoints()) != 1 {
+			return errors.New("LOGICAL_DNS clusters must have a single locality_lb_endpoint and a single lb_endpoint")
+		}
+	}
+	return nil
+}
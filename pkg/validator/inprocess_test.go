@@ -0,0 +1,307 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	envoybootstrapv3 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v3"
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoybufferv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/buffer/v3"
+	envoyhttpv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+)
+
+func socketAddress(address string, port uint32) *envoycorev3.Address {
+	return &envoycorev3.Address{
+		Address: &envoycorev3.Address_SocketAddress{
+			SocketAddress: &envoycorev3.SocketAddress{
+				Address: address,
+				PortSpecifier: &envoycorev3.SocketAddress_PortValue{
+					PortValue: port,
+				},
+			},
+		},
+	}
+}
+
+// testBootstrap returns a valid bootstrap with a listener routing to a static cluster. The route
+// configuration and the HTTP connection manager are passed to the mutate functions before they are packed.
+func testBootstrap(
+	mutateRoute func(*envoyroutev3.RouteConfiguration),
+	mutateHcm func(*envoy_hcm.HttpConnectionManager),
+) *envoybootstrapv3.Bootstrap {
+	routeConfig := &envoyroutev3.RouteConfiguration{
+		Name: "local_route",
+		VirtualHosts: []*envoyroutev3.VirtualHost{{
+			Name:    "local_service",
+			Domains: []string{"*"},
+			Routes: []*envoyroutev3.Route{{
+				Name: "route",
+				Match: &envoyroutev3.RouteMatch{
+					PathSpecifier: &envoyroutev3.RouteMatch_Prefix{Prefix: "/"},
+				},
+				Action: &envoyroutev3.Route_Route{
+					Route: &envoyroutev3.RouteAction{
+						ClusterSpecifier: &envoyroutev3.RouteAction_Cluster{Cluster: "service_foo"},
+					},
+				},
+			}},
+		}},
+	}
+	if mutateRoute != nil {
+		mutateRoute(routeConfig)
+	}
+	hcm := &envoy_hcm.HttpConnectionManager{
+		StatPrefix: "ingress_http",
+		RouteSpecifier: &envoy_hcm.HttpConnectionManager_RouteConfig{
+			RouteConfig: routeConfig,
+		},
+		HttpFilters: []*envoy_hcm.HttpFilter{{
+			Name: "envoy.filters.http.router",
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: utils.MustMessageToAny(&envoyhttpv3.Router{}),
+			},
+		}},
+	}
+	if mutateHcm != nil {
+		mutateHcm(hcm)
+	}
+	return &envoybootstrapv3.Bootstrap{
+		Node: &envoycorev3.Node{
+			Id:      "test-id",
+			Cluster: "test-cluster",
+		},
+		StaticResources: &envoybootstrapv3.Bootstrap_StaticResources{
+			Listeners: []*envoylistenerv3.Listener{{
+				Name:    "listener_0",
+				Address: socketAddress("0.0.0.0", 10000),
+				FilterChains: []*envoylistenerv3.FilterChain{{
+					Filters: []*envoylistenerv3.Filter{{
+						Name: "envoy.filters.network.http_connection_manager",
+						ConfigType: &envoylistenerv3.Filter_TypedConfig{
+							TypedConfig: utils.MustMessageToAny(hcm),
+						},
+					}},
+				}},
+			}},
+			Clusters: []*envoyclusterv3.Cluster{{
+				Name:           "service_foo",
+				ConnectTimeout: durationpb.New(10_000_000_000),
+				ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{
+					Type: envoyclusterv3.Cluster_STATIC,
+				},
+				LoadAssignment: &envoyendpointv3.ClusterLoadAssignment{
+					ClusterName: "service_foo",
+					Endpoints: []*envoyendpointv3.LocalityLbEndpoints{{
+						LbEndpoints: []*envoyendpointv3.LbEndpoint{{
+							HostIdentifier: &envoyendpointv3.LbEndpoint_Endpoint{
+								Endpoint: &envoyendpointv3.Endpoint{
+									Address: socketAddress("127.0.0.1", 8080),
+								},
+							},
+						}},
+					}},
+				},
+			}},
+		},
+	}
+}
+
+func TestInProcessValidator_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		bootstrap func() *envoybootstrapv3.Bootstrap
+		errorMsg  string
+	}{
+		{
+			name: "valid configuration",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				return testBootstrap(nil, nil)
+			},
+		},
+		{
+			name: "missing listener address",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				b := testBootstrap(nil, nil)
+				b.StaticResources.Listeners[0].Address = nil
+				return b
+			},
+			errorMsg: "error adding listener named 'listener_0': address is necessary",
+		},
+		{
+			name: "duplicate listener address",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				b := testBootstrap(nil, nil)
+				listener := proto.CloneOf(b.StaticResources.Listeners[0])
+				listener.Name = "listener_1"
+				b.StaticResources.Listeners = append(b.StaticResources.Listeners, listener)
+				return b
+			},
+			errorMsg: "error adding listener named 'listener_1': duplicate address '0.0.0.0:10000' as existing listener 'listener_0'",
+		},
+		{
+			name: "duplicate filter chain match",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				b := testBootstrap(nil, nil)
+				listener := b.StaticResources.Listeners[0]
+				listener.FilterChains = append(listener.FilterChains, listener.FilterChains[0])
+				return b
+			},
+			errorMsg: "listener 'listener_0': multiple filter chains with the same matching rules are defined",
+		},
+		{
+			name: "proto constraint in typed config",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				return testBootstrap(nil, func(hcm *envoy_hcm.HttpConnectionManager) {
+					hcm.StatPrefix = ""
+				})
+			},
+			errorMsg: "invalid HttpConnectionManager.StatPrefix: value length must be at least 1 runes",
+		},
+		{
+			name: "invalid regex in route match",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				return testBootstrap(func(rc *envoyroutev3.RouteConfiguration) {
+					rc.VirtualHosts[0].Routes[0].Match.PathSpecifier = &envoyroutev3.RouteMatch_SafeRegex{
+						SafeRegex: &envoymatcherv3.RegexMatcher{Regex: "[[invalid.regex"},
+					}
+				}, nil)
+			},
+			errorMsg: "route 'route': error parsing regexp: missing closing ]",
+		},
+		{
+			name: "route to unknown cluster",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				return testBootstrap(func(rc *envoyroutev3.RouteConfiguration) {
+					rc.VirtualHosts[0].Routes[0].GetRoute().ClusterSpecifier = &envoyroutev3.RouteAction_Cluster{Cluster: "missing"}
+				}, nil)
+			},
+			errorMsg: "route configuration 'local_route': virtual host 'local_service': route 'route': unknown cluster 'missing'",
+		},
+		{
+			name: "route to unknown cluster without cluster validation",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				return testBootstrap(func(rc *envoyroutev3.RouteConfiguration) {
+					rc.ValidateClusters = wrapperspb.Bool(false)
+					rc.VirtualHosts[0].Routes[0].GetRoute().ClusterSpecifier = &envoyroutev3.RouteAction_Cluster{Cluster: "missing"}
+				}, nil)
+			},
+		},
+		{
+			name: "duplicate domains",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				return testBootstrap(func(rc *envoyroutev3.RouteConfiguration) {
+					vhost := proto.CloneOf(rc.VirtualHosts[0])
+					vhost.Name = "other_service"
+					rc.VirtualHosts = append(rc.VirtualHosts, vhost)
+				}, nil)
+			},
+			errorMsg: "only unique values for domains are permitted, duplicate entry of domain *",
+		},
+		{
+			name: "prefix and regex rewrite",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				return testBootstrap(func(rc *envoyroutev3.RouteConfiguration) {
+					action := rc.VirtualHosts[0].Routes[0].GetRoute()
+					action.PrefixRewrite = "/foo"
+					action.RegexRewrite = &envoymatcherv3.RegexMatchAndSubstitute{
+						Pattern:      &envoymatcherv3.RegexMatcher{Regex: "^/bar"},
+						Substitution: "/baz",
+					}
+				}, nil)
+			},
+			errorMsg: "cannot specify both prefix_rewrite and regex_rewrite",
+		},
+		{
+			name: "router is not the last filter",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				return testBootstrap(nil, func(hcm *envoy_hcm.HttpConnectionManager) {
+					hcm.HttpFilters = append(hcm.HttpFilters, &envoy_hcm.HttpFilter{
+						Name: "envoy.filters.http.buffer",
+						ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+							TypedConfig: utils.MustMessageToAny(&envoybufferv3.Buffer{MaxRequestBytes: wrapperspb.UInt32(1024)}),
+						},
+					})
+				})
+			},
+			errorMsg: "terminal filter named envoy.filters.http.router must be the last filter in a http filter chain",
+		},
+		{
+			name: "unregistered filter type",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				return testBootstrap(nil, func(hcm *envoy_hcm.HttpConnectionManager) {
+					hcm.HttpFilters = append([]*envoy_hcm.HttpFilter{{
+						Name: "unknown",
+						ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+							TypedConfig: &anypb.Any{TypeUrl: "type.googleapis.com/unknown.v1.Filter"},
+						},
+					}}, hcm.HttpFilters...)
+				})
+			},
+			errorMsg: "http filter 'unknown': didn't find a registered implementation for type URL 'type.googleapis.com/unknown.v1.Filter'",
+		},
+		{
+			name: "unknown static secret",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				b := testBootstrap(nil, nil)
+				b.StaticResources.Clusters[0].TransportSocket = &envoycorev3.TransportSocket{
+					Name: "envoy.transport_sockets.tls",
+					ConfigType: &envoycorev3.TransportSocket_TypedConfig{
+						TypedConfig: utils.MustMessageToAny(&envoytlsv3.UpstreamTlsContext{
+							CommonTlsContext: &envoytlsv3.CommonTlsContext{
+								TlsCertificateSdsSecretConfigs: []*envoytlsv3.SdsSecretConfig{{Name: "client-cert"}},
+							},
+						}),
+					},
+				}
+				return b
+			},
+			errorMsg: "cluster 'service_foo': unknown static secret: client-cert",
+		},
+		{
+			name: "static cluster with hostname",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				b := testBootstrap(nil, nil)
+				b.StaticResources.Clusters[0].LoadAssignment.Endpoints[0].LbEndpoints[0].GetEndpoint().Address = socketAddress("example.com", 8080)
+				return b
+			},
+			errorMsg: "cluster 'service_foo': malformed IP address: example.com",
+		},
+		{
+			name: "duplicate cluster",
+			bootstrap: func() *envoybootstrapv3.Bootstrap {
+				b := testBootstrap(nil, nil)
+				b.StaticResources.Clusters = append(b.StaticResources.Clusters, b.StaticResources.Clusters[0])
+				return b
+			},
+			errorMsg: "cluster manager: duplicate cluster 'service_foo'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewInProcess().Validate(context.Background(), tt.bootstrap())
+
+			if tt.errorMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidXDS)
+			assert.Contains(t, err.Error(), tt.errorMsg)
+		})
+	}
+}
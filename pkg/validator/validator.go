@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"slices"
 	"strings"
@@ -35,6 +36,21 @@ type Validator interface {
 	Validate(context.Context, *envoybootstrapv3.Bootstrap) error
 }
 
+// New creates a new validator: the binary validator when the Envoy binary is available at the
+// default path, and the in-process validator otherwise.
+func New() Validator {
+	return NewWithFallback(true)
+}
+
+// NewWithFallback creates a new binary validator using the Envoy binary at the default path. When the
+// binary is missing and allowFallback is set, the in-process validator is returned instead.
+func NewWithFallback(allowFallback bool) Validator {
+	if _, err := os.Stat(defaultEnvoyPath); err != nil && allowFallback {
+		return NewInProcess()
+	}
+	return NewBinary()
+}
+
 // binaryValidator validates envoy using the binary.
 type binaryValidator struct {
 	path string
@@ -50,6 +66,10 @@ func NewBinary(path ...string) Validator {
 	return &binaryValidator{path: path[0]}
 }
 
+func (b *binaryValidator) String() string {
+	return "envoy binary " + b.path
+}
+
 func (b *binaryValidator) Validate(ctx context.Context, bootstrap *envoybootstrapv3.Bootstrap) error {
 	marshalled, err := prepareBootstrapConfig(bootstrap)
 	if err != nil {
@@ -107,6 +127,10 @@ func NewDocker(opts ...DockerValidatorOptions) Validator {
 	return ret
 }
 
+func (d *dockerValidator) String() string {
+	return "envoy docker image " + d.img
+}
+
 func (d *dockerValidator) args() []string {
 	args := []string{
 		"run",
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -468,3 +469,17 @@ func createMockBinary(t *testing.T, script string) string {
 
 	return mockPath
 }
+
+func TestNewWithFallback(t *testing.T) {
+	envoyPath := filepath.Join(t.TempDir(), "envoy")
+	orig := defaultEnvoyPath
+	defaultEnvoyPath = envoyPath
+	t.Cleanup(func() { defaultEnvoyPath = orig })
+
+	// the binary is missing: only fall back when allowed
+	assert.Equal(t, "envoy binary "+envoyPath, NewWithFallback(false).(fmt.Stringer).String())
+	assert.Equal(t, "in-process", NewWithFallback(true).(fmt.Stringer).String())
+
+	require.NoError(t, os.WriteFile(envoyPath, []byte("#!/bin/sh\n"), 0o755)) //nolint:gosec // G306: test binary must be executable
+	assert.Equal(t, "envoy binary "+envoyPath, NewWithFallback(true).(fmt.Stringer).String())
+}
@@ -87,6 +87,12 @@ wIDAQABMA0GCSqGSIb3DQEBCwUAA4IBAQBtestcertdata
 		}
 	}
 
+	// delta xDS override function for tests that need incremental xDS enabled
+	deltaXdsOverride := func(inputs *pkgdeployer.Inputs) pkgdeployer.HelmValuesGenerator {
+		inputs.ControlPlane.XdsDelta = true
+		return nil
+	}
+
 	// Istio override function for tests that need Istio auto mTLS enabled
 	istioOverride := func(inputs *pkgdeployer.Inputs) pkgdeployer.HelmValuesGenerator {
 		inputs.IstioAutoMtlsEnabled = true
@@ -241,6 +247,29 @@ wIDAQABMA0GCSqGSIb3DQEBCwUAA4IBAQBtestcertdata
 					"HPA should have CPU utilization target from overlay spec")
 			},
 		},
+		{
+			Name:      "envoy with autoscaling and disruptionBudget",
+			InputFile: "envoy-autoscaling",
+			Validate: func(t *testing.T, outputYaml string) {
+				t.Helper()
+				assert.Contains(t, outputYaml, "kind: HorizontalPodAutoscaler",
+					"HPA should be created when autoscaling is specified")
+				assert.Contains(t, outputYaml, "kind: PodDisruptionBudget",
+					"PDB should be created when disruptionBudget is specified")
+				assert.Equal(t, 1, strings.Count(outputYaml, "kind: HorizontalPodAutoscaler"),
+					"HPA overlay should be applied to the generated HPA instead of creating another one")
+				assert.NotContains(t, outputYaml, "replicas: 3",
+					"Deployment replicas should be omitted when the HPA owns scaling")
+				assert.Contains(t, outputYaml, "name: envoy_http_downstream_cx_active",
+					"HPA should have the custom metric")
+				assert.Contains(t, outputYaml, "maxUnavailable: 25%",
+					"PDB should have maxUnavailable from disruptionBudget")
+				assert.Contains(t, outputYaml, "hpa-label: from-overlay",
+					"HPA should have label from overlay")
+				assert.Contains(t, outputYaml, "stabilizationWindowSeconds: 600",
+					"HPA should have behavior from overlay spec")
+			},
+		},
 		{
 			Name:      "envoy with VerticalPodAutoscaler overlay",
 			InputFile: "envoy-vpa-overlay",
@@ -476,6 +505,15 @@ wIDAQABMA0GCSqGSIb3DQEBCwUAA4IBAQBtestcertdata
 			InputFile:                   "base-gateway-tls",
 			HelmValuesGeneratorOverride: tlsOverride(caCertPath),
 		},
+		{
+			Name:                        "basic gateway with delta xDS enabled",
+			InputFile:                   "base-gateway-delta-xds",
+			HelmValuesGeneratorOverride: deltaXdsOverride,
+			Validate: func(t *testing.T, outputYaml string) {
+				t.Helper()
+				assert.Contains(t, outputYaml, "api_type: DELTA_GRPC")
+			},
+		},
 		{
 			Name:                        "gateway with istio enabled",
 			InputFile:                   "istio-enabled",
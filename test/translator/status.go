@@ -1,8 +1,6 @@
 package translator
 
 import (
-	"context"
-	"fmt"
 	"sort"
 	"time"
 
@@ -11,150 +9,45 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
-	gwv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gwxv1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
 
-	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translate"
 	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
 )
 
-type Statuses struct {
-	Gateways     map[string]*gwv1.GatewayStatus      `json:"gateways,omitempty"`
-	ListenerSets map[string]*gwxv1.ListenerSetStatus `json:"listenerSets,omitempty"`
-	HTTPRoutes   map[string]*gwv1.RouteStatus        `json:"httpRoutes,omitempty"`
-	TCPRoutes    map[string]*gwv1.RouteStatus        `json:"tcpRoutes,omitempty"`
-	TLSRoutes    map[string]*gwv1.RouteStatus        `json:"tlsRoutes,omitempty"`
-	GRPCRoutes   map[string]*gwv1.RouteStatus        `json:"grpcRoutes,omitempty"`
-	Policies     map[string]*gwv1.PolicyStatus       `json:"policies,omitempty"`
-}
+type Statuses = translate.Statuses
 
 func buildStatusesFromReports(
 	reportsMap reports.ReportMap,
 	gateways map[types.NamespacedName]*gwv1.Gateway,
 	listenerSets map[types.NamespacedName]*gwxv1.XListenerSet,
 ) *Statuses {
-	ctx := context.Background()
+	statuses := translate.BuildStatuses(reportsMap, gateways, listenerSets)
 
 	// Fixed values for deterministic golden file tests. Use the zero time
 	// for consistency and to avoid confusion about the significance of a
 	// specific date.
 	fixedTime := metav1.Time{Time: time.Time{}}
 
-	statuses := &Statuses{
-		Gateways:     make(map[string]*gwv1.GatewayStatus),
-		ListenerSets: make(map[string]*gwxv1.ListenerSetStatus),
-		HTTPRoutes:   make(map[string]*gwv1.RouteStatus),
-		TCPRoutes:    make(map[string]*gwv1.RouteStatus),
-		TLSRoutes:    make(map[string]*gwv1.RouteStatus),
-		GRPCRoutes:   make(map[string]*gwv1.RouteStatus),
-		Policies:     make(map[string]*gwv1.PolicyStatus),
-	}
-
-	// Build Gateway statuses. We need to use the actual Gateway object to make sure that
-	// status.listeners are correctly populated instead of using the object metadata.
-	for gwNN := range reportsMap.Gateways {
-		// Use the actual Gateway object from the input if available, otherwise create empty one
-		var gw gwv1.Gateway
-		if actualGw, exists := gateways[gwNN]; exists && actualGw != nil {
-			gw = *actualGw
-		} else {
-			gw = gwv1.Gateway{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      gwNN.Name,
-					Namespace: gwNN.Namespace,
-				},
-			}
-		}
-		if status := reportsMap.BuildGWStatus(ctx, gw, nil); status != nil {
-			normalizeStatus(status, fixedTime)
-			statuses.Gateways[gwNN.String()] = status
-		}
+	for _, status := range statuses.Gateways {
+		normalizeStatus(status, fixedTime)
 	}
-
-	// Build ListenerSet statuses. We need to use the actual XListenerSet object to make sure that
-	// status.listeners are correctly populated instead of using the object metadata.
-	for listenerSetNN := range reportsMap.ListenerSets[wellknown.XListenerSetGVK] {
-		// Use the actual XListenerSet object from the input if available, otherwise create empty one
-		var listenerSet gwxv1.XListenerSet
-		if actualLS, exists := listenerSets[listenerSetNN]; exists && actualLS != nil {
-			listenerSet = *actualLS
-		} else {
-			listenerSet = gwxv1.XListenerSet{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      listenerSetNN.Name,
-					Namespace: listenerSetNN.Namespace,
-				},
-			}
-		}
-		if status := reportsMap.BuildListenerSetStatus(ctx, listenerSet); status != nil {
-			normalizeListenerSetStatus(status, fixedTime)
-			statuses.ListenerSets[listenerSetNN.String()] = status
-		}
+	for _, status := range statuses.ListenerSets {
+		normalizeListenerSetStatus(status, fixedTime)
 	}
-
-	// Build HTTPRoute statuses
-	for routeNN := range reportsMap.HTTPRoutes {
-		route := gwv1.HTTPRoute{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      routeNN.Name,
-				Namespace: routeNN.Namespace,
-			},
-		}
-		if status := reportsMap.BuildRouteStatus(ctx, &route, wellknown.DefaultGatewayClassName); status != nil {
+	for _, routeStatuses := range []map[string]*gwv1.RouteStatus{
+		statuses.HTTPRoutes,
+		statuses.TCPRoutes,
+		statuses.TLSRoutes,
+		statuses.UDPRoutes,
+		statuses.GRPCRoutes,
+	} {
+		for _, status := range routeStatuses {
 			normalizeRouteStatus(status, fixedTime)
-			statuses.HTTPRoutes[routeNN.String()] = status
 		}
 	}
-
-	// Build TCPRoute statuses
-	for routeNN := range reportsMap.TCPRoutes {
-		route := gwv1a2.TCPRoute{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      routeNN.Name,
-				Namespace: routeNN.Namespace,
-			},
-		}
-		if status := reportsMap.BuildRouteStatus(ctx, &route, wellknown.DefaultGatewayClassName); status != nil {
-			normalizeRouteStatus(status, fixedTime)
-			statuses.TCPRoutes[routeNN.String()] = status
-		}
-	}
-
-	// Build TLSRoute statuses
-	for routeNN := range reportsMap.TLSRoutes {
-		route := gwv1a2.TLSRoute{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      routeNN.Name,
-				Namespace: routeNN.Namespace,
-			},
-		}
-		if status := reportsMap.BuildRouteStatus(ctx, &route, wellknown.DefaultGatewayClassName); status != nil {
-			normalizeRouteStatus(status, fixedTime)
-			statuses.TLSRoutes[routeNN.String()] = status
-		}
-	}
-
-	// Build GRPCRoute statuses
-	for routeNN := range reportsMap.GRPCRoutes {
-		route := gwv1.GRPCRoute{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      routeNN.Name,
-				Namespace: routeNN.Namespace,
-			},
-		}
-		if status := reportsMap.BuildRouteStatus(ctx, &route, wellknown.DefaultGatewayClassName); status != nil {
-			normalizeRouteStatus(status, fixedTime)
-			statuses.GRPCRoutes[routeNN.String()] = status
-		}
-	}
-
-	// Build Policy statuses
-	for policyKey := range reportsMap.Policies {
-		policyKeyStr := fmt.Sprintf("%s/%s/%s", policyKey.Kind, policyKey.Namespace, policyKey.Name)
-		if status := reportsMap.BuildPolicyStatus(ctx, policyKey, wellknown.DefaultGatewayControllerName, gwv1.PolicyStatus{}); status != nil {
-			normalizePolicyStatus(status, fixedTime)
-			statuses.Policies[policyKeyStr] = status
-		}
+	for _, status := range statuses.Policies {
+		normalizePolicyStatus(status, fixedTime)
 	}
 
 	return statuses
@@ -243,6 +136,7 @@ func sortStatuses(statuses *Statuses) *Statuses {
 		HTTPRoutes:   make(map[string]*gwv1.RouteStatus),
 		TCPRoutes:    make(map[string]*gwv1.RouteStatus),
 		TLSRoutes:    make(map[string]*gwv1.RouteStatus),
+		UDPRoutes:    make(map[string]*gwv1.RouteStatus),
 		GRPCRoutes:   make(map[string]*gwv1.RouteStatus),
 		Policies:     make(map[string]*gwv1.PolicyStatus),
 	}
@@ -297,6 +191,16 @@ func sortStatuses(statuses *Statuses) *Statuses {
 		sorted.TLSRoutes[k] = statuses.TLSRoutes[k]
 	}
 
+	// Sort UDP routes
+	udpRouteKeys := make([]string, 0, len(statuses.UDPRoutes))
+	for k := range statuses.UDPRoutes {
+		udpRouteKeys = append(udpRouteKeys, k)
+	}
+	sort.Strings(udpRouteKeys)
+	for _, k := range udpRouteKeys {
+		sorted.UDPRoutes[k] = statuses.UDPRoutes[k]
+	}
+
 	// Sort GRPC routes
 	grpcRouteKeys := make([]string, 0, len(statuses.GRPCRoutes))
 	for k := range statuses.GRPCRoutes {
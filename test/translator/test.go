@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"maps"
 	"os"
 	"path/filepath"
 	"sort"
@@ -24,7 +23,6 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/anypb"
-	kubeclient "istio.io/istio/pkg/kube"
 	"istio.io/istio/pkg/kube/krt"
 	apiserverschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -40,18 +38,14 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/apiclient"
 	"github.com/kgateway-dev/kgateway/v2/pkg/apiclient/fake"
-	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/registry"
-	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/proxy_syncer"
-	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translate"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/irtranslator"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/listener"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
-	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
-	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
 	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
 	"github.com/kgateway-dev/kgateway/v2/pkg/schemes"
@@ -579,6 +573,27 @@ func AreReportsSuccess(gwNN types.NamespacedName, reportsMap reports.ReportMap)
 		}
 	}
 
+	for nns := range reportsMap.UDPRoutes {
+		r := gwv1a2.UDPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nns.Name,
+				Namespace: nns.Namespace,
+			},
+		}
+		status := reportsMap.BuildRouteStatus(context.Background(), &r, wellknown.DefaultGatewayClassName)
+
+		for ref, parentRefReport := range status.Parents {
+			for _, c := range parentRefReport.Conditions {
+				// most route conditions true is good, except RouteConditionPartiallyInvalid
+				if c.Type == string(gwv1.RouteConditionPartiallyInvalid) && c.Status != metav1.ConditionFalse {
+					return fmt.Errorf("condition error for udproute: %v ref: %v condition: %v", nns, ref, c)
+				} else if c.Status != metav1.ConditionTrue {
+					return fmt.Errorf("condition error for udproute: %v ref: %v condition: %v", nns, ref, c)
+				}
+			}
+		}
+	}
+
 	for nns := range reportsMap.GRPCRoutes {
 		r := gwv1.GRPCRoute{
 			ObjectMeta: metav1.ObjectMeta{
@@ -704,10 +719,6 @@ func (tc TestCase) Run(
 		}, metav1.CreateOptions{})
 	}
 
-	krtOpts := krtutil.KrtOptions{
-		Stop: ctx.Done(),
-	}
-
 	settings, err := apisettings.BuildSettings()
 	if err != nil {
 		return nil, err
@@ -716,82 +727,44 @@ func (tc TestCase) Run(
 		opt(settings)
 	}
 
-	commoncol, err := collections.NewCommonCollections(
-		ctx,
-		krtOpts,
-		fakeClient,
-		wellknown.DefaultGatewayControllerName,
-		*settings,
-	)
+	translateResults, err := translate.Translate(ctx, fakeClient, translate.Options{
+		Settings:     *settings,
+		Validator:    validator.NewDocker(),
+		ExtraPlugins: extraConfig.PluginsFn,
+		ExtendPlugins: func(extensions *pluginsdk.Plugin) {
+			// needed for the Plugin Backend test (backend-plugin/gateway.yaml)
+			gk := schema.GroupKind{
+				Group: "",
+				Kind:  "test-backend-plugin",
+			}
+			extensions.ContributesPolicies[gk] = pluginsdk.PolicyPlugin{
+				Name: "test-backend-plugin",
+			}
+			testBackend := ir.NewBackendObjectIR(ir.ObjectSource{
+				Kind:      "test-backend-plugin",
+				Namespace: "default",
+				Name:      "example-svc",
+			}, 80, "")
+			extensions.ContributesBackends[gk] = pluginsdk.BackendPlugin{
+				Backends: krt.NewStaticCollection(nil, []ir.BackendObjectIR{
+					testBackend,
+				}),
+				BackendInit: ir.BackendInit{
+					InitEnvoyBackend: func(ctx context.Context, in ir.BackendObjectIR, out *envoyclusterv3.Cluster) *ir.EndpointsForBackend {
+						return nil
+					},
+				},
+			}
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	v := validator.NewDocker()
-	plugins := registry.Plugins(ctx, commoncol, *settings, v)
-	// TODO: consider moving the common code to a util that both proxy syncer and this test call
-	plugins = append(plugins, krtcollections.NewBuiltinPlugin(ctx))
-
-	var extraPlugs []pluginsdk.Plugin
-	if extraConfig.PluginsFn != nil {
-		extraPlugins := extraConfig.PluginsFn(ctx, commoncol, settings.PolicyMerge)
-		extraPlugs = append(extraPlugs, extraPlugins...)
-	}
-	plugins = append(plugins, extraPlugs...)
-	extensions := registry.MergePlugins(plugins...)
-
-	// needed for the Plugin Backend test (backend-plugin/gateway.yaml)
-	gk := schema.GroupKind{
-		Group: "",
-		Kind:  "test-backend-plugin",
-	}
-	extensions.ContributesPolicies[gk] = pluginsdk.PolicyPlugin{
-		Name: "test-backend-plugin",
-	}
-	testBackend := ir.NewBackendObjectIR(ir.ObjectSource{
-		Kind:      "test-backend-plugin",
-		Namespace: "default",
-		Name:      "example-svc",
-	}, 80, "")
-	extensions.ContributesBackends[gk] = pluginsdk.BackendPlugin{
-		Backends: krt.NewStaticCollection(nil, []ir.BackendObjectIR{
-			testBackend,
-		}),
-		BackendInit: ir.BackendInit{
-			InitEnvoyBackend: func(ctx context.Context, in ir.BackendObjectIR, out *envoyclusterv3.Cluster) *ir.EndpointsForBackend {
-				return nil
-			},
-		},
-	}
-
-	commoncol.InitPlugins(ctx, extensions, *settings)
-
-	translator := translator.NewCombinedTranslator(ctx, extensions, commoncol, v)
-	translator.Init(ctx)
-
-	fakeClient.RunAndWait(ctx.Done())
-	commoncol.GatewayIndex.Gateways.WaitUntilSynced(ctx.Done())
-
-	kubeclient.WaitForCacheSync("routes", ctx.Done(), commoncol.Routes.HasSynced)
-	kubeclient.WaitForCacheSync("extensions", ctx.Done(), extensions.HasSynced)
-	kubeclient.WaitForCacheSync("commoncol", ctx.Done(), commoncol.HasSynced)
-	kubeclient.WaitForCacheSync("translator", ctx.Done(), translator.HasSynced)
-	kubeclient.WaitForCacheSync("backends", ctx.Done(), commoncol.BackendIndex.HasSynced)
-	kubeclient.WaitForCacheSync("endpoints", ctx.Done(), commoncol.Endpoints.HasSynced)
-	for i, plug := range extraPlugs {
-		kubeclient.WaitForCacheSync(fmt.Sprintf("extra-%d", i), ctx.Done(), plug.HasSynced)
-	}
-
-	results := make(map[types.NamespacedName]ActualTestResult)
-
 	// Build a map of all gateways by NamespacedName for status building
 	gatewayMap := make(map[types.NamespacedName]*gwv1.Gateway)
-	for _, gw := range commoncol.GatewayIndex.Gateways.List() {
-		gwNN := types.NamespacedName{
-			Namespace: gw.Namespace,
-			Name:      gw.Name,
-		}
-		gatewayMap[gwNN] = gw.Obj
+	for gwNN, result := range translateResults {
+		gatewayMap[gwNN] = result.Gateway
 	}
 
 	// Build a map of all XListenerSets by nn for status building. We extract these
@@ -804,56 +777,15 @@ func (tc TestCase) Run(
 		}
 	}
 
-	for _, gw := range commoncol.GatewayIndex.Gateways.List() {
-		xdsSnap, reportsMap := translator.TranslateGateway(krt.TestingDummyContext{}, ctx, gw)
-
-		// Backend policies (e.g. BackendConfigPolicy) use a different reporting pipeline than gateway policies.
-		// Gateway policies (HTTPListenerPolicy, TrafficPolicy) are reported during gateway translation via the
-		// standard reporter mechanism. Backend policies are processed differently - they don't use the reporter
-		// during translation, instead their reports are generated separately by GenerateBackendPolicyReport().
-		// We need to merge both report types to capture all policy statuses for golden file testing.
-		var backendIRs []*ir.BackendObjectIR
-		for _, col := range commoncol.BackendIndex.BackendsWithPolicyRequiringStatus() {
-			backendIRs = append(backendIRs, col.List()...)
-		}
-		backendPolicyReports := proxy_syncer.GenerateBackendPolicyReport(backendIRs)
-
-		// Merge gateway reports with backend policy reports
-		mergedReports := reportsMap
-		maps.Copy(mergedReports.Policies, backendPolicyReports.Policies)
-
-		gwNN := types.NamespacedName{
-			Namespace: gw.Namespace,
-			Name:      gw.Name,
-		}
-		actual := ActualTestResult{
-			Proxy:        xdsSnap,
-			ReportsMap:   mergedReports,
+	results := make(map[types.NamespacedName]ActualTestResult)
+	for gwNN, result := range translateResults {
+		results[gwNN] = ActualTestResult{
+			Proxy:        result.Proxy,
+			ReportsMap:   result.Reports,
 			Gateways:     gatewayMap,
 			ListenerSets: listenerSetMap,
+			Clusters:     result.Clusters,
 		}
-		results[gwNN] = actual
-
-		ctx := context.Background()
-		t := translator.GetBackendTranslator()
-		ucc := ir.NewUniqlyConnectedClient("test", "test", nil, ir.PodLocality{})
-		var clusters []*envoyclusterv3.Cluster
-		for _, col := range commoncol.BackendIndex.BackendsWithPolicy() {
-			for _, backend := range col.List() {
-				cluster, err := t.TranslateBackend(ctx, krt.TestingDummyContext{}, ucc, backend)
-				if err != nil {
-					// In strict mode, backend validation errors are expected and should not fail the test
-					// The cluster will be nil or a blackhole cluster, which will be filtered out by perclient.go
-					// Note: These errors are expected when xDS validation is enabled in strict mode
-				}
-				if cluster != nil {
-					clusters = append(clusters, cluster)
-				}
-			}
-		}
-		r := results[gwNN]
-		r.Clusters = clusters
-		results[gwNN] = r
 	}
 
 	return results, nil